@@ -81,6 +81,13 @@ type Terminator interface {
 // before completion, the terminator's reason for termination will be returned
 // along with a potentially sub-optimal chromatic number and coloring. If
 // term is nil, DsaturExact will run to completion.
+//
+// The search is bounded above by an approximate Dsatur coloring and below by
+// the size of a maximum clique of g, and returns immediately when the bounds
+// meet. In the worst case the time taken is exponential in the number of nodes
+// in g, so DsaturExact is suited to small graphs or to use with a Terminator.
+// For large graphs the heuristic functions Dsatur, SmallestLast and WelshPowell
+// give colorings that are not guaranteed to be minimal in polynomial time.
 // See Brélaz doi:10.1145/359094.359101 for details of the algorithm.
 func DsaturExact(term Terminator, g graph.Undirected) (k int, colors map[int64]int, err error) {
 	// This is implemented essentially as described in algorithm 1 of
@@ -489,6 +496,30 @@ func WelshPowell(g graph.Undirected, partial map[int64]int) (k int, colors map[i
 	return k, colors, nil
 }
 
+// SmallestLast returns an approximate minimal chromatic number of g and a
+// corresponding vertex coloring using a greedy coloring algorithm with the
+// smallest last node ordering of Matula and Beck, the degeneracy ordering of
+// g. The number of colors used when no partial coloring is provided is at
+// most one more than the degeneracy of g, making SmallestLast effective for
+// sparse graphs. If a partial coloring is provided the coloring will be
+// consistent with that partial coloring if possible. Otherwise SmallestLast
+// will return ErrInvalidPartialColoring.
+// See Matula and Beck doi:10.1145/2402.322385 for details of the algorithm.
+func SmallestLast(g graph.Undirected, partial map[int64]int) (k int, colors map[int64]int, err error) {
+	nodes := g.Nodes()
+	n := nodes.Len()
+	if n == 0 {
+		return
+	}
+	partial, ok := newPartial(partial, g)
+	if !ok {
+		return -1, nil, ErrInvalidPartialColoring
+	}
+	order, _ := topo.DegeneracyOrdering(g)
+	k, colors = greedyColoringOf(g, iterator.NewOrderedNodes(order), partial)
+	return k, colors, nil
+}
+
 // byDescendingDegree returns a graph.Node iterator that returns nodes
 // in order of descending degree.
 func byDescendingDegree(it graph.Nodes, g graph.Undirected) graph.Nodes {
@@ -15,6 +15,7 @@ import (
 	"gonum.org/v1/gonum/graph/encoding/graph6"
 	"gonum.org/v1/gonum/graph/internal/set"
 	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/graph/topo"
 )
 
 var runLong = flag.Bool("color.long", false, "run long exact coloring tests")
@@ -631,6 +632,46 @@ func TestWelshPowell(t *testing.T) {
 	}
 }
 
+func TestSmallestLast(t *testing.T) {
+	for _, test := range coloringTests {
+		for _, partial := range []map[int64]int{nil, test.partial} {
+			k, colors, err := SmallestLast(test.g, partial)
+
+			if partial == nil {
+				if k < test.colors {
+					t.Errorf("unexpected chromatic number for %q: got:%d want at least:%d",
+						test.name, k, test.colors)
+				}
+				if _, cores := topo.DegeneracyOrdering(test.g); k > len(cores) {
+					t.Errorf("chromatic number for %q exceeds degeneracy bound: got:%d want at most:%d",
+						test.name, k, len(cores))
+				}
+			}
+			if s := Sets(colors); len(s) != k {
+				t.Errorf("mismatch between number of color sets and k: |sets|=%d k=%d", len(s), k)
+			}
+			if missing, ok := isCompleteColoring(colors, test.g); !ok {
+				t.Errorf("incomplete coloring for %q: missing %d\ngot:%v", test.name, missing, colors)
+			}
+			if xid, yid, ok := isValidColoring(colors, test.g); !ok {
+				t.Errorf("invalid coloring for %q: %d--%d match color\ncolors:%v",
+					test.name, xid, yid, colors)
+			}
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			for id, c := range partial {
+				if colors[id] != c {
+					t.Errorf("coloring not consistent with input partial for %q:\ngot:%v\nwant superset of:%v",
+						test.name, colors, partial)
+					break
+				}
+			}
+		}
+	}
+}
+
 var newPartialTests = []struct {
 	partial   map[int64]int
 	g         graph.Undirected
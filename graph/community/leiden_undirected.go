@@ -0,0 +1,230 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package community
+
+import (
+	"math/rand/v2"
+	"slices"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+// Leiden returns the hierarchical modularization of the undirected graph g at
+// the given resolution using the Leiden algorithm described in Traag, Waltman
+// and van Eck doi:10.1038/s41598-019-41695-z. If src is nil, rand.IntN is used
+// as the random generator. Leiden will panic if g has any edge with negative
+// edge weight.
+//
+// The Leiden algorithm extends the Louvain algorithm used by Modularize with a
+// refinement phase between local moving and aggregation. During refinement
+// each community is split into sub-communities by merging nodes only into
+// sub-communities that they are connected to and that are well connected to
+// the rest of the community. The aggregate graph is then constructed from the
+// refined sub-communities, so communities found by Leiden are guaranteed to be
+// connected, while the Louvain algorithm may find communities that are
+// internally disconnected.
+//
+// The modularity optimized is the same as for Modularize,
+//
+//	Q = 1/2m \sum_{ij} [ A_{ij} - (\gamma k_i k_j)/2m ] \delta(c_i,c_j),
+//
+// and the returned ReducedGraph is a *ReducedUndirected, so the result of
+// Leiden may be used in place of the result of Modularize.
+//
+// Leiden is deterministic for a given g when src is a rand.Source in a fixed
+// state; src is used only to order the visits of nodes during local moving
+// and refinement.
+//
+// graph.Undirect may be used as a shim to allow modularization of
+// directed graphs with the undirected modularity function.
+func Leiden(g graph.Undirected, resolution float64, src rand.Source) ReducedGraph {
+	c := reduceUndirected(g, nil)
+	rnd := rand.IntN
+	if src != nil {
+		rnd = rand.New(src).IntN
+	}
+	communities := c.communities
+	for {
+		l := newUndirectedLocalMover(c, communities, resolution)
+		if l == nil {
+			return c
+		}
+		l.localMovingHeuristic(rnd)
+		partition := l.connectedCommunities()
+		if len(partition) == len(l.nodes) {
+			c.communities = partition
+			return c
+		}
+
+		refined, partOf := l.refine(partition, rnd)
+		if len(refined) == len(l.nodes) {
+			// No node could be merged during the
+			// refinement, so the partition is final.
+			return reduceUndirected(c, partition)
+		}
+
+		// Aggregate the refined communities and
+		// use the unrefined partition of them as
+		// the starting point for the next level.
+		c = reduceUndirected(c, refined)
+		communities = make([][]graph.Node, len(partition))
+		for i, p := range partOf {
+			communities[p] = append(communities[p], node(i))
+		}
+	}
+}
+
+// connectedCommunities returns the non-empty communities held by l, with
+// any internally disconnected community split into its connected parts.
+// Splitting a disconnected community cannot decrease the modularity of
+// the partition.
+func (l *undirectedLocalMover) connectedCommunities() [][]graph.Node {
+	var (
+		parts [][]graph.Node
+		seen  = make([]bool, len(l.nodes))
+	)
+	for _, c := range l.communities {
+		for _, u := range c {
+			uid := u.ID()
+			if seen[uid] {
+				continue
+			}
+			seen[uid] = true
+			part := []graph.Node{u}
+			for i := 0; i < len(part); i++ {
+				for _, vid := range l.g.edges[part[i].ID()] {
+					if seen[vid] || l.memberships[vid] != l.memberships[uid] {
+						continue
+					}
+					seen[vid] = true
+					part = append(part, node(vid))
+				}
+			}
+			order.ByID(part)
+			parts = append(parts, part)
+		}
+	}
+	for i, c := range parts {
+		for _, u := range c {
+			l.memberships[u.ID()] = i
+		}
+	}
+	l.communities = parts
+	return parts
+}
+
+// refine performs the Leiden refinement of the given partition of the nodes
+// held by l, returning the refined communities and the index of the partition
+// community that contains each refined community. The refinement of each
+// community starts from singletons. Visiting nodes in a random order, each
+// node that is still a singleton and is well connected to its community is
+// merged into the well connected refined community it is connected to that
+// gives the greatest non-negative gain in modularity.
+func (l *undirectedLocalMover) refine(partition [][]graph.Node, rnd func(int) int) (refined [][]graph.Node, partOf []int) {
+	gamma := l.resolution
+	m2 := l.m2
+
+	// refinedOf is the index of the refined
+	// community of each node.
+	refinedOf := make([]int, len(l.nodes))
+	var (
+		// members, k and ext are the nodes, total
+		// degree and the weight of edges to the rest
+		// of the containing partition community for
+		// each refined community.
+		members [][]graph.Node
+		k       []float64
+		ext     []float64
+	)
+	for p, c := range partition {
+		var k_C float64
+		for _, u := range c {
+			k_C += l.edgeWeightOf[u.ID()]
+		}
+
+		first := len(members)
+		for _, u := range c {
+			uid := u.ID()
+			var w float64
+			for _, vid := range l.g.edges[uid] {
+				if l.memberships[vid] == p {
+					w += l.weight(uid, int64(vid))
+				}
+			}
+			refinedOf[uid] = len(members)
+			members = append(members, []graph.Node{u})
+			k = append(k, l.edgeWeightOf[uid])
+			ext = append(ext, w)
+		}
+
+		visit := make([]graph.Node, len(c))
+		copy(visit, c)
+		for i := range visit[:max(len(visit)-1, 0)] {
+			j := i + rnd(len(visit)-i)
+			visit[i], visit[j] = visit[j], visit[i]
+		}
+
+		weightTo := make(map[int]float64)
+		var candidates []int
+		for _, u := range visit {
+			uid := u.ID()
+			r := refinedOf[uid]
+			if len(members[r]) != 1 {
+				continue
+			}
+			k_u := l.edgeWeightOf[uid]
+			if ext[r] < gamma*k_u*(k_C-k_u)/m2 {
+				// u is not well connected to c.
+				continue
+			}
+
+			clear(weightTo)
+			candidates = candidates[:0]
+			for _, vid := range l.g.edges[uid] {
+				if l.memberships[vid] != p {
+					continue
+				}
+				s := refinedOf[vid]
+				if _, ok := weightTo[s]; !ok {
+					candidates = append(candidates, s)
+				}
+				weightTo[s] += l.weight(uid, int64(vid))
+			}
+			slices.Sort(candidates)
+
+			dst := -1
+			var best float64
+			for _, s := range candidates {
+				if ext[s] < gamma*k[s]*(k_C-k[s])/m2 {
+					// s is not well connected to c.
+					continue
+				}
+				dQ := weightTo[s] - gamma*k_u*k[s]/m2
+				if dQ >= 0 && (dst < 0 || dQ > best) {
+					best = dQ
+					dst = s
+				}
+			}
+			if dst < 0 {
+				continue
+			}
+
+			refinedOf[uid] = dst
+			members[dst] = append(members[dst], u)
+			members[r] = nil
+			k[dst] += k_u
+			ext[dst] += ext[r] - 2*weightTo[dst]
+		}
+
+		for _, m := range members[first:] {
+			if len(m) != 0 {
+				refined = append(refined, m)
+				partOf = append(partOf, p)
+			}
+		}
+	}
+	return refined, partOf
+}
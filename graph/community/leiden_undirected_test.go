@@ -0,0 +1,124 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package community
+
+import (
+	"math"
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/graph/topo"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+func TestLeidenUndirected(t *testing.T) {
+	for _, test := range communityUndirectedQTests {
+		g := simple.NewUndirectedGraph()
+		for u, e := range test.g {
+			// Add nodes that are not defined by an edge.
+			if g.Node(int64(u)) == nil {
+				g.AddNode(simple.Node(u))
+			}
+			for v := range e {
+				g.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+			}
+		}
+
+		t.Run(test.name, func(t *testing.T) {
+			testLeidenUndirected(t, test, g)
+		})
+	}
+}
+
+func testLeidenUndirected(t *testing.T, test communityUndirectedQTest, g graph.Undirected) {
+	const leidenIterations = 20
+
+	if test.structures[0].resolution != 1 {
+		panic("bad test: expect resolution=1")
+	}
+	want := test.structures[0].want
+	tol := test.structures[0].tol
+
+	bestQ := math.Inf(-1)
+	src := rand.New(rand.NewPCG(1, 1))
+	for i := 0; i < leidenIterations; i++ {
+		r := Leiden(g, 1, src).(*ReducedUndirected)
+		q := Q(r, nil, 1)
+		if math.IsNaN(q) {
+			if !math.IsNaN(want) {
+				t.Errorf("unexpected NaN Q")
+			}
+			return
+		}
+		bestQ = math.Max(bestQ, q)
+
+		var qs []float64
+		for p := r; p != nil; p = p.Expanded().(*ReducedUndirected) {
+			qs = append(qs, Q(p, nil, 1))
+		}
+		// Recovery of Q values is reversed.
+		if slices.Reverse(qs); !slices.IsSorted(qs) {
+			t.Errorf("Q values not monotonically increasing: %.5v", qs)
+		}
+
+		for _, c := range r.Communities() {
+			if !isConnectedIn(g, c) {
+				t.Errorf("community is not connected: %v", c)
+			}
+		}
+	}
+	if bestQ < want-tol {
+		t.Errorf("unexpected best Q: got:%.4v want at least:%.4v", bestQ, want)
+	}
+}
+
+func TestLeidenConnected(t *testing.T) {
+	src := rand.New(rand.NewPCG(1, 1))
+	for i := 0; i < 5; i++ {
+		r := Leiden(dupGraph, 1, src)
+		for _, c := range r.Communities() {
+			if !isConnectedIn(dupGraph, c) {
+				t.Errorf("community is not connected: %v", c)
+			}
+		}
+		if qL, qM := Q(r, nil, 1), Q(Modularize(dupGraph, 1, src), nil, 1); qL < qM-0.01 {
+			t.Errorf("unexpectedly low Q: got:%.4v Louvain:%.4v", qL, qM)
+		}
+	}
+}
+
+func TestLeidenDeterministic(t *testing.T) {
+	var got [2][][]graph.Node
+	for i := range got {
+		got[i] = Leiden(dupGraph, 1, rand.NewPCG(1, 1)).Communities()
+		for _, c := range got[i] {
+			order.ByID(c)
+		}
+		order.BySliceIDs(got[i])
+	}
+	if !reflect.DeepEqual(got[0], got[1]) {
+		t.Error("unexpected difference between runs with the same random state")
+	}
+}
+
+// isConnectedIn returns whether the nodes of c induce a connected subgraph of g.
+func isConnectedIn(g graph.Undirected, c []graph.Node) bool {
+	sub := simple.NewUndirectedGraph()
+	for _, u := range c {
+		sub.AddNode(u)
+	}
+	for _, u := range c {
+		for _, v := range c {
+			if u.ID() < v.ID() && g.HasEdgeBetween(u.ID(), v.ID()) {
+				sub.SetEdge(simple.Edge{F: u, T: v})
+			}
+		}
+	}
+	return len(topo.ConnectedComponents(sub)) == 1
+}
@@ -9,6 +9,13 @@ package graph
 // than the nodes in the original graph and the connection topology. Nodes returned
 // by the Complement directly or via queries to returned Edges will be those stored
 // in the original graph.
+//
+// The Complement is a lazy view that holds only a reference to the original
+// graph; no complement adjacency is materialized. Queries are answered from the
+// original graph, so From iterates over all the nodes of the graph to return the
+// non-neighbours of a node, and changes to the original graph are reflected in
+// the complement. Complement satisfies Undirected, and is the complement of an
+// undirected graph when the original graph is an Undirected.
 type Complement struct {
 	Graph
 }
@@ -45,6 +52,15 @@ func (g Complement) HasEdgeBetween(xid, yid int64) bool {
 		!g.Graph.HasEdgeBetween(xid, yid)
 }
 
+// EdgeBetween returns the edge between nodes x and y if such an edge exists
+// and nil otherwise.
+func (g Complement) EdgeBetween(xid, yid int64) Edge {
+	if !g.HasEdgeBetween(xid, yid) {
+		return nil
+	}
+	return shadow{F: g.Node(xid), T: g.Node(yid)}
+}
+
 // shadow is an edge that is not exposed to the user.
 type shadow struct{ F, T Node }
 
@@ -90,3 +90,33 @@ func TestNodeFilterIterator(t *testing.T) {
 		}
 	}
 }
+
+var _ graph.Undirected = graph.Complement{}
+
+func TestComplementEdgeBetween(t *testing.T) {
+	g := simple.NewUndirectedGraph()
+	g.SetEdge(simple.Edge{F: simple.Node(0), T: simple.Node(1)})
+	g.AddNode(simple.Node(2))
+	c := graph.Complement{g}
+
+	for _, test := range []struct {
+		x, y int64
+		want bool
+	}{
+		{x: 0, y: 1, want: false},
+		{x: 1, y: 0, want: false},
+		{x: 0, y: 2, want: true},
+		{x: 2, y: 1, want: true},
+		{x: 2, y: 2, want: false},
+		{x: 2, y: 3, want: false},
+	} {
+		e := c.EdgeBetween(test.x, test.y)
+		if (e != nil) != test.want {
+			t.Errorf("unexpected edge between %d and %d: got:%v want:%t", test.x, test.y, e, test.want)
+			continue
+		}
+		if e != nil && (e.From().ID() != test.x || e.To().ID() != test.y) {
+			t.Errorf("unexpected edge nodes: got:%d-%d want:%d-%d", e.From().ID(), e.To().ID(), test.x, test.y)
+		}
+	}
+}
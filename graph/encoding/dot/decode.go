@@ -40,11 +40,37 @@ type PortSetter interface {
 	SetToPort(port, compass string) error
 }
 
+// SubgraphAdder is implemented by graph values that can record the subgraph
+// structure of a DOT graph.
+type SubgraphAdder interface {
+	// AddSubgraph returns a SubgraphBuilder for recording the
+	// members of a subgraph with the given DOT ID. The ID is
+	// empty for anonymous subgraphs.
+	AddSubgraph(id string) SubgraphBuilder
+}
+
+// SubgraphBuilder is implemented by values that can record the members of
+// a DOT subgraph and the subgraphs nested within it.
+type SubgraphBuilder interface {
+	// AddNode records n as a member of the subgraph.
+	// AddNode may be called more than once with the
+	// same node.
+	AddNode(n graph.Node)
+
+	SubgraphAdder
+}
+
 // Unmarshal parses the Graphviz DOT-encoded data and stores the result in dst.
 // If the number of graphs encoded in data is not one, an error is returned and
 // dst will hold the first graph in data.
 //
 // Attributes and IDs are unquoted during unmarshalling if appropriate.
+//
+// If dst implements SubgraphAdder, AddSubgraph is called for each subgraph
+// of the DOT graph, including anonymous subgraphs, in the order they occur.
+// Subgraphs nested within a subgraph are added to the SubgraphBuilder of
+// the enclosing subgraph, and each node is recorded as a member of the
+// inner-most subgraph that it is mentioned in.
 func Unmarshal(data []byte, dst encoding.Builder) error {
 	file, err := dot.ParseBytes(data)
 	if err != nil {
@@ -63,6 +89,9 @@ func Unmarshal(data []byte, dst encoding.Builder) error {
 // dst will hold the first graph in data.
 //
 // Attributes and IDs are unquoted during unmarshalling if appropriate.
+//
+// If dst implements SubgraphAdder, subgraph membership is recorded as
+// described for Unmarshal.
 func UnmarshalMulti(data []byte, dst encoding.MultiBuilder) error {
 	file, err := dot.ParseBytes(data)
 	if err != nil {
@@ -99,6 +128,9 @@ func copyGraph(dst encoding.Builder, src *ast.Graph) (err error) {
 	if a, ok := dst.(AttributeSetters); ok {
 		gen.graphAttr, gen.nodeAttr, gen.edgeAttr = a.DOTAttributeSetters()
 	}
+	if s, ok := dst.(SubgraphAdder); ok {
+		gen.structure = s
+	}
 	for _, stmt := range src.Stmts {
 		gen.addStmt(dst, stmt)
 	}
@@ -129,6 +161,9 @@ func copyMultigraph(dst encoding.MultiBuilder, src *ast.Graph) (err error) {
 	if a, ok := dst.(AttributeSetters); ok {
 		gen.graphAttr, gen.nodeAttr, gen.edgeAttr = a.DOTAttributeSetters()
 	}
+	if s, ok := dst.(SubgraphAdder); ok {
+		gen.structure = s
+	}
 	for _, stmt := range src.Stmts {
 		gen.addStmt(dst, stmt)
 	}
@@ -150,12 +185,19 @@ type generator struct {
 	subStart []int
 	// graphAttr, nodeAttr and edgeAttr are global graph attributes.
 	graphAttr, nodeAttr, edgeAttr encoding.AttributeSetter
+	// Destination for the subgraph structure of the graph; or nil if the
+	// structure is not recorded.
+	structure SubgraphAdder
+	// Stack of builders for the subgraphs being processed. The top element
+	// corresponds to the inner-most subgraph.
+	subgraphs []SubgraphBuilder
 }
 
 // node returns the Gonum node corresponding to the given dot AST node ID,
 // generating a new such node if none exist.
 func (gen *generator) node(dst graph.NodeAdder, id string) graph.Node {
 	if n, ok := gen.ids[id]; ok {
+		gen.addSubgraphMember(n)
 		return n
 	}
 	n := dst.NewNode()
@@ -171,6 +213,7 @@ func (gen *generator) node(dst graph.NodeAdder, id string) graph.Node {
 		// used as a vertex of an edge
 		gen.appendSubgraphNode(n)
 	}
+	gen.addSubgraphMember(n)
 	return n
 }
 
@@ -232,9 +275,11 @@ func (gen *simpleGraph) addStmt(dst encoding.Builder, stmt ast.Stmt) {
 	case *ast.Attr:
 		// ignore.
 	case *ast.Subgraph:
+		gen.enterSubgraph(stmt.ID)
 		for _, stmt := range stmt.Stmts {
 			gen.addStmt(dst, stmt)
 		}
+		gen.leaveSubgraph()
 	default:
 		panic(fmt.Sprintf("unknown statement type %T", stmt))
 	}
@@ -303,9 +348,11 @@ func (gen *simpleGraph) addVertex(dst encoding.Builder, v ast.Vertex) []graph.No
 		return []graph.Node{n}
 	case *ast.Subgraph:
 		gen.pushSubgraph()
+		gen.enterSubgraph(v.ID)
 		for _, stmt := range v.Stmts {
 			gen.addStmt(dst, stmt)
 		}
+		gen.leaveSubgraph()
 		return gen.popSubgraph()
 	default:
 		panic(fmt.Sprintf("unknown vertex type %T", v))
@@ -383,6 +430,38 @@ func (gen *generator) appendSubgraphNode(n graph.Node) {
 	gen.subNodes = append(gen.subNodes, n)
 }
 
+// enterSubgraph makes the subgraph with the given DOT ID the inner-most
+// subgraph for recording node membership, if the subgraph structure is
+// being recorded.
+func (gen *generator) enterSubgraph(id string) {
+	if gen.structure == nil {
+		return
+	}
+	parent := gen.structure
+	if len(gen.subgraphs) != 0 {
+		parent = gen.subgraphs[len(gen.subgraphs)-1]
+	}
+	gen.subgraphs = append(gen.subgraphs, parent.AddSubgraph(unquoteID(id)))
+}
+
+// leaveSubgraph makes the parent of the inner-most subgraph the inner-most
+// subgraph for recording node membership.
+func (gen *generator) leaveSubgraph() {
+	if gen.structure == nil {
+		return
+	}
+	gen.subgraphs = gen.subgraphs[:len(gen.subgraphs)-1]
+}
+
+// addSubgraphMember records n as a member of the inner-most subgraph, if
+// there is one.
+func (gen *generator) addSubgraphMember(n graph.Node) {
+	if len(gen.subgraphs) == 0 {
+		return
+	}
+	gen.subgraphs[len(gen.subgraphs)-1].AddNode(n)
+}
+
 type multiGraph struct{ generator }
 
 // addStmt adds the given statement to the multigraph.
@@ -441,9 +520,11 @@ func (gen *multiGraph) addStmt(dst encoding.MultiBuilder, stmt ast.Stmt) {
 	case *ast.Attr:
 		// ignore.
 	case *ast.Subgraph:
+		gen.enterSubgraph(stmt.ID)
 		for _, stmt := range stmt.Stmts {
 			gen.addStmt(dst, stmt)
 		}
+		gen.leaveSubgraph()
 	default:
 		panic(fmt.Sprintf("unknown statement type %T", stmt))
 	}
@@ -471,9 +552,11 @@ func (gen *multiGraph) addVertex(dst encoding.MultiBuilder, v ast.Vertex) []grap
 		return []graph.Node{n}
 	case *ast.Subgraph:
 		gen.pushSubgraph()
+		gen.enterSubgraph(v.ID)
 		for _, stmt := range v.Stmts {
 			gen.addStmt(dst, stmt)
 		}
+		gen.leaveSubgraph()
 		return gen.popSubgraph()
 	default:
 		panic(fmt.Sprintf("unknown vertex type %T", v))
@@ -6,6 +6,7 @@ package dot
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"gonum.org/v1/gonum/graph"
@@ -778,3 +779,130 @@ func panics(fn func()) (message string, ok bool) {
 	fn()
 	return
 }
+
+func TestSubgraphStructure(t *testing.T) {
+	for _, test := range []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			name: "flat",
+			input: `graph {
+	A -- B;
+}`,
+			want: `[]`,
+		},
+		{
+			name: "clusters",
+			input: `digraph {
+	subgraph cluster_a {
+		label="a";
+		A -> B;
+	}
+	subgraph "cluster b" {
+		C;
+		D;
+	}
+	A -> C;
+	E;
+}`,
+			want: `[cluster_a{0 1} cluster b{2 3}]`,
+		},
+		{
+			name: "nested",
+			input: `graph {
+	A;
+	subgraph cluster_outer {
+		B;
+		subgraph cluster_inner {
+			A -- C;
+			subgraph cluster_innermost {
+				D;
+			}
+		}
+		E;
+	}
+}`,
+			want: `[cluster_outer{1 4 cluster_inner{0 2 cluster_innermost{3}}}]`,
+		},
+		{
+			name: "anonymous",
+			input: `graph {
+	A -- {B C};
+	subgraph S {
+		D -- {E};
+	}
+}`,
+			want: `[{1 2} S{3 {4}}]`,
+		},
+	} {
+		dst := newSubgraphTracker()
+		if err := Unmarshal([]byte(test.input), dst); err != nil {
+			t.Errorf("unexpected error for %q: %v", test.name, err)
+			continue
+		}
+		got := fmt.Sprint(dst.subgraphs)
+		if got != test.want {
+			t.Errorf("unexpected subgraph structure for %q:\ngot: %s\nwant:%s", test.name, got, test.want)
+		}
+	}
+}
+
+// subgraphTracker is an encoding.Builder that records
+// the subgraph structure of an unmarshalled DOT graph.
+type subgraphTracker struct {
+	*simple.DirectedGraph
+	subgraphs []*subgraphRecord
+}
+
+func newSubgraphTracker() *subgraphTracker {
+	return &subgraphTracker{DirectedGraph: simple.NewDirectedGraph()}
+}
+
+func (g *subgraphTracker) AddSubgraph(id string) SubgraphBuilder {
+	s := &subgraphRecord{id: id}
+	g.subgraphs = append(g.subgraphs, s)
+	return s
+}
+
+// subgraphRecord implements the SubgraphBuilder interface.
+type subgraphRecord struct {
+	id        string
+	nodes     []int64
+	subgraphs []*subgraphRecord
+}
+
+func (s *subgraphRecord) AddNode(n graph.Node) {
+	for _, id := range s.nodes {
+		if id == n.ID() {
+			return
+		}
+	}
+	s.nodes = append(s.nodes, n.ID())
+}
+
+func (s *subgraphRecord) AddSubgraph(id string) SubgraphBuilder {
+	c := &subgraphRecord{id: id}
+	s.subgraphs = append(s.subgraphs, c)
+	return c
+}
+
+func (s *subgraphRecord) String() string {
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "%s{", s.id)
+	for i, id := range s.nodes {
+		if i != 0 {
+			buf.WriteByte(' ')
+		}
+		fmt.Fprint(&buf, id)
+	}
+	for i, c := range s.subgraphs {
+		if i != 0 || len(s.nodes) != 0 {
+			buf.WriteByte(' ')
+		}
+		buf.WriteString(c.String())
+	}
+	buf.WriteByte('}')
+	return buf.String()
+}
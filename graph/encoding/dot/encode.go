@@ -105,6 +105,27 @@ func Marshal(g graph.Graph, name, prefix, indent string) ([]byte, error) {
 	return p.buf.Bytes(), nil
 }
 
+// MarshalWith returns the DOT encoding for the graph g as Marshal does, but
+// with node and edge attributes provided by the nodeAttr and edgeAttr functions
+// instead of by the encoding.Attributer interface. This allows attributes to
+// be supplied for graphs whose node and edge types cannot be modified. If
+// nodeAttr or edgeAttr is nil, the attributes of nodes or edges respectively
+// are obtained from the encoding.Attributer interface as for Marshal. The
+// functions are also used for the nodes and edges of subgraphs.
+func MarshalWith(g graph.Graph, name, prefix, indent string, nodeAttr func(graph.Node) []encoding.Attribute, edgeAttr func(graph.Edge) []encoding.Attribute) ([]byte, error) {
+	var p simpleGraphPrinter
+	p.indent = indent
+	p.prefix = prefix
+	p.visited = make(map[edge]bool)
+	p.nodeAttr = nodeAttr
+	p.edgeAttr = edgeAttr
+	err := p.print(g, name, false, false)
+	if err != nil {
+		return nil, err
+	}
+	return p.buf.Bytes(), nil
+}
+
 // MarshalMulti returns the DOT encoding for the multigraph g, applying the
 // prefix and indent to the encoding. Name is used to specify the graph name. If
 // name is empty and g implements Graph, the returned string from DOTID will be
@@ -196,7 +217,10 @@ func (p *simpleGraphPrinter) print(g graph.Graph, name string, needsIndent, isSu
 		}
 		p.newline()
 		p.writeNode(n)
-		if a, ok := n.(encoding.Attributer); ok {
+		if p.nodeAttr != nil {
+			a := encoding.Attributes(p.nodeAttr(n))
+			p.writeAttributeList(&a)
+		} else if a, ok := n.(encoding.Attributer); ok {
 			p.writeAttributeList(a)
 		}
 		p.buf.WriteByte(';')
@@ -276,7 +300,10 @@ func (p *simpleGraphPrinter) print(g graph.Graph, name string, needsIndent, isSu
 				}
 			}
 
-			if a, ok := g.Edge(nid, tid).(encoding.Attributer); ok {
+			if p.edgeAttr != nil {
+				a := encoding.Attributes(p.edgeAttr(e))
+				p.writeAttributeList(&a)
+			} else if a, ok := e.(encoding.Attributer); ok {
 				p.writeAttributeList(a)
 			}
 
@@ -426,6 +453,9 @@ func (p *printer) closeBlock(b string) {
 type simpleGraphPrinter struct {
 	printer
 	visited map[edge]bool
+
+	nodeAttr func(graph.Node) []encoding.Attribute
+	edgeAttr func(graph.Edge) []encoding.Attribute
 }
 
 type multiGraphPrinter struct {
@@ -6,6 +6,7 @@ package dot
 
 import (
 	"bytes"
+	"fmt"
 	"os/exec"
 	"testing"
 
@@ -1553,6 +1554,64 @@ func createNamedDirectedMultigraph(g []intlist) graph.Multigraph {
 	return dg
 }
 
+func TestMarshalWith(t *testing.T) {
+	g := simple.NewUndirectedGraph()
+	for _, e := range [][2]int64{{0, 1}, {1, 2}} {
+		g.SetEdge(simple.Edge{F: simple.Node(e[0]), T: simple.Node(e[1])})
+	}
+	g.AddNode(simple.Node(3))
+
+	nodeAttr := func(n graph.Node) []encoding.Attribute {
+		if n.ID()%2 != 0 {
+			return nil
+		}
+		return []encoding.Attribute{{Key: "color", Value: "red"}}
+	}
+	edgeAttr := func(e graph.Edge) []encoding.Attribute {
+		return []encoding.Attribute{
+			{Key: "label", Value: fmt.Sprintf("%d to %d", e.From().ID(), e.To().ID())},
+			{Key: "weight", Value: "2"},
+		}
+	}
+	got, err := MarshalWith(g, "G", "", "\t", nodeAttr, edgeAttr)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	const want = `strict graph G {
+	// Node definitions.
+	0 [color=red];
+	1;
+	2 [color=red];
+	3;
+
+	// Edge definitions.
+	0 -- 1 [
+		label="0 to 1"
+		weight=2
+	];
+	1 -- 2 [
+		label="1 to 2"
+		weight=2
+	];
+}`
+	if string(got) != want {
+		t.Errorf("unexpected DOT result:\ngot: %s\nwant:%s", got, want)
+	}
+	checkDOT(t, got)
+
+	// Nil functions fall back to the Attributer interface.
+	for i, test := range encodeTests {
+		got, err := MarshalWith(test.g, test.name, test.prefix, "\t", nil, nil)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			continue
+		}
+		if string(got) != test.want {
+			t.Errorf("unexpected DOT result for test %d:\ngot: %s\nwant:%s", i, got, test.want)
+		}
+	}
+}
+
 var encodeMultiTests = []struct {
 	name string
 	g    graph.Multigraph
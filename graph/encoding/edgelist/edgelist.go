@@ -0,0 +1,135 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package edgelist implements reading and writing of graphs as delimited
+// edge lists, such as CSV and TSV files.
+//
+// Each line of an edge list holds the IDs of the from and to nodes of an
+// edge, and optionally the edge weight, separated by a separator rune:
+//
+//	u<sep>v[<sep>weight]
+package edgelist // import "gonum.org/v1/gonum/graph/encoding/edgelist"
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/encoding"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+// Read reads an edge list from r with fields separated by sep and adds the
+// edges to builder. Fields are trimmed of surrounding white space, and blank
+// lines and lines beginning with '#' are skipped. Edges without a weight field
+// are given a weight of 1.
+//
+// If builder implements graph.NodeWithIDer, nodes not already in builder are
+// obtained with NodeWithID so that node IDs in the edge list are retained,
+// otherwise they are created with builder.NewNode and the IDs of the edge list
+// only identify nodes within the list.
+func Read(r io.Reader, builder encoding.WeightedBuilder, sep rune) (err error) {
+	defer func() {
+		switch e := recover().(type) {
+		case nil:
+		case error:
+			err = e
+		default:
+			err = fmt.Errorf("edgelist: panic building graph: %v", e)
+		}
+	}()
+
+	ider, hasIDs := builder.(graph.NodeWithIDer)
+	nodes := make(map[int64]graph.Node)
+	node := func(id int64) graph.Node {
+		if n, ok := nodes[id]; ok {
+			return n
+		}
+		var n graph.Node
+		if hasIDs {
+			n = builder.Node(id)
+			if n == nil {
+				n, _ = ider.NodeWithID(id)
+				builder.AddNode(n)
+			}
+		} else {
+			n = builder.NewNode()
+			builder.AddNode(n)
+		}
+		nodes[id] = n
+		return n
+	}
+
+	sc := bufio.NewScanner(r)
+	for line := 1; sc.Scan(); line++ {
+		text := strings.TrimSpace(sc.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		fields := strings.Split(text, string(sep))
+		if len(fields) != 2 && len(fields) != 3 {
+			return fmt.Errorf("edgelist: line %d: invalid number of fields; expected 2 or 3, got %d", line, len(fields))
+		}
+		var ids [2]int64
+		for i, f := range fields[:2] {
+			ids[i], err = strconv.ParseInt(strings.TrimSpace(f), 10, 64)
+			if err != nil {
+				return fmt.Errorf("edgelist: line %d: invalid node ID: %w", line, err)
+			}
+		}
+		w := 1.0
+		if len(fields) == 3 {
+			w, err = strconv.ParseFloat(strings.TrimSpace(fields[2]), 64)
+			if err != nil {
+				return fmt.Errorf("edgelist: line %d: invalid weight: %w", line, err)
+			}
+		}
+		builder.SetWeightedEdge(builder.NewWeightedEdge(node(ids[0]), node(ids[1]), w))
+	}
+	return sc.Err()
+}
+
+// Write writes the edges of g to w as an edge list with fields separated by
+// sep. Edges are ordered lexically by their from and to node IDs, and the
+// edges of an undirected graph are written once, with the lower node ID
+// first. If g is a graph.Weighted, each line holds the edge weight.
+//
+// Nodes with no edges are not represented in the edge list.
+func Write(w io.Writer, g graph.Graph, sep rune) error {
+	_, isDirected := g.(graph.Directed)
+	wg, isWeighted := g.(graph.Weighted)
+
+	nodes := graph.NodesOf(g.Nodes())
+	order.ByID(nodes)
+	bw := bufio.NewWriter(w)
+	var to []int64
+	for _, u := range nodes {
+		uid := u.ID()
+		to = to[:0]
+		it := g.From(uid)
+		for it.Next() {
+			vid := it.Node().ID()
+			if isDirected || uid <= vid {
+				to = append(to, vid)
+			}
+		}
+		slices.Sort(to)
+		for _, vid := range to {
+			bw.WriteString(strconv.FormatInt(uid, 10))
+			bw.WriteRune(sep)
+			bw.WriteString(strconv.FormatInt(vid, 10))
+			if isWeighted {
+				weight, _ := wg.Weight(uid, vid)
+				bw.WriteRune(sep)
+				bw.WriteString(strconv.FormatFloat(weight, 'g', -1, 64))
+			}
+			bw.WriteByte('\n')
+		}
+	}
+	return bw.Flush()
+}
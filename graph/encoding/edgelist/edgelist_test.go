@@ -0,0 +1,158 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package edgelist
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/encoding"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+var readWriteTests = []struct {
+	name     string
+	directed bool
+	sep      rune
+	src      string
+	want     string
+}{
+	{
+		name:     "csv",
+		directed: true,
+		sep:      ',',
+		src: `# source,target,weight
+0,1,0.5
+
+1, 2 ,2
+2,0
+  # indented comment
+3,1,-1.5
+`,
+		want: `0,1,0.5
+1,2,2
+2,0,1
+3,1,-1.5
+`,
+	},
+	{
+		name: "tsv undirected",
+		sep:  '\t',
+		src:  "5\t1\n1\t2\t3e1\n",
+		want: "1\t2\t30\n1\t5\t1\n",
+	},
+	{
+		name: "empty",
+		sep:  ' ',
+		src:  "\n# nothing\n",
+		want: "",
+	},
+}
+
+func TestReadWrite(t *testing.T) {
+	for _, test := range readWriteTests {
+		var dst encoding.WeightedBuilder
+		if test.directed {
+			dst = simple.NewWeightedDirectedGraph(0, math.Inf(1))
+		} else {
+			dst = simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+		}
+		err := Read(strings.NewReader(test.src), dst, test.sep)
+		if err != nil {
+			t.Errorf("unexpected error reading %q: %v", test.name, err)
+			continue
+		}
+		var buf strings.Builder
+		err = Write(&buf, dst, test.sep)
+		if err != nil {
+			t.Errorf("unexpected error writing %q: %v", test.name, err)
+			continue
+		}
+		if got := buf.String(); got != test.want {
+			t.Errorf("unexpected edge list for %q:\ngot:\n%s\nwant:\n%s", test.name, got, test.want)
+		}
+	}
+}
+
+func TestWriteUnweighted(t *testing.T) {
+	g := simple.NewUndirectedGraph()
+	g.SetEdge(simple.Edge{F: simple.Node(3), T: simple.Node(1)})
+	g.SetEdge(simple.Edge{F: simple.Node(1), T: simple.Node(2)})
+	g.AddNode(simple.Node(4))
+	var buf strings.Builder
+	err := Write(&buf, g, ';')
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	const want = "1;2\n1;3\n"
+	if got := buf.String(); got != want {
+		t.Errorf("unexpected edge list:\ngot:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestReadNewNode(t *testing.T) {
+	// Wrapping the graph hides its NodeWithID method,
+	// so node IDs are allocated by NewNode.
+	g := simple.NewWeightedDirectedGraph(0, math.Inf(1))
+	err := Read(strings.NewReader("10,20,2\n20,10,3\n"), struct{ encoding.WeightedBuilder }{g}, ',')
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if g.Nodes().Len() != 2 {
+		t.Errorf("unexpected nodes: %v", graph.NodesOf(g.Nodes()))
+	}
+	for _, e := range []struct {
+		u, v int64
+		w    float64
+	}{
+		{u: 0, v: 1, w: 2},
+		{u: 1, v: 0, w: 3},
+	} {
+		if w, ok := g.Weight(e.u, e.v); !ok || w != e.w {
+			t.Errorf("unexpected weight for edge %d->%d: got:%v want:%v", e.u, e.v, w, e.w)
+		}
+	}
+}
+
+func TestReadErrors(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		src  string
+		want string
+	}{
+		{
+			name: "too few fields",
+			src:  "0,1\n2\n",
+			want: "edgelist: line 2: invalid number of fields; expected 2 or 3, got 1",
+		},
+		{
+			name: "too many fields",
+			src:  "0,1,2,3\n",
+			want: "edgelist: line 1: invalid number of fields; expected 2 or 3, got 4",
+		},
+		{
+			name: "node ID",
+			src:  "# header\na,1\n",
+			want: `edgelist: line 2: invalid node ID: strconv.ParseInt: parsing "a": invalid syntax`,
+		},
+		{
+			name: "weight",
+			src:  "0,1,heavy\n",
+			want: `edgelist: line 1: invalid weight: strconv.ParseFloat: parsing "heavy": invalid syntax`,
+		},
+		{
+			name: "self edge",
+			src:  "0,0\n",
+			want: "edgelist: panic building graph: simple: adding self edge",
+		},
+	} {
+		err := Read(strings.NewReader(test.src), simple.NewWeightedDirectedGraph(0, math.Inf(1)), ',')
+		if err == nil || err.Error() != test.want {
+			t.Errorf("unexpected error for %q: got:%v want:%s", test.name, err, test.want)
+		}
+	}
+}
@@ -18,6 +18,13 @@ type MultiBuilder interface {
 	graph.MultigraphBuilder
 }
 
+// WeightedBuilder is a weighted graph that can have user-defined nodes and
+// weighted edges added.
+type WeightedBuilder interface {
+	graph.Weighted
+	graph.WeightedBuilder
+}
+
 // AttributeSetter is implemented by types that can set an encoded graph
 // attribute.
 type AttributeSetter interface {
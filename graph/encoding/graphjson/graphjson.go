@@ -0,0 +1,205 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package graphjson implements JSON marshaling and unmarshaling of graphs
+// with a stable ordering of nodes and edges, suitable for storage under
+// version control.
+//
+// A graph is encoded as a JSON object with the fields "directed", "nodes" and
+// "edges". Each node is an object holding the node's "id" and each edge is an
+// object holding the IDs of its "from" and "to" nodes and, for weighted graphs,
+// its "weight". Nodes and edges may also hold an "attributes" object.
+package graphjson // import "gonum.org/v1/gonum/graph/encoding/graphjson"
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/encoding"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+// document is the JSON representation of a graph.
+type document struct {
+	Directed bool   `json:"directed"`
+	Nodes    []node `json:"nodes"`
+	Edges    []edge `json:"edges"`
+}
+
+// node is the JSON representation of a graph node.
+type node struct {
+	ID         int64             `json:"id"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+// edge is the JSON representation of a graph edge.
+type edge struct {
+	From       int64             `json:"from"`
+	To         int64             `json:"to"`
+	Weight     *float64          `json:"weight,omitempty"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+// Marshal returns the JSON encoding of g. Nodes are ordered by ID and edges
+// are ordered lexically by their from and to node IDs, so the encoding of a
+// graph is stable. The edges of an undirected graph are encoded once, with
+// the lower node ID as the from node. If g is a graph.Weighted, each edge
+// holds its weight. Attributes of nodes and edges implementing
+// encoding.Attributer are encoded in lexical order of key.
+//
+// Marshal returns an error if a weight is infinite or NaN, since these
+// values cannot be represented in JSON.
+func Marshal(g graph.Graph) ([]byte, error) {
+	_, isDirected := g.(graph.Directed)
+	wg, isWeighted := g.(graph.Weighted)
+
+	nodes := graph.NodesOf(g.Nodes())
+	order.ByID(nodes)
+	doc := document{
+		Directed: isDirected,
+		Nodes:    make([]node, 0, len(nodes)),
+		Edges:    []edge{},
+	}
+	for _, n := range nodes {
+		uid := n.ID()
+		doc.Nodes = append(doc.Nodes, node{ID: uid, Attributes: attributesOf(n)})
+		to := g.From(uid)
+		for to.Next() {
+			vid := to.Node().ID()
+			if !isDirected && vid < uid {
+				continue
+			}
+			e := edge{From: uid, To: vid, Attributes: attributesOf(g.Edge(uid, vid))}
+			if isWeighted {
+				w, _ := wg.Weight(uid, vid)
+				e.Weight = &w
+			}
+			doc.Edges = append(doc.Edges, e)
+		}
+	}
+	slices.SortFunc(doc.Edges, func(a, b edge) int {
+		if c := cmp.Compare(a.From, b.From); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.To, b.To)
+	})
+	return json.MarshalIndent(doc, "", "\t")
+}
+
+// attributesOf returns the attributes of x if it is an encoding.Attributer.
+func attributesOf(x any) map[string]string {
+	a, ok := x.(encoding.Attributer)
+	if !ok {
+		return nil
+	}
+	attrs := a.Attributes()
+	if len(attrs) == 0 {
+		return nil
+	}
+	m := make(map[string]string, len(attrs))
+	for _, attr := range attrs {
+		m[attr.Key] = attr.Value
+	}
+	return m
+}
+
+// Unmarshal parses the JSON-encoded data produced by Marshal and stores the
+// result in dst. The direction of the edges is determined by dst.
+//
+// If dst implements graph.NodeWithIDer, nodes are obtained with NodeWithID so
+// that the node IDs of the encoding are retained, otherwise they are created
+// with dst.NewNode. If dst implements graph.WeightedEdgeAdder, edges are
+// created and added with NewWeightedEdge and SetWeightedEdge, with a weight
+// of 1 for edges without a weight. Attributes are set in lexical order of key
+// on nodes and edges that implement encoding.AttributeSetter.
+func Unmarshal(data []byte, dst encoding.Builder) (err error) {
+	var doc document
+	err = json.Unmarshal(data, &doc)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		switch e := recover().(type) {
+		case nil:
+		case error:
+			err = e
+		default:
+			err = fmt.Errorf("graphjson: panic building graph: %v", e)
+		}
+	}()
+
+	ider, hasIDs := dst.(graph.NodeWithIDer)
+	nodes := make(map[int64]graph.Node, len(doc.Nodes))
+	for _, n := range doc.Nodes {
+		if _, exists := nodes[n.ID]; exists {
+			return fmt.Errorf("graphjson: duplicate node ID %d", n.ID)
+		}
+		var u graph.Node
+		if hasIDs {
+			u, _ = ider.NodeWithID(n.ID)
+		} else {
+			u = dst.NewNode()
+		}
+		dst.AddNode(u)
+		nodes[n.ID] = u
+		err = setAttributes(u, n.Attributes)
+		if err != nil {
+			return fmt.Errorf("graphjson: unable to unmarshal node %d attributes: %w", n.ID, err)
+		}
+	}
+
+	wdst, isWeighted := dst.(graph.WeightedEdgeAdder)
+	for _, e := range doc.Edges {
+		u, ok := nodes[e.From]
+		if !ok {
+			return fmt.Errorf("graphjson: edge from node %d not in graph", e.From)
+		}
+		v, ok := nodes[e.To]
+		if !ok {
+			return fmt.Errorf("graphjson: edge to node %d not in graph", e.To)
+		}
+		if isWeighted {
+			w := 1.0
+			if e.Weight != nil {
+				w = *e.Weight
+			}
+			we := wdst.NewWeightedEdge(u, v, w)
+			err = setAttributes(we, e.Attributes)
+			wdst.SetWeightedEdge(we)
+		} else {
+			ue := dst.NewEdge(u, v)
+			err = setAttributes(ue, e.Attributes)
+			dst.SetEdge(ue)
+		}
+		if err != nil {
+			return fmt.Errorf("graphjson: unable to unmarshal edge %d-%d attributes: %w", e.From, e.To, err)
+		}
+	}
+	return nil
+}
+
+// setAttributes sets attrs in lexical order of key on x if it is an
+// encoding.AttributeSetter.
+func setAttributes(x any, attrs map[string]string) error {
+	s, ok := x.(encoding.AttributeSetter)
+	if !ok {
+		return nil
+	}
+	keys := make([]string, 0, len(attrs))
+	for k := range attrs {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	for _, k := range keys {
+		err := s.SetAttribute(encoding.Attribute{Key: k, Value: attrs[k]})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
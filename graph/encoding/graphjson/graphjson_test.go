@@ -0,0 +1,261 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphjson
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/encoding"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+const weightedUndirected = `{
+	"directed": false,
+	"nodes": [
+		{
+			"id": 1
+		},
+		{
+			"id": 2
+		},
+		{
+			"id": 5
+		},
+		{
+			"id": 9
+		}
+	],
+	"edges": [
+		{
+			"from": 1,
+			"to": 2,
+			"weight": 0.5
+		},
+		{
+			"from": 1,
+			"to": 5,
+			"weight": 2
+		},
+		{
+			"from": 2,
+			"to": 5,
+			"weight": -3
+		}
+	]
+}`
+
+const directedAttributed = `{
+	"directed": true,
+	"nodes": [
+		{
+			"id": 0,
+			"attributes": {
+				"color": "red",
+				"label": "A"
+			}
+		},
+		{
+			"id": 3
+		}
+	],
+	"edges": [
+		{
+			"from": 0,
+			"to": 3
+		},
+		{
+			"from": 3,
+			"to": 0,
+			"attributes": {
+				"style": "dashed"
+			}
+		}
+	]
+}`
+
+func TestMarshalStable(t *testing.T) {
+	for _, edges := range [][]simple.WeightedEdge{
+		{
+			{F: simple.Node(1), T: simple.Node(2), W: 0.5},
+			{F: simple.Node(5), T: simple.Node(1), W: 2},
+			{F: simple.Node(2), T: simple.Node(5), W: -3},
+		},
+		{
+			{F: simple.Node(5), T: simple.Node(2), W: -3},
+			{F: simple.Node(2), T: simple.Node(1), W: 0.5},
+			{F: simple.Node(1), T: simple.Node(5), W: 2},
+		},
+	} {
+		g := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+		g.AddNode(simple.Node(9))
+		for _, e := range edges {
+			g.SetWeightedEdge(e)
+		}
+		for i := 0; i < 5; i++ {
+			b, err := Marshal(g)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := string(b); got != weightedUndirected {
+				t.Fatalf("unexpected encoding:\ngot:\n%s\nwant:\n%s", got, weightedUndirected)
+			}
+		}
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		dst  func() encoding.Builder
+		src  string
+	}{
+		{
+			name: "weighted undirected",
+			dst:  func() encoding.Builder { return newWeightedUndirectedGraph() },
+			src:  weightedUndirected,
+		},
+		{
+			name: "directed attributed",
+			dst:  func() encoding.Builder { return newAttrGraph(simple.NewDirectedGraph()) },
+			src:  directedAttributed,
+		},
+	} {
+		dst := test.dst()
+		err := Unmarshal([]byte(test.src), dst)
+		if err != nil {
+			t.Errorf("unexpected error unmarshaling %q: %v", test.name, err)
+			continue
+		}
+		b, err := Marshal(dst)
+		if err != nil {
+			t.Errorf("unexpected error marshaling %q: %v", test.name, err)
+			continue
+		}
+		if got := string(b); got != test.src {
+			t.Errorf("unexpected round trip for %q:\ngot:\n%s\nwant:\n%s", test.name, got, test.src)
+		}
+	}
+}
+
+func TestUnmarshalNewNode(t *testing.T) {
+	// Wrapping the graph hides its NodeWithID method,
+	// so node IDs are allocated by NewNode.
+	const src = `{"nodes": [{"id": 10}, {"id": 20}], "edges": [{"from": 20, "to": 10}]}`
+	g := simple.NewDirectedGraph()
+	err := Unmarshal([]byte(src), struct{ encoding.Builder }{g})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if g.Nodes().Len() != 2 || g.Node(0) == nil || g.Node(1) == nil || !g.HasEdgeFromTo(1, 0) {
+		t.Errorf("unexpected graph: nodes:%v edges:%v", graph.NodesOf(g.Nodes()), graph.EdgesOf(g.Edges()))
+	}
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		src  string
+		want string
+	}{
+		{
+			name: "duplicate node",
+			src:  `{"nodes": [{"id": 1}, {"id": 1}]}`,
+			want: "graphjson: duplicate node ID 1",
+		},
+		{
+			name: "missing from",
+			src:  `{"nodes": [{"id": 1}], "edges": [{"from": 2, "to": 1}]}`,
+			want: "graphjson: edge from node 2 not in graph",
+		},
+		{
+			name: "missing to",
+			src:  `{"nodes": [{"id": 1}], "edges": [{"from": 1, "to": 2}]}`,
+			want: "graphjson: edge to node 2 not in graph",
+		},
+		{
+			name: "self loop",
+			src:  `{"nodes": [{"id": 1}], "edges": [{"from": 1, "to": 1}]}`,
+			want: "graphjson: panic building graph: simple: adding self edge",
+		},
+	} {
+		err := Unmarshal([]byte(test.src), simple.NewDirectedGraph())
+		if err == nil || err.Error() != test.want {
+			t.Errorf("unexpected error for %q: got:%v want:%s", test.name, err, test.want)
+		}
+	}
+
+	g := simple.NewWeightedDirectedGraph(0, 0)
+	g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(0), T: simple.Node(1), W: math.Inf(1)})
+	_, err := Marshal(g)
+	if err == nil {
+		t.Error("expected error for infinite weight")
+	}
+}
+
+// attrGraph is an encoding.Builder creating nodes and
+// edges that hold attributes.
+type attrGraph struct {
+	*simple.DirectedGraph
+}
+
+func newAttrGraph(g *simple.DirectedGraph) attrGraph {
+	return attrGraph{DirectedGraph: g}
+}
+
+func (g attrGraph) NodeWithID(id int64) (graph.Node, bool) {
+	n, new := g.DirectedGraph.NodeWithID(id)
+	if !new {
+		return n, false
+	}
+	return &attrNode{Node: n}, true
+}
+
+func (g attrGraph) NewNode() graph.Node {
+	return &attrNode{Node: g.DirectedGraph.NewNode()}
+}
+
+func (g attrGraph) NewEdge(from, to graph.Node) graph.Edge {
+	return &attrEdge{Edge: g.DirectedGraph.NewEdge(from, to)}
+}
+
+// weightedUndirectedGraph is a shim allowing a weighted graph
+// to be used as an encoding.Builder.
+type weightedUndirectedGraph struct {
+	*simple.WeightedUndirectedGraph
+}
+
+func newWeightedUndirectedGraph() weightedUndirectedGraph {
+	return weightedUndirectedGraph{simple.NewWeightedUndirectedGraph(0, math.Inf(1))}
+}
+
+func (g weightedUndirectedGraph) NewEdge(from, to graph.Node) graph.Edge {
+	return g.NewWeightedEdge(from, to, math.NaN())
+}
+
+func (g weightedUndirectedGraph) SetEdge(e graph.Edge) {
+	g.SetWeightedEdge(e.(graph.WeightedEdge))
+}
+
+type attrNode struct {
+	graph.Node
+	attrs encoding.Attributes
+}
+
+func (n *attrNode) Attributes() []encoding.Attribute           { return n.attrs }
+func (n *attrNode) SetAttribute(attr encoding.Attribute) error { return n.attrs.SetAttribute(attr) }
+
+type attrEdge struct {
+	graph.Edge
+	attrs encoding.Attributes
+}
+
+func (e *attrEdge) Attributes() []encoding.Attribute           { return e.attrs }
+func (e *attrEdge) SetAttribute(attr encoding.Attribute) error { return e.attrs.SetAttribute(attr) }
+
+func (e *attrEdge) ReversedEdge() graph.Edge {
+	return &attrEdge{Edge: e.Edge.ReversedEdge(), attrs: e.attrs}
+}
@@ -0,0 +1,214 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphml
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/encoding"
+)
+
+// Unmarshal parses the GraphML-encoded data and stores the result in dst.
+// If the number of graphs encoded in data is not one, an error is returned and
+// dst will hold the first graph in data.
+//
+// Node and edge data are set as attributes with the attr.name of their key on
+// nodes and edges that implement encoding.AttributeSetter, with key defaults
+// applied to elements without the data. Nodes that implement GraphMLIDSetter
+// have their GraphML ID set. Both directed and undirected edgedefault values
+// are accepted, with the direction of the edges determined by dst, but graphs
+// with a mix of directed and undirected edges are not supported.
+//
+// If dst implements graph.WeightedEdgeAdder, edges are created and added with
+// NewWeightedEdge and SetWeightedEdge. The weight of each edge is obtained
+// from the numeric edge key named "weight" if one is declared, with a weight
+// of 1 for edges without a weight, and is not set as an attribute.
+func Unmarshal(data []byte, dst encoding.Builder) error {
+	var doc document
+	err := xml.Unmarshal(data, &doc)
+	if err != nil {
+		return err
+	}
+	if len(doc.Graphs) == 0 {
+		return errors.New("graphml: no graph")
+	}
+	err = copyGraph(dst, doc.Keys, doc.Graphs[0])
+	if err == nil && len(doc.Graphs) != 1 {
+		err = fmt.Errorf("graphml: invalid number of graphs; expected 1, got %d", len(doc.Graphs))
+	}
+	return err
+}
+
+// copyGraph copies the nodes and edges from the GraphML source graph
+// to the destination graph.
+func copyGraph(dst encoding.Builder, keys []key, src graphElement) (err error) {
+	defer func() {
+		switch e := recover().(type) {
+		case nil:
+		case error:
+			err = e
+		default:
+			err = fmt.Errorf("graphml: panic building graph: %v", e)
+		}
+	}()
+
+	var directed bool
+	switch src.EdgeDefault {
+	case "directed":
+		directed = true
+	case "undirected":
+	default:
+		return fmt.Errorf("graphml: invalid edgedefault %q", src.EdgeDefault)
+	}
+
+	var nodeKeys, edgeKeys []key
+	var weight *key
+	for _, k := range keys {
+		switch k.For {
+		case "node":
+			nodeKeys = append(nodeKeys, k)
+		case "edge":
+			edgeKeys = append(edgeKeys, k)
+		case "all":
+			nodeKeys = append(nodeKeys, k)
+			edgeKeys = append(edgeKeys, k)
+		default:
+			continue
+		}
+		if k.For != "node" && k.Name == weightKey && isNumeric(k.Type) {
+			weight = &k
+		}
+	}
+	wdst, isWeighted := dst.(graph.WeightedEdgeAdder)
+	if !isWeighted {
+		weight = nil
+	}
+
+	ids := make(map[string]graph.Node, len(src.Nodes))
+	for _, n := range src.Nodes {
+		if _, exists := ids[n.ID]; exists {
+			return fmt.Errorf("graphml: duplicate node ID %q", n.ID)
+		}
+		u := dst.NewNode()
+		if s, ok := u.(GraphMLIDSetter); ok {
+			s.SetGraphMLID(n.ID)
+		}
+		dst.AddNode(u)
+		ids[n.ID] = u
+		err = setAttributes(u, nodeKeys, n.Data, nil)
+		if err != nil {
+			return fmt.Errorf("graphml: unable to unmarshal node %q data: %w", n.ID, err)
+		}
+	}
+
+	for _, e := range src.Edges {
+		switch e.Directed {
+		case "":
+		case "true", "false":
+			if (e.Directed == "true") != directed {
+				return fmt.Errorf("graphml: mixed edge directions at edge %s--%s", e.Source, e.Target)
+			}
+		default:
+			return fmt.Errorf("graphml: invalid edge directed value %q", e.Directed)
+		}
+		u, ok := ids[e.Source]
+		if !ok {
+			return fmt.Errorf("graphml: edge source %q not in graph", e.Source)
+		}
+		v, ok := ids[e.Target]
+		if !ok {
+			return fmt.Errorf("graphml: edge target %q not in graph", e.Target)
+		}
+
+		var edge graph.Edge
+		if isWeighted {
+			w := 1.0
+			if weight != nil {
+				w, err = edgeWeight(*weight, e.Data)
+				if err != nil {
+					return fmt.Errorf("graphml: invalid weight for edge %s--%s: %w", e.Source, e.Target, err)
+				}
+			}
+			we := wdst.NewWeightedEdge(u, v, w)
+			edge = we
+			err = setAttributes(edge, edgeKeys, e.Data, weight)
+			wdst.SetWeightedEdge(we)
+		} else {
+			edge = dst.NewEdge(u, v)
+			err = setAttributes(edge, edgeKeys, e.Data, nil)
+			dst.SetEdge(edge)
+		}
+		if err != nil {
+			return fmt.Errorf("graphml: unable to unmarshal edge %s--%s data: %w", e.Source, e.Target, err)
+		}
+	}
+	return nil
+}
+
+// setAttributes sets the values in d and the defaults of the keys not in
+// d as attributes of x if it is an encoding.AttributeSetter, in the order
+// of keys. Data for the skip key is not set.
+func setAttributes(x any, keys []key, d []data, skip *key) error {
+	s, ok := x.(encoding.AttributeSetter)
+	if !ok {
+		return nil
+	}
+	values := make(map[string]string, len(d))
+	for _, v := range d {
+		values[v.Key] = v.Value
+	}
+	for _, k := range keys {
+		v, ok := values[k.ID]
+		if ok {
+			delete(values, k.ID)
+		} else if k.Default != nil {
+			v = strings.TrimSpace(*k.Default)
+		} else {
+			continue
+		}
+		if skip != nil && k.ID == skip.ID {
+			continue
+		}
+		err := s.SetAttribute(encoding.Attribute{Key: k.Name, Value: v})
+		if err != nil {
+			return err
+		}
+	}
+	for _, v := range d {
+		if _, ok := values[v.Key]; ok {
+			return fmt.Errorf("undeclared key %q", v.Key)
+		}
+	}
+	return nil
+}
+
+// edgeWeight returns the weight held in d for the weight key k, or the
+// weight key default if d has no weight. If there is neither, the weight
+// is 1.
+func edgeWeight(k key, d []data) (float64, error) {
+	for _, v := range d {
+		if v.Key == k.ID {
+			return strconv.ParseFloat(strings.TrimSpace(v.Value), 64)
+		}
+	}
+	if k.Default != nil {
+		return strconv.ParseFloat(strings.TrimSpace(*k.Default), 64)
+	}
+	return 1, nil
+}
+
+// isNumeric returns whether the GraphML attr.type typ is numeric.
+func isNumeric(typ string) bool {
+	switch typ {
+	case "int", "long", "float", "double":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,13 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package graphml implements XML marshaling and unmarshaling of graphs in the
+// GraphML format.
+//
+// GraphML data keys are mapped to encoding.Attribute keys by their attr.name,
+// and edge data for a numeric key named "weight" is used as the edge weight.
+// Nested graphs, hyperedges, ports and graph data are not supported.
+//
+// For details of GraphML see http://graphml.graphdrawing.org/.
+package graphml // import "gonum.org/v1/gonum/graph/encoding/graphml"
@@ -0,0 +1,177 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphml
+
+import (
+	"encoding/xml"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/encoding"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+// Marshal returns a GraphML encoding of the graph g.
+//
+// The edgedefault of the encoded graph is "directed" if g is a graph.Directed
+// and "undirected" otherwise. The GraphML IDs of nodes are obtained from the
+// GraphMLID method if the node is a Node, and are otherwise "n" followed by
+// the node's ID. Attributes of nodes and edges that implement
+// encoding.Attributer are encoded as string data with a key declared for each
+// attribute name. If g is a graph.Weighted, the weight of each edge is encoded
+// as double data with the key "weight", and edge attributes with the key
+// "weight" are not encoded.
+func Marshal(g graph.Graph) ([]byte, error) {
+	_, isDirected := g.(graph.Directed)
+	wg, isWeighted := g.(graph.Weighted)
+
+	nodes := graph.NodesOf(g.Nodes())
+	order.ByID(nodes)
+	ids := make(map[int64]string, len(nodes))
+	seen := make(map[string]bool, len(nodes))
+	for _, n := range nodes {
+		id := graphMLID(n)
+		if seen[id] {
+			return nil, fmt.Errorf("graphml: duplicate node ID %q", id)
+		}
+		seen[id] = true
+		ids[n.ID()] = id
+	}
+
+	// Collect the edges and the attribute
+	// names of the nodes and edges.
+	var edges []graph.Edge
+	nodeNames := make(map[string]bool)
+	edgeNames := make(map[string]bool)
+	for _, n := range nodes {
+		for _, a := range attributesOf(n) {
+			nodeNames[a.Key] = true
+		}
+		uid := n.ID()
+		to := graph.NodesOf(g.From(uid))
+		order.ByID(to)
+		for _, v := range to {
+			vid := v.ID()
+			if !isDirected && vid < uid {
+				continue
+			}
+			e := g.Edge(uid, vid)
+			for _, a := range attributesOf(e) {
+				if isWeighted && a.Key == weightKey {
+					continue
+				}
+				edgeNames[a.Key] = true
+			}
+			edges = append(edges, e)
+		}
+	}
+
+	doc := document{NS: namespace}
+	nodeKeys := declareKeys(&doc, "node", nodeNames, "")
+	weightType := ""
+	if isWeighted {
+		weightType = "double"
+	}
+	edgeKeys := declareKeys(&doc, "edge", edgeNames, weightType)
+
+	dst := graphElement{EdgeDefault: "undirected"}
+	if isDirected {
+		dst.EdgeDefault = "directed"
+	}
+	for _, n := range nodes {
+		dst.Nodes = append(dst.Nodes, node{
+			ID:   ids[n.ID()],
+			Data: dataOf(attributesOf(n), nodeKeys),
+		})
+	}
+	for _, e := range edges {
+		uid := e.From().ID()
+		vid := e.To().ID()
+		attrs := attributesOf(e)
+		if isWeighted {
+			w, _ := wg.Weight(uid, vid)
+			// The weight replaces any weight attribute
+			// since later values take precedence.
+			attrs = append(slices.Clip(attrs), encoding.Attribute{
+				Key:   weightKey,
+				Value: strconv.FormatFloat(w, 'g', -1, 64),
+			})
+		}
+		dst.Edges = append(dst.Edges, edge{
+			Source: ids[uid],
+			Target: ids[vid],
+			Data:   dataOf(attrs, edgeKeys),
+		})
+	}
+	doc.Graphs = []graphElement{dst}
+
+	b, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), b...), nil
+}
+
+// graphMLID returns the GraphML ID of n.
+func graphMLID(n graph.Node) string {
+	if n, ok := n.(Node); ok {
+		return n.GraphMLID()
+	}
+	return "n" + strconv.FormatInt(n.ID(), 10)
+}
+
+// attributesOf returns the attributes of x if it is an encoding.Attributer.
+func attributesOf(x any) []encoding.Attribute {
+	a, ok := x.(encoding.Attributer)
+	if !ok {
+		return nil
+	}
+	return a.Attributes()
+}
+
+// declareKeys adds string keys for the given domain and attribute names
+// to doc, in lexical order of name, and returns the added keys. If
+// weightType is not empty, a weight key of that type is also added.
+func declareKeys(doc *document, domain string, names map[string]bool, weightType string) []key {
+	sorted := make([]string, 0, len(names)+1)
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	if weightType != "" {
+		sorted = append(sorted, weightKey)
+	}
+	slices.Sort(sorted)
+	keys := make([]key, len(sorted))
+	for i, name := range sorted {
+		typ := "string"
+		if name == weightKey && weightType != "" {
+			typ = weightType
+		}
+		keys[i] = key{ID: "d" + strconv.Itoa(len(doc.Keys)), For: domain, Name: name, Type: typ}
+		doc.Keys = append(doc.Keys, keys[i])
+	}
+	return keys
+}
+
+// dataOf returns the GraphML data elements for attrs in the order of
+// the given keys.
+func dataOf(attrs []encoding.Attribute, keys []key) []data {
+	if len(attrs) == 0 {
+		return nil
+	}
+	values := make(map[string]string, len(attrs))
+	for _, a := range attrs {
+		values[a.Key] = a.Value
+	}
+	d := make([]data, 0, len(attrs))
+	for _, k := range keys {
+		if v, ok := values[k.Name]; ok {
+			d = append(d, data{Key: k.ID, Value: v})
+		}
+	}
+	return d
+}
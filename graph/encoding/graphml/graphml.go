@@ -0,0 +1,84 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphml
+
+import (
+	"encoding/xml"
+
+	"gonum.org/v1/gonum/graph"
+)
+
+// Node is a graph.Node with a GraphML ID.
+type Node interface {
+	graph.Node
+
+	// GraphMLID returns the GraphML ID of the node.
+	// The ID must be unique within the graph.
+	GraphMLID() string
+}
+
+// GraphMLIDSetter is implemented by types that can set a GraphML ID.
+type GraphMLIDSetter interface {
+	SetGraphMLID(id string)
+}
+
+const (
+	// namespace is the GraphML XML namespace.
+	namespace = "http://graphml.graphdrawing.org/xmlns"
+
+	// weightKey is the attr.name of the edge weight key.
+	weightKey = "weight"
+)
+
+// document is a GraphML document.
+type document struct {
+	XMLName xml.Name       `xml:"graphml"`
+	NS      string         `xml:"xmlns,attr,omitempty"`
+	Keys    []key          `xml:"key"`
+	Graphs  []graphElement `xml:"graph"`
+}
+
+// key is a GraphML data key declaration.
+type key struct {
+	ID string `xml:"id,attr"`
+	// For may be one of "graph", "node", "edge" or "all"
+	// for the domains supported by this package.
+	For  string `xml:"for,attr"`
+	Name string `xml:"attr.name,attr,omitempty"`
+	// Type may be one of "boolean", "int", "long",
+	// "float", "double" or "string".
+	Type    string  `xml:"attr.type,attr,omitempty"`
+	Default *string `xml:"default"`
+}
+
+// graphElement is a GraphML graph element.
+type graphElement struct {
+	ID string `xml:"id,attr,omitempty"`
+	// EdgeDefault may be "directed" or "undirected".
+	EdgeDefault string `xml:"edgedefault,attr"`
+	Nodes       []node `xml:"node"`
+	Edges       []edge `xml:"edge"`
+}
+
+// node is a GraphML node element.
+type node struct {
+	ID   string `xml:"id,attr"`
+	Data []data `xml:"data"`
+}
+
+// edge is a GraphML edge element.
+type edge struct {
+	ID       string `xml:"id,attr,omitempty"`
+	Source   string `xml:"source,attr"`
+	Target   string `xml:"target,attr"`
+	Directed string `xml:"directed,attr,omitempty"`
+	Data     []data `xml:"data"`
+}
+
+// data is a GraphML data element.
+type data struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
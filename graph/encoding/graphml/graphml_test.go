@@ -0,0 +1,349 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphml
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/encoding"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+const undirectedAttributed = `<?xml version="1.0" encoding="UTF-8"?>
+<graphml xmlns="http://graphml.graphdrawing.org/xmlns">
+  <key id="d0" for="node" attr.name="color" attr.type="string"></key>
+  <key id="d1" for="node" attr.name="label" attr.type="string"></key>
+  <key id="d2" for="edge" attr.name="style" attr.type="string"></key>
+  <graph edgedefault="undirected">
+    <node id="a">
+      <data key="d0">red</data>
+      <data key="d1">A</data>
+    </node>
+    <node id="b">
+      <data key="d1">B</data>
+    </node>
+    <node id="c"></node>
+    <edge source="a" target="b">
+      <data key="d2">dashed</data>
+    </edge>
+    <edge source="a" target="c"></edge>
+    <edge source="b" target="c"></edge>
+  </graph>
+</graphml>`
+
+const directedWeighted = `<?xml version="1.0" encoding="UTF-8"?>
+<graphml xmlns="http://graphml.graphdrawing.org/xmlns">
+  <key id="d0" for="edge" attr.name="weight" attr.type="double"></key>
+  <graph edgedefault="directed">
+    <node id="n0"></node>
+    <node id="n1"></node>
+    <node id="n2"></node>
+    <edge source="n0" target="n1">
+      <data key="d0">0.5</data>
+    </edge>
+    <edge source="n1" target="n2">
+      <data key="d0">2</data>
+    </edge>
+    <edge source="n2" target="n0">
+      <data key="d0">-1.25</data>
+    </edge>
+  </graph>
+</graphml>`
+
+func TestRoundTrip(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		dst  func() encoding.Builder
+		src  string
+	}{
+		{
+			name: "undirected attributed",
+			dst:  func() encoding.Builder { return newAttrGraph(simple.NewUndirectedGraph()) },
+			src:  undirectedAttributed,
+		},
+		{
+			name: "directed weighted",
+			dst:  func() encoding.Builder { return newWeightedDirectedGraph() },
+			src:  directedWeighted,
+		},
+	} {
+		dst := test.dst()
+		err := Unmarshal([]byte(test.src), dst)
+		if err != nil {
+			t.Errorf("unexpected error unmarshaling %q: %v", test.name, err)
+			continue
+		}
+		b, err := Marshal(dst)
+		if err != nil {
+			t.Errorf("unexpected error marshaling %q: %v", test.name, err)
+			continue
+		}
+		if got := string(b); got != test.src {
+			t.Errorf("unexpected round trip for %q:\ngot:\n%s\nwant:\n%s", test.name, got, test.src)
+		}
+	}
+}
+
+func TestUnmarshal(t *testing.T) {
+	// The document has no namespace, uses a key for all
+	// domains, a default value and an int weight key.
+	const src = `<graphml>
+  <key id="w" for="all" attr.name="weight" attr.type="int">
+    <default>3</default>
+  </key>
+  <key id="k" for="edge" attr.name="kind" attr.type="string">
+    <default>plain</default>
+  </key>
+  <graph id="G" edgedefault="undirected">
+    <node id="x"><data key="w">7</data></node>
+    <node id="y"/>
+    <node id="z"/>
+    <edge source="x" target="y" directed="false"><data key="w">4</data></edge>
+    <edge source="y" target="z"><data key="k">bold</data></edge>
+  </graph>
+</graphml>`
+
+	t.Run("weighted", func(t *testing.T) {
+		dst := newWeightedUndirectedGraph()
+		err := Unmarshal([]byte(src), dst)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		for _, want := range []struct {
+			u, v   int64
+			weight float64
+		}{
+			{u: 0, v: 1, weight: 4},
+			{u: 1, v: 2, weight: 3},
+		} {
+			w, ok := dst.Weight(want.u, want.v)
+			if !ok || w != want.weight {
+				t.Errorf("unexpected weight for edge %d--%d: got:%v want:%v", want.u, want.v, w, want.weight)
+			}
+		}
+		if got, want := attrsOf(dst.Node(0)), "[{weight 7}]"; got != want {
+			t.Errorf("unexpected node attributes: got:%s want:%s", got, want)
+		}
+		if got, want := attrsOf(dst.Edge(1, 2)), "[{kind bold}]"; got != want {
+			t.Errorf("unexpected edge attributes: got:%s want:%s", got, want)
+		}
+		if got, want := attrsOf(dst.Edge(0, 1)), "[{kind plain}]"; got != want {
+			t.Errorf("unexpected edge default attributes: got:%s want:%s", got, want)
+		}
+	})
+
+	t.Run("unweighted", func(t *testing.T) {
+		dst := newAttrGraph(simple.NewUndirectedGraph())
+		err := Unmarshal([]byte(src), dst)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got, want := attrsOf(dst.Edge(0, 1)), "[{weight 4} {kind plain}]"; got != want {
+			t.Errorf("unexpected edge attributes: got:%s want:%s", got, want)
+		}
+		if got, want := attrsOf(dst.Edge(1, 2)), "[{weight 3} {kind bold}]"; got != want {
+			t.Errorf("unexpected edge attributes: got:%s want:%s", got, want)
+		}
+	})
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		src  string
+		want string
+	}{
+		{
+			name: "no graph",
+			src:  `<graphml></graphml>`,
+			want: "graphml: no graph",
+		},
+		{
+			name: "two graphs",
+			src:  `<graphml><graph edgedefault="directed"/><graph edgedefault="directed"/></graphml>`,
+			want: "graphml: invalid number of graphs; expected 1, got 2",
+		},
+		{
+			name: "edgedefault",
+			src:  `<graphml><graph edgedefault="sideways"/></graphml>`,
+			want: `graphml: invalid edgedefault "sideways"`,
+		},
+		{
+			name: "duplicate node",
+			src:  `<graphml><graph edgedefault="directed"><node id="a"/><node id="a"/></graph></graphml>`,
+			want: `graphml: duplicate node ID "a"`,
+		},
+		{
+			name: "missing node",
+			src:  `<graphml><graph edgedefault="directed"><node id="a"/><edge source="a" target="b"/></graph></graphml>`,
+			want: `graphml: edge target "b" not in graph`,
+		},
+		{
+			name: "mixed",
+			src:  `<graphml><graph edgedefault="directed"><node id="a"/><node id="b"/><edge source="a" target="b" directed="false"/></graph></graphml>`,
+			want: "graphml: mixed edge directions at edge a--b",
+		},
+		{
+			name: "undeclared key",
+			src:  `<graphml><graph edgedefault="directed"><node id="a"><data key="d9">x</data></node></graph></graphml>`,
+			want: `graphml: unable to unmarshal node "a" data: undeclared key "d9"`,
+		},
+		{
+			name: "self loop",
+			src:  `<graphml><graph edgedefault="directed"><node id="a"/><edge source="a" target="a"/></graph></graphml>`,
+			want: "graphml: panic building graph: simple: adding self edge",
+		},
+	} {
+		err := Unmarshal([]byte(test.src), newAttrGraph(simple.NewDirectedGraph()))
+		if err == nil || err.Error() != test.want {
+			t.Errorf("unexpected error for %q: got:%v want:%s", test.name, err, test.want)
+		}
+	}
+
+	const badWeight = `<graphml>
+  <key id="w" for="edge" attr.name="weight" attr.type="double"/>
+  <graph edgedefault="undirected">
+    <node id="a"/><node id="b"/>
+    <edge source="a" target="b"><data key="w">heavy</data></edge>
+  </graph>
+</graphml>`
+	err := Unmarshal([]byte(badWeight), newWeightedUndirectedGraph())
+	if err == nil {
+		t.Error("expected error for invalid weight")
+	}
+}
+
+func TestMarshalWeighted(t *testing.T) {
+	g := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+	g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(1), T: simple.Node(0), W: 1.5})
+	g.AddNode(simple.Node(2))
+	b, err := Marshal(g)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	const want = `<?xml version="1.0" encoding="UTF-8"?>
+<graphml xmlns="http://graphml.graphdrawing.org/xmlns">
+  <key id="d0" for="edge" attr.name="weight" attr.type="double"></key>
+  <graph edgedefault="undirected">
+    <node id="n0"></node>
+    <node id="n1"></node>
+    <node id="n2"></node>
+    <edge source="n0" target="n1">
+      <data key="d0">1.5</data>
+    </edge>
+  </graph>
+</graphml>`
+	if got := string(b); got != want {
+		t.Errorf("unexpected encoding:\ngot:\n%s\nwant:\n%s", got, want)
+	}
+
+	dup := simple.NewUndirectedGraph()
+	dup.AddNode(&attrNode{Node: simple.Node(0), id: "a"})
+	dup.AddNode(&attrNode{Node: simple.Node(1), id: "a"})
+	_, err = Marshal(dup)
+	if err == nil {
+		t.Error("expected error for duplicate GraphML ID")
+	}
+}
+
+func attrsOf(x any) string {
+	return fmt.Sprint(x.(encoding.Attributer).Attributes())
+}
+
+// attrGraph is an encoding.Builder creating nodes and edges
+// that hold attributes and GraphML IDs.
+type attrGraph struct {
+	encoding.Builder
+}
+
+func newAttrGraph(g encoding.Builder) attrGraph {
+	return attrGraph{Builder: g}
+}
+
+func (g attrGraph) NewNode() graph.Node {
+	return &attrNode{Node: g.Builder.NewNode()}
+}
+
+func (g attrGraph) NewEdge(from, to graph.Node) graph.Edge {
+	return &attrEdge{Edge: g.Builder.NewEdge(from, to)}
+}
+
+// weightedUndirectedGraph is a shim allowing a weighted graph
+// to be used as an encoding.Builder.
+type weightedUndirectedGraph struct {
+	*simple.WeightedUndirectedGraph
+}
+
+func newWeightedUndirectedGraph() weightedUndirectedGraph {
+	return weightedUndirectedGraph{simple.NewWeightedUndirectedGraph(0, math.Inf(1))}
+}
+
+func (g weightedUndirectedGraph) NewNode() graph.Node {
+	return &attrNode{Node: g.WeightedUndirectedGraph.NewNode()}
+}
+
+func (g weightedUndirectedGraph) NewWeightedEdge(from, to graph.Node, w float64) graph.WeightedEdge {
+	return &attrEdge{Edge: g.WeightedUndirectedGraph.NewWeightedEdge(from, to, w)}
+}
+
+func (g weightedUndirectedGraph) NewEdge(from, to graph.Node) graph.Edge {
+	return g.NewWeightedEdge(from, to, math.NaN())
+}
+
+func (g weightedUndirectedGraph) SetEdge(e graph.Edge) {
+	g.SetWeightedEdge(e.(graph.WeightedEdge))
+}
+
+// weightedDirectedGraph is a shim allowing a weighted graph
+// to be used as an encoding.Builder.
+type weightedDirectedGraph struct {
+	*simple.WeightedDirectedGraph
+}
+
+func newWeightedDirectedGraph() weightedDirectedGraph {
+	return weightedDirectedGraph{simple.NewWeightedDirectedGraph(0, math.Inf(1))}
+}
+
+func (g weightedDirectedGraph) NewNode() graph.Node {
+	return &attrNode{Node: g.WeightedDirectedGraph.NewNode()}
+}
+
+func (g weightedDirectedGraph) NewEdge(from, to graph.Node) graph.Edge {
+	return g.NewWeightedEdge(from, to, math.NaN())
+}
+
+func (g weightedDirectedGraph) SetEdge(e graph.Edge) {
+	g.SetWeightedEdge(e.(graph.WeightedEdge))
+}
+
+type attrNode struct {
+	graph.Node
+	id    string
+	attrs encoding.Attributes
+}
+
+func (n *attrNode) GraphMLID() string                          { return n.id }
+func (n *attrNode) SetGraphMLID(id string)                     { n.id = id }
+func (n *attrNode) Attributes() []encoding.Attribute           { return n.attrs }
+func (n *attrNode) SetAttribute(attr encoding.Attribute) error { return n.attrs.SetAttribute(attr) }
+
+type attrEdge struct {
+	graph.Edge
+	attrs encoding.Attributes
+}
+
+func (e *attrEdge) Attributes() []encoding.Attribute           { return e.attrs }
+func (e *attrEdge) SetAttribute(attr encoding.Attribute) error { return e.attrs.SetAttribute(attr) }
+
+func (e *attrEdge) Weight() float64 {
+	return e.Edge.(graph.WeightedEdge).Weight()
+}
+
+func (e *attrEdge) ReversedEdge() graph.Edge {
+	return &attrEdge{Edge: e.Edge.ReversedEdge(), attrs: e.attrs}
+}
@@ -0,0 +1,277 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package flow
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+// randomFlowTests compare the flow and cut algorithms with reference
+// implementations on random networks.
+var randomFlowTests = []struct {
+	name   string
+	trials int
+
+	// test checks the algorithm on a random network.
+	test func(t *testing.T, rnd *rand.Rand)
+}{
+	{
+		name:   "MaxFlowDinic",
+		trials: 50,
+		test: func(t *testing.T, rnd *rand.Rand) {
+			g := randomNetwork(20, 0.2, 10, rnd)
+			value, flow := MaxFlowDinic(g, simple.Node(0), simple.Node(19))
+			checkFlow(t, "random", g, 0, 19, value, flow)
+			if want := edmondsKarp(g, 0, 19); value != want {
+				t.Errorf("unexpected flow value: got:%v want:%v", value, want)
+			}
+		},
+	},
+	{
+		name:   "MinCut",
+		trials: 50,
+		test: func(t *testing.T, rnd *rand.Rand) {
+			g := randomNetwork(20, 0.2, 10, rnd)
+			sSide, tSide, cut, value := MinCut(g, simple.Node(0), simple.Node(19))
+			if want := edmondsKarp(g, 0, 19); value != want {
+				t.Errorf("unexpected cut value: got:%v want:%v", value, want)
+			}
+			checkCut(t, "random", g, 0, 19, sSide, tSide, cut, value)
+		},
+	},
+	{
+		name:   "GlobalMinCut",
+		trials: 100,
+		test: func(t *testing.T, rnd *rand.Rand) {
+			n := 2 + rnd.IntN(8)
+			g := randomUndirectedNetwork(n, 0.5, 10, rnd)
+			partition, weight := GlobalMinCut(g)
+			if len(partition) == 0 || len(partition) == n {
+				t.Errorf("partition is not a cut: %v", partition)
+			}
+			if got := cutWeight(g, partition); got != weight {
+				t.Errorf("weight does not match partition: got:%v want:%v", weight, got)
+			}
+			if want := bruteMinCut(g, n); weight != want {
+				t.Errorf("unexpected weight: got:%v want:%v", weight, want)
+			}
+		},
+	},
+	{
+		name:   "MinCostMaxFlow",
+		trials: 50,
+		test: func(t *testing.T, rnd *rand.Rand) {
+			g := randomNetwork(15, 0.25, 5, rnd)
+			// Costs are drawn in edge order so that they do
+			// not depend on the order of cost queries.
+			costs := make(map[[2]int64]float64)
+			for u := int64(0); u < 15; u++ {
+				for v := int64(0); v < 15; v++ {
+					if g.HasEdgeFromTo(u, v) {
+						costs[[2]int64{u, v}] = float64(rnd.IntN(10))
+					}
+				}
+			}
+			cost := func(u, v int64) float64 {
+				return costs[[2]int64{u, v}]
+			}
+			value, total, flow := MinCostMaxFlow(g, cost, simple.Node(0), simple.Node(14))
+			checkFlow(t, "random", g, 0, 14, value, flow)
+			if want := edmondsKarp(g, 0, 14); value != want {
+				t.Errorf("unexpected flow value: got:%v want:%v", value, want)
+			}
+			if got := flowCost(flow, cost); got != total {
+				t.Errorf("cost does not match flow: got:%v want:%v", total, got)
+			}
+			checkMinCost(t, "random", g, cost, flow)
+		},
+	},
+}
+
+func TestFlowRandom(t *testing.T) {
+	t.Parallel()
+	for _, test := range randomFlowTests {
+		rnd := rand.New(rand.NewPCG(1, 1))
+		for trial := 0; trial < test.trials; trial++ {
+			test.test(t, rnd)
+		}
+	}
+}
+
+// checkFlow checks that flow is a valid flow of the given value from s to t in g.
+func checkFlow(t *testing.T, name string, g graph.WeightedDirected, s, tid int64, value float64, flow map[[2]int64]float64) {
+	t.Helper()
+	net := make(map[int64]float64)
+	for e, f := range flow {
+		c, ok := g.Weight(e[0], e[1])
+		if !ok || e[0] == e[1] {
+			t.Errorf("flow for %q along non-edge %d->%d", name, e[0], e[1])
+			continue
+		}
+		if f <= 0 || f > c {
+			t.Errorf("invalid flow for %q along %d->%d: %v with capacity %v", name, e[0], e[1], f, c)
+		}
+		net[e[0]] -= f
+		net[e[1]] += f
+	}
+	for id, f := range net {
+		switch id {
+		case s:
+			if f != -value {
+				t.Errorf("unexpected net flow for %q from source: got:%v want:%v", name, -f, value)
+			}
+		case tid:
+			if f != value {
+				t.Errorf("unexpected net flow for %q into sink: got:%v want:%v", name, f, value)
+			}
+		default:
+			if f != 0 {
+				t.Errorf("flow not conserved for %q at %d: %v", name, id, f)
+			}
+		}
+	}
+}
+
+// randomNetwork returns a random directed graph on n nodes with integer edge
+// capacities in [1, maxCap] and edge probability p.
+func randomNetwork(n int, p float64, maxCap int, rnd *rand.Rand) *simple.WeightedDirectedGraph {
+	g := simple.NewWeightedDirectedGraph(0, 0)
+	for i := 0; i < n; i++ {
+		g.AddNode(simple.Node(i))
+	}
+	for u := 0; u < n; u++ {
+		for v := 0; v < n; v++ {
+			if u != v && rnd.Float64() < p {
+				g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(u), T: simple.Node(v), W: float64(1 + rnd.IntN(maxCap))})
+			}
+		}
+	}
+	return g
+}
+
+// randomUndirectedNetwork returns a random undirected graph on n nodes with
+// integer edge weights in [1, maxWeight] and edge probability p.
+func randomUndirectedNetwork(n int, p float64, maxWeight int, rnd *rand.Rand) *simple.WeightedUndirectedGraph {
+	g := simple.NewWeightedUndirectedGraph(0, 0)
+	for i := 0; i < n; i++ {
+		g.AddNode(simple.Node(i))
+	}
+	for u := 0; u < n; u++ {
+		for v := u + 1; v < n; v++ {
+			if rnd.Float64() < p {
+				g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(u), T: simple.Node(v), W: float64(1 + rnd.IntN(maxWeight))})
+			}
+		}
+	}
+	return g
+}
+
+// edmondsKarp returns the value of a maximum flow from s to t in g using the
+// Edmonds-Karp shortest augmenting path algorithm. It is used as a reference
+// for testing and benchmarking.
+func edmondsKarp(g graph.WeightedDirected, sid, tid int64) float64 {
+	r := newResidual(g)
+	s, t := r.indexOf[sid], r.indexOf[tid]
+	type step struct{ from, arc int }
+	var value float64
+	for {
+		prev := make([]step, len(r.nodes))
+		for i := range prev {
+			prev[i].from = -1
+		}
+		prev[s].from = s
+		queue := []int{s}
+		for len(queue) != 0 && prev[t].from < 0 {
+			u := queue[0]
+			queue = queue[1:]
+			for i, a := range r.arcs[u] {
+				if a.cap > 0 && prev[a.to].from < 0 {
+					prev[a.to] = step{from: u, arc: i}
+					queue = append(queue, a.to)
+				}
+			}
+		}
+		if prev[t].from < 0 {
+			return value
+		}
+		f := math.Inf(1)
+		for v := t; v != s; v = prev[v].from {
+			f = math.Min(f, r.arcs[prev[v].from][prev[v].arc].cap)
+		}
+		for v := t; v != s; v = prev[v].from {
+			a := &r.arcs[prev[v].from][prev[v].arc]
+			a.cap -= f
+			r.arcs[a.to][a.rev].cap += f
+		}
+		value += f
+	}
+}
+
+// cutWeight returns the weight of the edges of g crossing the partition.
+func cutWeight(g *simple.WeightedUndirectedGraph, partition []graph.Node) float64 {
+	in := make(map[int64]bool)
+	for _, n := range partition {
+		in[n.ID()] = true
+	}
+	var w float64
+	for _, e := range graph.WeightedEdgesOf(g.WeightedEdges()) {
+		if in[e.From().ID()] != in[e.To().ID()] {
+			w += e.Weight()
+		}
+	}
+	return w
+}
+
+// bruteMinCut returns the minimum cut weight of g with nodes [0, n)
+// by exhaustive search of all partitions.
+func bruteMinCut(g *simple.WeightedUndirectedGraph, n int) float64 {
+	best := math.Inf(1)
+	for mask := 1; mask < 1<<(n-1); mask++ {
+		var partition []graph.Node
+		for i := 0; i < n; i++ {
+			if mask&(1<<i) != 0 {
+				partition = append(partition, simple.Node(i))
+			}
+		}
+		best = math.Min(best, cutWeight(g, partition))
+	}
+	return best
+}
+
+// checkMinCost checks that flow is a minimum cost flow in g for its value.
+// A flow has minimum cost if and only if its residual network has no negative
+// cost cycle. The node IDs of g must be consecutive from zero.
+func checkMinCost(t *testing.T, name string, g *simple.WeightedDirectedGraph, cost func(u, v int64) float64, flow map[[2]int64]float64) {
+	t.Helper()
+	n := g.Nodes().Len()
+	f := costNetwork{
+		adj:       make([][]int, n),
+		potential: make([]float64, n),
+	}
+	for u := int64(0); u < int64(n); u++ {
+		to := g.From(u)
+		for to.Next() {
+			v := to.Node().ID()
+			c, _ := g.Weight(u, v)
+			f.addArc(int(u), int(v), c-flow[[2]int64{u, v}], cost(u, v))
+			f.arcs[len(f.arcs)-1].cap = flow[[2]int64{u, v}]
+		}
+	}
+	for s := range n {
+		func() {
+			defer func() {
+				if r := recover(); r != nil {
+					t.Errorf("residual network for %q has a negative cost cycle from %d", name, s)
+				}
+			}()
+			f.initPotentials(s)
+		}()
+	}
+}
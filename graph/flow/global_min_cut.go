@@ -0,0 +1,130 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package flow
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+// GlobalMinCut returns a minimum weight cut of the undirected graph g, the
+// set of edges of least total weight whose removal disconnects g, using the
+// Stoer-Wagner maximum adjacency algorithm. The nodes on one side of the cut
+// are returned in partition, sorted by ID, and the total weight of the edges
+// crossing the cut is returned in weight. If g is disconnected, the returned
+// partition is a set of components of g and weight is zero.
+//
+// See Stoer and Wagner doi:10.1145/263867.263872 for details of the algorithm.
+// The time complexity of GlobalMinCut is O(|V|^3). If g has fewer than two
+// nodes, GlobalMinCut returns nil and +Inf. Self loops are ignored.
+// GlobalMinCut will panic if g has an edge with a negative or NaN weight.
+func GlobalMinCut(g graph.WeightedUndirected) (partition []graph.Node, weight float64) {
+	nodes := graph.NodesOf(g.Nodes())
+	n := len(nodes)
+	if n < 2 {
+		return nil, math.Inf(1)
+	}
+	order.ByID(nodes)
+	indexOf := make(map[int64]int, n)
+	for i, u := range nodes {
+		indexOf[u.ID()] = i
+	}
+
+	// w is the dense weight matrix of the
+	// graph with merged nodes.
+	w := make([][]float64, n)
+	for i, u := range nodes {
+		w[i] = make([]float64, n)
+		uid := u.ID()
+		to := g.From(uid)
+		for to.Next() {
+			vid := to.Node().ID()
+			if vid == uid {
+				continue
+			}
+			ew, ok := g.Weight(uid, vid)
+			if !ok {
+				panic("flow: unexpected invalid weight")
+			}
+			if ew < 0 || math.IsNaN(ew) {
+				panic("flow: invalid edge weight")
+			}
+			w[i][indexOf[vid]] = ew
+		}
+	}
+
+	// merged holds the original nodes
+	// merged into each remaining node.
+	merged := make([][]int, n)
+	active := make([]int, n)
+	for i := range merged {
+		merged[i] = []int{i}
+		active[i] = i
+	}
+
+	var best []int
+	weight = math.Inf(1)
+	key := make([]float64, n)
+	added := make([]bool, n)
+	for len(active) > 1 {
+		for _, i := range active {
+			key[i] = 0
+			added[i] = false
+		}
+
+		// Add nodes in maximum adjacency
+		// order until the last node is found.
+		prev := -1
+		for k := range active {
+			next := -1
+			for _, i := range active {
+				if !added[i] && (next < 0 || key[i] > key[next]) {
+					next = i
+				}
+			}
+			added[next] = true
+			if k < len(active)-1 {
+				for _, i := range active {
+					if !added[i] {
+						key[i] += w[next][i]
+					}
+				}
+				prev = next
+				continue
+			}
+
+			// The cut of the phase separates the
+			// last node from the rest of the graph.
+			if key[next] < weight {
+				weight = key[next]
+				best = append(best[:0], merged[next]...)
+			}
+
+			// Merge the last node into the
+			// second to last node.
+			for _, i := range active {
+				w[prev][i] += w[next][i]
+				w[i][prev] = w[prev][i]
+			}
+			w[prev][prev] = 0
+			merged[prev] = append(merged[prev], merged[next]...)
+			for j, i := range active {
+				if i == next {
+					active = append(active[:j], active[j+1:]...)
+					break
+				}
+			}
+		}
+	}
+
+	partition = make([]graph.Node, len(best))
+	for i, j := range best {
+		partition[i] = nodes[j]
+	}
+	order.ByID(partition)
+	return partition, weight
+}
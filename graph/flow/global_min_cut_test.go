@@ -0,0 +1,110 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package flow
+
+import (
+	"math"
+	"reflect"
+	"slices"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+var globalMinCutTests = []struct {
+	name  string
+	nodes int64
+	edges []simple.WeightedEdge
+
+	want       []int64
+	wantWeight float64
+}{
+	{
+		// Stoer and Wagner doi:10.1145/263867.263872 Figure 1.
+		name: "stoer-wagner",
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(1), T: simple.Node(2), W: 2},
+			{F: simple.Node(1), T: simple.Node(5), W: 3},
+			{F: simple.Node(2), T: simple.Node(3), W: 3},
+			{F: simple.Node(2), T: simple.Node(5), W: 2},
+			{F: simple.Node(2), T: simple.Node(6), W: 2},
+			{F: simple.Node(3), T: simple.Node(4), W: 4},
+			{F: simple.Node(3), T: simple.Node(7), W: 2},
+			{F: simple.Node(4), T: simple.Node(7), W: 2},
+			{F: simple.Node(4), T: simple.Node(8), W: 2},
+			{F: simple.Node(5), T: simple.Node(6), W: 3},
+			{F: simple.Node(6), T: simple.Node(7), W: 1},
+			{F: simple.Node(7), T: simple.Node(8), W: 3},
+		},
+		want:       []int64{3, 4, 7, 8},
+		wantWeight: 4,
+	},
+	{
+		name: "pendant",
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 5},
+			{F: simple.Node(1), T: simple.Node(2), W: 5},
+			{F: simple.Node(2), T: simple.Node(0), W: 5},
+			{F: simple.Node(2), T: simple.Node(3), W: 1},
+		},
+		want:       []int64{3},
+		wantWeight: 1,
+	},
+	{
+		name:  "disconnected",
+		nodes: 3,
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 5},
+		},
+		want:       []int64{2},
+		wantWeight: 0,
+	},
+	{
+		name:       "single",
+		nodes:      1,
+		wantWeight: math.Inf(1),
+	},
+}
+
+func TestGlobalMinCut(t *testing.T) {
+	t.Parallel()
+	for _, test := range globalMinCutTests {
+		g := simple.NewWeightedUndirectedGraph(0, 0)
+		for id := int64(0); id < test.nodes; id++ {
+			g.AddNode(simple.Node(id))
+		}
+		for _, e := range test.edges {
+			g.SetWeightedEdge(e)
+		}
+		partition, weight := GlobalMinCut(g)
+		var got []int64
+		for _, n := range partition {
+			got = append(got, n.ID())
+		}
+		if !reflect.DeepEqual(got, test.want) && !reflect.DeepEqual(complementIDs(g, got), test.want) {
+			t.Errorf("unexpected partition for %q: got:%v want:%v", test.name, got, test.want)
+		}
+		if weight != test.wantWeight {
+			t.Errorf("unexpected weight for %q: got:%v want:%v", test.name, weight, test.wantWeight)
+		}
+	}
+}
+
+// complementIDs returns the IDs of nodes in g that are not in ids, sorted.
+func complementIDs(g graph.Graph, ids []int64) []int64 {
+	in := make(map[int64]bool)
+	for _, id := range ids {
+		in[id] = true
+	}
+	var c []int64
+	for _, n := range graph.NodesOf(g.Nodes()) {
+		if !in[n.ID()] {
+			c = append(c, n.ID())
+		}
+	}
+	slices.Sort(c)
+	return c
+}
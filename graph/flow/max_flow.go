@@ -0,0 +1,246 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package flow
+
+import (
+	"cmp"
+	"math"
+	"slices"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+// MaxFlowDinic returns the value of a maximum flow from s to t in g and the
+// flow along each edge of g that carries a positive flow, using Dinic's
+// blocking flow algorithm. The capacity of each edge is its weight. The flow
+// map is keyed by the IDs of the from and to nodes of each edge.
+//
+// Each phase of Dinic's algorithm builds a level graph of the residual network
+// by a breadth-first search from s and saturates it with a blocking flow found
+// by depth-first search, so the number of phases is at most |V|. The time
+// complexity of MaxFlowDinic is O(|V|^2.|E|), and O(|E|.sqrt(|V|)) for unit
+// capacity bipartite matching networks.
+//
+// If s or t is not in g, or s and t are the same node, the returned value is
+// zero and the flow map is empty. MaxFlowDinic will panic if g has an edge
+// with a negative or non-finite weight.
+func MaxFlowDinic(g graph.WeightedDirected, s, t graph.Node) (value float64, flow map[[2]int64]float64) {
+	r := newResidual(g)
+	value = r.dinic(s.ID(), t.ID())
+	return value, r.flow()
+}
+
+// MinCut returns a minimum s-t cut of g, where the capacity of each edge is
+// its weight. The nodes reachable from s in the residual network of a maximum
+// flow found by MaxFlowDinic are returned in sSide and the remaining nodes are
+// returned in tSide, and both are sorted by ID. The cut edges are the edges of
+// g from a node in sSide to a node in tSide, held as pairs of from and to node
+// IDs sorted lexically, and value is the total capacity of the cut edges,
+// which is equal to the value of the maximum flow.
+//
+// If s or t is not in g, or s and t are the same node, MinCut returns nil
+// partitions and cut edges and zero value. MinCut will panic if g has an edge
+// with a negative or non-finite weight.
+func MinCut(g graph.WeightedDirected, s, t graph.Node) (sSide, tSide []graph.Node, cutEdges [][2]int64, value float64) {
+	sid, tid := s.ID(), t.ID()
+	if sid == tid || g.Node(sid) == nil || g.Node(tid) == nil {
+		return nil, nil, nil, 0
+	}
+	r := newResidual(g)
+	value = r.dinic(sid, tid)
+
+	inS := r.reachable(r.indexOf[sid])
+	for i, u := range r.nodes {
+		if inS[i] {
+			sSide = append(sSide, u)
+		} else {
+			tSide = append(tSide, u)
+		}
+	}
+	order.ByID(sSide)
+	order.ByID(tSide)
+	for _, u := range sSide {
+		uid := u.ID()
+		to := g.From(uid)
+		for to.Next() {
+			vid := to.Node().ID()
+			if !inS[r.indexOf[vid]] {
+				cutEdges = append(cutEdges, [2]int64{uid, vid})
+			}
+		}
+	}
+	slices.SortFunc(cutEdges, func(a, b [2]int64) int {
+		return cmp.Or(cmp.Compare(a[0], b[0]), cmp.Compare(a[1], b[1]))
+	})
+	return sSide, tSide, cutEdges, value
+}
+
+// residual is a residual network of a capacitated directed graph.
+type residual struct {
+	nodes   []graph.Node
+	indexOf map[int64]int
+
+	// arcs holds the residual arcs leaving
+	// each node. Each arc of the input graph
+	// is paired with a reverse arc with zero
+	// capacity.
+	arcs [][]residualArc
+
+	level []int
+	next  []int
+}
+
+// residualArc is an arc in a residual network.
+type residualArc struct {
+	to int
+	// rev is the index of the paired
+	// arc in arcs[to].
+	rev int
+	// cap is the residual capacity and
+	// capacity is the capacity of the
+	// arc in the input graph, zero for
+	// reverse arcs.
+	cap, capacity float64
+}
+
+// newResidual returns the residual network of g with no flow.
+func newResidual(g graph.WeightedDirected) *residual {
+	nodes := graph.NodesOf(g.Nodes())
+	r := residual{
+		nodes:   nodes,
+		indexOf: make(map[int64]int, len(nodes)),
+		arcs:    make([][]residualArc, len(nodes)),
+		level:   make([]int, len(nodes)),
+		next:    make([]int, len(nodes)),
+	}
+	for i, u := range nodes {
+		r.indexOf[u.ID()] = i
+	}
+	for i, u := range nodes {
+		uid := u.ID()
+		to := g.From(uid)
+		for to.Next() {
+			vid := to.Node().ID()
+			if vid == uid {
+				continue
+			}
+			c, ok := g.Weight(uid, vid)
+			if !ok {
+				panic("flow: unexpected invalid weight")
+			}
+			if c < 0 || math.IsNaN(c) || math.IsInf(c, 0) {
+				panic("flow: invalid edge capacity")
+			}
+			j := r.indexOf[vid]
+			r.arcs[i] = append(r.arcs[i], residualArc{to: j, rev: len(r.arcs[j]), cap: c, capacity: c})
+			r.arcs[j] = append(r.arcs[j], residualArc{to: i, rev: len(r.arcs[i]) - 1})
+		}
+	}
+	return &r
+}
+
+// dinic pushes a maximum flow from the node with ID sid to the node
+// with ID tid through the residual network, returning its value.
+func (r *residual) dinic(sid, tid int64) float64 {
+	s, ok := r.indexOf[sid]
+	if !ok {
+		return 0
+	}
+	t, ok := r.indexOf[tid]
+	if !ok || s == t {
+		return 0
+	}
+	var value float64
+	for r.buildLevels(s, t) {
+		for i := range r.next {
+			r.next[i] = 0
+		}
+		for {
+			f := r.augment(s, t, math.Inf(1))
+			if f == 0 {
+				break
+			}
+			value += f
+		}
+	}
+	return value
+}
+
+// buildLevels labels each node with its breadth-first distance from s in
+// the residual network, returning whether t is reachable from s.
+func (r *residual) buildLevels(s, t int) bool {
+	for i := range r.level {
+		r.level[i] = -1
+	}
+	r.level[s] = 0
+	queue := []int{s}
+	for len(queue) != 0 {
+		u := queue[0]
+		queue = queue[1:]
+		for _, a := range r.arcs[u] {
+			if a.cap > 0 && r.level[a.to] < 0 {
+				r.level[a.to] = r.level[u] + 1
+				queue = append(queue, a.to)
+			}
+		}
+	}
+	return r.level[t] >= 0
+}
+
+// augment pushes up to limit units of flow from u to t along a path of
+// the level graph, returning the flow pushed. Arcs that can carry no more
+// flow in the current phase are skipped in later calls by advancing next.
+func (r *residual) augment(u, t int, limit float64) float64 {
+	if u == t {
+		return limit
+	}
+	for ; r.next[u] < len(r.arcs[u]); r.next[u]++ {
+		a := &r.arcs[u][r.next[u]]
+		if a.cap <= 0 || r.level[a.to] != r.level[u]+1 {
+			continue
+		}
+		f := r.augment(a.to, t, math.Min(limit, a.cap))
+		if f > 0 {
+			a.cap -= f
+			r.arcs[a.to][a.rev].cap += f
+			return f
+		}
+	}
+	return 0
+}
+
+// reachable returns whether each node is reachable from s through arcs
+// of the residual network with positive residual capacity.
+func (r *residual) reachable(s int) []bool {
+	seen := make([]bool, len(r.nodes))
+	seen[s] = true
+	stack := []int{s}
+	for len(stack) != 0 {
+		u := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, a := range r.arcs[u] {
+			if a.cap > 0 && !seen[a.to] {
+				seen[a.to] = true
+				stack = append(stack, a.to)
+			}
+		}
+	}
+	return seen
+}
+
+// flow returns the flow along each arc of the input graph that carries
+// a positive flow.
+func (r *residual) flow() map[[2]int64]float64 {
+	flow := make(map[[2]int64]float64)
+	for i, arcs := range r.arcs {
+		for _, a := range arcs {
+			if f := a.capacity - a.cap; a.capacity > 0 && f > 0 {
+				flow[[2]int64{r.nodes[i].ID(), r.nodes[a.to].ID()}] = f
+			}
+		}
+	}
+	return flow
+}
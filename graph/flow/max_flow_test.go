@@ -0,0 +1,182 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package flow
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+var maxFlowTests = []struct {
+	name  string
+	edges []simple.WeightedEdge
+	s, t  int64
+
+	want float64
+}{
+	{
+		// Cormen et al. Introduction to Algorithms Figure 26.1.
+		name: "clrs",
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 16},
+			{F: simple.Node(0), T: simple.Node(2), W: 13},
+			{F: simple.Node(2), T: simple.Node(1), W: 4},
+			{F: simple.Node(1), T: simple.Node(3), W: 12},
+			{F: simple.Node(3), T: simple.Node(2), W: 9},
+			{F: simple.Node(2), T: simple.Node(4), W: 14},
+			{F: simple.Node(4), T: simple.Node(3), W: 7},
+			{F: simple.Node(3), T: simple.Node(5), W: 20},
+			{F: simple.Node(4), T: simple.Node(5), W: 4},
+		},
+		s: 0, t: 5,
+		want: 23,
+	},
+	{
+		name: "antiparallel",
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 3},
+			{F: simple.Node(1), T: simple.Node(0), W: 2},
+			{F: simple.Node(1), T: simple.Node(2), W: 5},
+			{F: simple.Node(0), T: simple.Node(2), W: 1},
+		},
+		s: 0, t: 2,
+		want: 4,
+	},
+	{
+		name: "unreachable",
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 3},
+			{F: simple.Node(2), T: simple.Node(1), W: 3},
+		},
+		s: 0, t: 2,
+		want: 0,
+	},
+	{
+		name: "zero capacity",
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 0},
+		},
+		s: 0, t: 1,
+		want: 0,
+	},
+	{
+		name: "same node",
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 3},
+		},
+		s: 0, t: 0,
+		want: 0,
+	},
+	{
+		name: "missing node",
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 3},
+		},
+		s: 0, t: 5,
+		want: 0,
+	},
+}
+
+func TestMaxFlowDinic(t *testing.T) {
+	t.Parallel()
+	for _, test := range maxFlowTests {
+		g := simple.NewWeightedDirectedGraph(0, 0)
+		for _, e := range test.edges {
+			g.SetWeightedEdge(e)
+		}
+		value, flow := MaxFlowDinic(g, simple.Node(test.s), simple.Node(test.t))
+		if value != test.want {
+			t.Errorf("unexpected flow value for %q: got:%v want:%v", test.name, value, test.want)
+		}
+		checkFlow(t, test.name, g, test.s, test.t, value, flow)
+	}
+}
+
+// unitBipartite returns a unit capacity network for bipartite matching
+// between n left and n right nodes with each left node joined to d random
+// right nodes. The source is -1 and the sink is -2.
+func unitBipartite(n, d int, rnd *rand.Rand) *simple.WeightedDirectedGraph {
+	g := simple.NewWeightedDirectedGraph(0, 0)
+	for i := 0; i < n; i++ {
+		g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(-1), T: simple.Node(i), W: 1})
+		g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(n + i), T: simple.Node(-2), W: 1})
+		for j := 0; j < d; j++ {
+			g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(i), T: simple.Node(n + rnd.IntN(n)), W: 1})
+		}
+	}
+	return g
+}
+
+func BenchmarkMaxFlowUnitBipartite(b *testing.B) {
+	for _, n := range []int{100, 1000, 5000} {
+		g := unitBipartite(n, 5, rand.New(rand.NewPCG(1, 1)))
+		b.Run(fmt.Sprintf("dinic-n=%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				MaxFlowDinic(g, simple.Node(-1), simple.Node(-2))
+			}
+		})
+		b.Run(fmt.Sprintf("edmonds-karp-n=%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				edmondsKarp(g, -1, -2)
+			}
+		})
+	}
+}
+
+func TestMinCut(t *testing.T) {
+	t.Parallel()
+	for _, test := range maxFlowTests {
+		g := simple.NewWeightedDirectedGraph(0, 0)
+		for _, e := range test.edges {
+			g.SetWeightedEdge(e)
+		}
+		sSide, tSide, cut, value := MinCut(g, simple.Node(test.s), simple.Node(test.t))
+		if test.s == test.t || g.Node(test.s) == nil || g.Node(test.t) == nil {
+			if sSide != nil || tSide != nil || cut != nil || value != 0 {
+				t.Errorf("unexpected cut for %q: got:%v|%v %v %v", test.name, sSide, tSide, cut, value)
+			}
+			continue
+		}
+		if value != test.want {
+			t.Errorf("unexpected cut value for %q: got:%v want:%v", test.name, value, test.want)
+		}
+		checkCut(t, test.name, g, test.s, test.t, sSide, tSide, cut, value)
+	}
+}
+
+// checkCut checks that the partition and cut edges describe an s-t cut of g
+// with the given value.
+func checkCut(t *testing.T, name string, g *simple.WeightedDirectedGraph, s, tid int64, sSide, tSide []graph.Node, cut [][2]int64, value float64) {
+	t.Helper()
+	inS := make(map[int64]bool)
+	for _, n := range sSide {
+		inS[n.ID()] = true
+	}
+	if !inS[s] || inS[tid] {
+		t.Errorf("source and sink not separated for %q: %v|%v", name, sSide, tSide)
+	}
+	if len(sSide)+len(tSide) != g.Nodes().Len() {
+		t.Errorf("partition for %q does not cover the graph", name)
+	}
+	var want [][2]int64
+	var w float64
+	for _, e := range graph.WeightedEdgesOf(g.WeightedEdges()) {
+		uid, vid := e.From().ID(), e.To().ID()
+		if inS[uid] && !inS[vid] {
+			want = append(want, [2]int64{uid, vid})
+			w += e.Weight()
+		}
+	}
+	if len(cut) != len(want) {
+		t.Errorf("unexpected number of cut edges for %q: got:%v want:%v", name, cut, want)
+	}
+	if w != value {
+		t.Errorf("cut weight does not match value for %q: got:%v want:%v", name, w, value)
+	}
+}
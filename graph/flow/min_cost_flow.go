@@ -0,0 +1,248 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package flow
+
+import (
+	"container/heap"
+	"math"
+
+	"gonum.org/v1/gonum/graph"
+)
+
+// MinCostMaxFlow returns the value of a maximum flow from s to t in g with
+// the least total cost, the total cost of the flow and the flow along each
+// edge of g that carries a positive flow. The capacity of each edge is its
+// weight and the cost of a unit of flow along the edge from u to v is given
+// by cost(u, v). The flow map is keyed by the IDs of the from and to nodes of
+// each edge.
+//
+// The flow is found by successively augmenting along shortest paths of the
+// residual network with respect to cost. Initial node potentials are found
+// using the Bellman-Ford algorithm, and each shortest path is then found with
+// Dijkstra's algorithm on the reduced costs, which the potentials keep
+// non-negative. Edges may therefore have negative costs as long as there is no
+// cycle of edges with positive capacity and negative total cost reachable from
+// s; MinCostMaxFlow will panic if such a cycle exists, since the minimum cost
+// is then unbounded around the cycle.
+//
+// If s or t is not in g, or s and t are the same node, the returned value and
+// cost are zero and the flow map is empty. MinCostMaxFlow will panic if g has
+// an edge with a negative or non-finite weight, or if cost returns a
+// non-finite value.
+func MinCostMaxFlow(g graph.WeightedDirected, cost func(u, v int64) float64, s, t graph.Node) (flowValue, totalCost float64, flow map[[2]int64]float64) {
+	nodes := graph.NodesOf(g.Nodes())
+	indexOf := make(map[int64]int, len(nodes))
+	for i, u := range nodes {
+		indexOf[u.ID()] = i
+	}
+	f := costNetwork{
+		adj:       make([][]int, len(nodes)),
+		potential: make([]float64, len(nodes)),
+	}
+	for i, u := range nodes {
+		uid := u.ID()
+		to := g.From(uid)
+		for to.Next() {
+			vid := to.Node().ID()
+			if vid == uid {
+				continue
+			}
+			c, ok := g.Weight(uid, vid)
+			if !ok {
+				panic("flow: unexpected invalid weight")
+			}
+			if c < 0 || math.IsNaN(c) || math.IsInf(c, 0) {
+				panic("flow: invalid edge capacity")
+			}
+			w := cost(uid, vid)
+			if math.IsNaN(w) || math.IsInf(w, 0) {
+				panic("flow: invalid edge cost")
+			}
+			f.addArc(i, indexOf[vid], c, w)
+		}
+	}
+
+	flow = make(map[[2]int64]float64)
+	si, ok := indexOf[s.ID()]
+	if !ok {
+		return 0, 0, flow
+	}
+	ti, ok := indexOf[t.ID()]
+	if !ok || si == ti {
+		return 0, 0, flow
+	}
+
+	f.initPotentials(si)
+	for {
+		df, dc, ok := f.augment(si, ti)
+		if !ok {
+			break
+		}
+		flowValue += df
+		totalCost += dc
+	}
+
+	for i := 0; i < len(f.arcs); i += 2 {
+		a := f.arcs[i]
+		if v := a.capacity - a.cap; v > 0 {
+			flow[[2]int64{nodes[a.from].ID(), nodes[a.to].ID()}] = v
+		}
+	}
+	return flowValue, totalCost, flow
+}
+
+// costNetwork is a residual flow network for finding minimum cost flows
+// with real capacities.
+type costNetwork struct {
+	// arcs holds the arcs of the network.
+	// The arc at index i^1 is the reverse
+	// of the arc at index i.
+	arcs []costArc
+
+	// adj holds the indexes into arcs of
+	// the arcs leaving each node.
+	adj [][]int
+
+	// potential holds the node potentials
+	// used to keep reduced costs non-negative.
+	potential []float64
+}
+
+// costArc is an arc in a residual flow network with costs.
+type costArc struct {
+	from, to int
+	// cap is the residual capacity and
+	// capacity is the capacity of the
+	// arc in the input graph, zero for
+	// reverse arcs.
+	cap, capacity float64
+	cost          float64
+}
+
+// addArc adds an arc from u to v with the given capacity and cost
+// and its zero capacity reverse arc.
+func (f *costNetwork) addArc(u, v int, cap, cost float64) {
+	f.adj[u] = append(f.adj[u], len(f.arcs))
+	f.arcs = append(f.arcs, costArc{from: u, to: v, cap: cap, capacity: cap, cost: cost})
+	f.adj[v] = append(f.adj[v], len(f.arcs))
+	f.arcs = append(f.arcs, costArc{from: v, to: u, cost: -cost})
+}
+
+// initPotentials sets the node potentials to the shortest path costs from
+// s over arcs with positive capacity using the Bellman-Ford algorithm, so
+// that the reduced costs of those arcs are non-negative. Nodes that are not
+// reachable from s are given a zero potential. initPotentials will panic if
+// a negative cost cycle is reachable from s.
+func (f *costNetwork) initPotentials(s int) {
+	dist := f.potential
+	for i := range dist {
+		dist[i] = math.Inf(1)
+	}
+	dist[s] = 0
+	for i := 0; i <= len(dist); i++ {
+		changed := false
+		for _, a := range f.arcs {
+			if a.cap <= 0 || math.IsInf(dist[a.from], 1) {
+				continue
+			}
+			if joint := dist[a.from] + a.cost; joint < dist[a.to] {
+				dist[a.to] = joint
+				changed = true
+			}
+		}
+		if !changed {
+			break
+		}
+		if i == len(dist) {
+			panic("flow: negative cost cycle")
+		}
+	}
+	for i, d := range dist {
+		if math.IsInf(d, 1) {
+			dist[i] = 0
+		}
+	}
+}
+
+// augment pushes the largest possible flow along a least cost path from s
+// to t in the residual network, returning the flow pushed, its cost and
+// whether such a path exists.
+func (f *costNetwork) augment(s, t int) (flow, cost float64, ok bool) {
+	dist := make([]float64, len(f.adj))
+	for i := range dist {
+		dist[i] = math.Inf(1)
+	}
+	prev := make([]int, len(f.adj))
+	for i := range prev {
+		prev[i] = -1
+	}
+	dist[s] = 0
+
+	Q := costQueue{{node: s, dist: 0}}
+	for Q.Len() != 0 {
+		mid := heap.Pop(&Q).(costNode)
+		u := mid.node
+		if mid.dist > dist[u] {
+			continue
+		}
+		for _, i := range f.adj[u] {
+			a := f.arcs[i]
+			if a.cap <= 0 {
+				continue
+			}
+			// Clamp the reduced cost to avoid
+			// negative costs due to rounding.
+			rc := math.Max(0, a.cost+f.potential[u]-f.potential[a.to])
+			joint := dist[u] + rc
+			if joint < dist[a.to] {
+				dist[a.to] = joint
+				prev[a.to] = i
+				heap.Push(&Q, costNode{node: a.to, dist: joint})
+			}
+		}
+	}
+	if math.IsInf(dist[t], 1) {
+		return 0, 0, false
+	}
+
+	for i, d := range dist {
+		if !math.IsInf(d, 1) {
+			f.potential[i] += d
+		}
+	}
+	flow = math.Inf(1)
+	for v := t; v != s; v = f.arcs[prev[v]].from {
+		flow = math.Min(flow, f.arcs[prev[v]].cap)
+	}
+	for v := t; v != s; {
+		i := prev[v]
+		f.arcs[i].cap -= flow
+		f.arcs[i^1].cap += flow
+		cost += flow * f.arcs[i].cost
+		v = f.arcs[i].from
+	}
+	return flow, cost, true
+}
+
+// costNode is a node in a residual flow network with its current
+// least known cost from the source.
+type costNode struct {
+	node int
+	dist float64
+}
+
+// costQueue implements a no-dec priority queue.
+type costQueue []costNode
+
+func (q costQueue) Len() int            { return len(q) }
+func (q costQueue) Less(i, j int) bool  { return q[i].dist < q[j].dist }
+func (q costQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *costQueue) Push(n interface{}) { *q = append(*q, n.(costNode)) }
+func (q *costQueue) Pop() interface{} {
+	t := *q
+	var n interface{}
+	n, *q = t[len(t)-1], t[:len(t)-1]
+	return n
+}
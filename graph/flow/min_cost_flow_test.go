@@ -0,0 +1,141 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package flow
+
+import (
+	"testing"
+
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+// costEdge is an edge with a capacity and a unit cost.
+type costEdge struct {
+	from, to  int64
+	cap, cost float64
+}
+
+var minCostMaxFlowTests = []struct {
+	name  string
+	edges []costEdge
+	s, t  int64
+
+	wantFlow float64
+	wantCost float64
+}{
+	{
+		name: "cheap and expensive",
+		edges: []costEdge{
+			{from: 0, to: 1, cap: 2, cost: 1},
+			{from: 1, to: 3, cap: 2, cost: 1},
+			{from: 0, to: 2, cap: 2, cost: 5},
+			{from: 2, to: 3, cap: 2, cost: 5},
+			{from: 1, to: 2, cap: 1, cost: 1},
+		},
+		s: 0, t: 3,
+		wantFlow: 4,
+		wantCost: 24,
+	},
+	{
+		// The cheapest first path uses the edge from 1 to
+		// 2, which must be cancelled to reach the maximum
+		// flow at minimum cost.
+		name: "cancellation",
+		edges: []costEdge{
+			{from: 0, to: 1, cap: 1, cost: 1},
+			{from: 0, to: 2, cap: 1, cost: 3},
+			{from: 1, to: 2, cap: 1, cost: 1},
+			{from: 1, to: 3, cap: 1, cost: 3},
+			{from: 2, to: 3, cap: 1, cost: 1},
+		},
+		s: 0, t: 3,
+		wantFlow: 2,
+		wantCost: 8,
+	},
+	{
+		name: "negative cost",
+		edges: []costEdge{
+			{from: 0, to: 1, cap: 1, cost: 2},
+			{from: 1, to: 3, cap: 1, cost: -3},
+			{from: 0, to: 2, cap: 1, cost: 1},
+			{from: 2, to: 3, cap: 1, cost: 1},
+		},
+		s: 0, t: 3,
+		wantFlow: 2,
+		wantCost: 1,
+	},
+	{
+		name: "unreachable",
+		edges: []costEdge{
+			{from: 1, to: 0, cap: 1, cost: 1},
+		},
+		s: 0, t: 1,
+		wantFlow: 0,
+		wantCost: 0,
+	},
+	{
+		name: "same node",
+		edges: []costEdge{
+			{from: 0, to: 1, cap: 1, cost: 1},
+		},
+		s: 0, t: 0,
+		wantFlow: 0,
+		wantCost: 0,
+	},
+}
+
+func TestMinCostMaxFlow(t *testing.T) {
+	t.Parallel()
+	for _, test := range minCostMaxFlowTests {
+		g, cost := costNetworkOf(test.edges)
+		value, total, flow := MinCostMaxFlow(g, cost, simple.Node(test.s), simple.Node(test.t))
+		if value != test.wantFlow {
+			t.Errorf("unexpected flow value for %q: got:%v want:%v", test.name, value, test.wantFlow)
+		}
+		if total != test.wantCost {
+			t.Errorf("unexpected cost for %q: got:%v want:%v", test.name, total, test.wantCost)
+		}
+		checkFlow(t, test.name, g, test.s, test.t, value, flow)
+		if got := flowCost(flow, cost); got != total {
+			t.Errorf("cost does not match flow for %q: got:%v want:%v", test.name, total, got)
+		}
+	}
+}
+
+func TestMinCostMaxFlowNegativeCycle(t *testing.T) {
+	t.Parallel()
+	g, cost := costNetworkOf([]costEdge{
+		{from: 0, to: 1, cap: 1, cost: 1},
+		{from: 1, to: 2, cap: 1, cost: -2},
+		{from: 2, to: 1, cap: 1, cost: 1},
+		{from: 2, to: 3, cap: 1, cost: 1},
+	})
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("expected panic for negative cost cycle")
+		}
+	}()
+	MinCostMaxFlow(g, cost, simple.Node(0), simple.Node(3))
+}
+
+// costNetworkOf returns a weighted directed graph holding the capacities
+// of edges and a cost function for the edges.
+func costNetworkOf(edges []costEdge) (*simple.WeightedDirectedGraph, func(u, v int64) float64) {
+	g := simple.NewWeightedDirectedGraph(0, 0)
+	costs := make(map[[2]int64]float64)
+	for _, e := range edges {
+		g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(e.from), T: simple.Node(e.to), W: e.cap})
+		costs[[2]int64{e.from, e.to}] = e.cost
+	}
+	return g, func(u, v int64) float64 { return costs[[2]int64{u, v}] }
+}
+
+// flowCost returns the total cost of flow.
+func flowCost(flow map[[2]int64]float64, cost func(u, v int64) float64) float64 {
+	var c float64
+	for e, f := range flow {
+		c += f * cost(e[0], e[1])
+	}
+	return c
+}
@@ -3,4 +3,21 @@
 // license that can be found in the LICENSE file.
 
 // Package gen provides random graph generation functions.
+//
+// The classic random graph models are provided by the following functions:
+//
+//   - Erdős–Rényi graphs by Gnp, for the G(n, p) model, and Gnm, for the
+//     G(n, m) model.
+//   - Barabási–Albert scale-free graphs by PreferentialAttachment, and with
+//     tunable clustering by TunableClusteringScaleFree.
+//   - Watts–Strogatz small-world graphs by WattsStrogatz, with the Batagelj
+//     and Brandes variant by SmallWorldsBB, and Kleinberg's navigable
+//     small-world graphs by NavigableSmallWorld.
+//
+// Each of the random graph functions takes a rand.Source so that the graphs
+// generated are reproducible for a given source.
+//
+// The package also provides deterministic constructors for graphs with a
+// known structure, including Complete, CompleteBipartite, Cycle, Path, Star,
+// Wheel, Tree and Grid.
 package gen // import "gonum.org/v1/gonum/graph/graphs/gen"
@@ -80,6 +80,35 @@ func Complete(dst NodeIDGraphBuilder, ids IDer) {
 	}
 }
 
+// CompleteBipartite constructs a complete bipartite graph in dst with edges
+// between each node with an ID in a and each node with an ID in b.
+// If dst is a directed graph, edges are directed from the nodes in a to the
+// nodes in b. If any ID appears twice in a and b, CompleteBipartite will panic.
+func CompleteBipartite(dst NodeIDGraphBuilder, a, b IDer) {
+	extra := make([]int64, b.Len())
+	for i := range extra {
+		extra[i] = b.ID(i)
+	}
+	err := check(a, extra...)
+	if err != nil {
+		panic(err)
+	}
+	for _, ids := range []IDer{a, b} {
+		for i := 0; i < ids.Len(); i++ {
+			if u, new := dst.NodeWithID(ids.ID(i)); new {
+				dst.AddNode(u)
+			}
+		}
+	}
+	for i := 0; i < a.Len(); i++ {
+		u, _ := dst.NodeWithID(a.ID(i))
+		for _, vid := range extra {
+			v, _ := dst.NodeWithID(vid)
+			dst.SetEdge(dst.NewEdge(u, v))
+		}
+	}
+}
+
 // Cycle constructs a cycle in dst using the node IDs in cycle.
 // If dst is a directed graph, edges are directed from earlier nodes to later
 // nodes in cycle. If any ID appears twice in cycle, Cycle will panic.
@@ -213,6 +242,49 @@ func Tree(dst NodeIDGraphBuilder, n int, nodes IDer) {
 	}
 }
 
+// Grid constructs a rows×cols grid graph in dst and returns a function that
+// returns the node at row r and column c of the grid, or nil if r or c is
+// outside the grid. The node at row r and column c has the ID r*cols+c. Each
+// node is joined to the nodes horizontally and vertically adjacent to it and,
+// if diagonals is true, also to the nodes diagonally adjacent to it.
+// If dst is a directed graph, edges are directed from lower to higher IDs.
+// If rows or cols is negative, Grid will panic.
+func Grid(dst NodeIDGraphBuilder, rows, cols int, diagonals bool) func(r, c int) graph.Node {
+	if rows < 0 || cols < 0 {
+		panic("gen: negative grid dimension")
+	}
+	nodes := make([]graph.Node, rows*cols)
+	for i := range nodes {
+		u, new := dst.NodeWithID(int64(i))
+		if new {
+			dst.AddNode(u)
+		}
+		nodes[i] = u
+	}
+	node := func(r, c int) graph.Node {
+		if r < 0 || rows <= r || c < 0 || cols <= c {
+			return nil
+		}
+		return nodes[r*cols+c]
+	}
+
+	for r := 0; r < rows; r++ {
+		for c := 0; c < cols; c++ {
+			u := node(r, c)
+			adjacent := []graph.Node{node(r, c+1), node(r+1, c)}
+			if diagonals {
+				adjacent = append(adjacent, node(r+1, c-1), node(r+1, c+1))
+			}
+			for _, v := range adjacent {
+				if v != nil {
+					dst.SetEdge(dst.NewEdge(u, v))
+				}
+			}
+		}
+	}
+	return node
+}
+
 // check confirms that no node ID exists more than once in ids and extra.
 func check(ids IDer, extra ...int64) error {
 	seen := make(map[int64]int, ids.Len()+len(extra))
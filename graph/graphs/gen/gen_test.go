@@ -902,3 +902,239 @@ func TestTree(t *testing.T) {
 		}
 	}
 }
+
+func TestGrid(t *testing.T) {
+	tests := []struct {
+		name       string
+		dst        func() nodeIDGraphBuilder
+		rows, cols int
+		diagonals  bool
+		want       string
+		panics     string
+	}{
+		{
+			name: "empty",
+			dst:  undirected,
+			rows: 0, cols: 3,
+			want: `strict graph empty {
+}`,
+		},
+		{
+			name: "row",
+			dst:  undirected,
+			rows: 1, cols: 3,
+			want: `strict graph row {
+ // Node definitions.
+ 0;
+ 1;
+ 2;
+
+ // Edge definitions.
+ 0 -- 1;
+ 1 -- 2;
+}`,
+		},
+		{
+			name: "square_undirected",
+			dst:  undirected,
+			rows: 2, cols: 3,
+			want: `strict graph square_undirected {
+ // Node definitions.
+ 0;
+ 1;
+ 2;
+ 3;
+ 4;
+ 5;
+
+ // Edge definitions.
+ 0 -- 1;
+ 0 -- 3;
+ 1 -- 2;
+ 1 -- 4;
+ 2 -- 5;
+ 3 -- 4;
+ 4 -- 5;
+}`,
+		},
+		{
+			name: "diagonal_undirected",
+			dst:  undirected,
+			rows: 2, cols: 3,
+			diagonals: true,
+			want: `strict graph diagonal_undirected {
+ // Node definitions.
+ 0;
+ 1;
+ 2;
+ 3;
+ 4;
+ 5;
+
+ // Edge definitions.
+ 0 -- 1;
+ 0 -- 3;
+ 0 -- 4;
+ 1 -- 2;
+ 1 -- 3;
+ 1 -- 4;
+ 1 -- 5;
+ 2 -- 4;
+ 2 -- 5;
+ 3 -- 4;
+ 4 -- 5;
+}`,
+		},
+		{
+			name: "diagonal_directed",
+			dst:  directed,
+			rows: 2, cols: 2,
+			diagonals: true,
+			want: `strict digraph diagonal_directed {
+ // Node definitions.
+ 0;
+ 1;
+ 2;
+ 3;
+
+ // Edge definitions.
+ 0 -> 1;
+ 0 -> 2;
+ 0 -> 3;
+ 1 -> 2;
+ 1 -> 3;
+ 2 -> 3;
+}`,
+		},
+		{
+			name: "negative",
+			dst:  undirected,
+			rows: -1, cols: 2,
+			panics: "gen: negative grid dimension",
+		},
+	}
+	for _, test := range tests {
+		dst := test.dst()
+		var node func(r, c int) graph.Node
+		panicked, msg := panics(func() { node = Grid(dst, test.rows, test.cols, test.diagonals) })
+		if msg != test.panics {
+			t.Errorf("unexpected panic message for %q: got:%q want:%q", test.name, msg, test.panics)
+		}
+		if panicked {
+			continue
+		}
+		got, err := dot.Marshal(dst, test.name, "", " ")
+		if err != nil {
+			t.Errorf("unexpected error marshaling graph: %v", err)
+		}
+		if !bytes.Equal(got, []byte(test.want)) {
+			t.Errorf("unexpected result for test %s:\ngot:\n%s\nwant:\n%s", test.name, got, test.want)
+		}
+		for r := -1; r <= test.rows; r++ {
+			for c := -1; c <= test.cols; c++ {
+				n := node(r, c)
+				if r < 0 || r == test.rows || c < 0 || c == test.cols {
+					if n != nil {
+						t.Errorf("unexpected node for %q at (%d, %d) outside grid: %v", test.name, r, c, n)
+					}
+					continue
+				}
+				if n == nil || n.ID() != int64(r*test.cols+c) {
+					t.Errorf("unexpected node for %q at (%d, %d): got:%v want:%d", test.name, r, c, n, r*test.cols+c)
+				}
+			}
+		}
+	}
+}
+
+func TestCompleteBipartite(t *testing.T) {
+	tests := []struct {
+		name   string
+		a, b   IDer
+		dst    func() nodeIDGraphBuilder
+		want   string
+		panics string
+	}{
+		{
+			name: "empty",
+			a:    empty{},
+			b:    empty{},
+			dst:  undirected,
+			want: `strict graph empty {
+}`,
+		},
+		{
+			name: "empty_b",
+			a:    IDRange{First: 0, Last: 1},
+			b:    empty{},
+			dst:  undirected,
+			want: `strict graph empty_b {
+ // Node definitions.
+ 0;
+ 1;
+}`,
+		},
+		{
+			name: "k23_undirected",
+			a:    IDRange{First: 0, Last: 1},
+			b:    IDSet{4, 3, 2},
+			dst:  undirected,
+			want: `strict graph k23_undirected {
+ // Node definitions.
+ 0;
+ 1;
+ 2;
+ 3;
+ 4;
+
+ // Edge definitions.
+ 0 -- 2;
+ 0 -- 3;
+ 0 -- 4;
+ 1 -- 2;
+ 1 -- 3;
+ 1 -- 4;
+}`,
+		},
+		{
+			name: "k21_directed",
+			a:    IDSet{2, 0},
+			b:    IDSet{1},
+			dst:  directed,
+			want: `strict digraph k21_directed {
+ // Node definitions.
+ 0;
+ 1;
+ 2;
+
+ // Edge definitions.
+ 0 -> 1;
+ 2 -> 1;
+}`,
+		},
+		{
+			name:   "collision",
+			a:      IDRange{First: 0, Last: 2},
+			b:      IDSet{3, 1},
+			dst:    undirected,
+			panics: "gen: node ID collision i=1 with extra j=1: id=1",
+		},
+	}
+	for _, test := range tests {
+		dst := test.dst()
+		panicked, msg := panics(func() { CompleteBipartite(dst, test.a, test.b) })
+		if msg != test.panics {
+			t.Errorf("unexpected panic message for %q: got:%q want:%q", test.name, msg, test.panics)
+		}
+		if panicked {
+			continue
+		}
+		got, err := dot.Marshal(dst, test.name, "", " ")
+		if err != nil {
+			t.Errorf("unexpected error marshaling graph: %v", err)
+		}
+		if !bytes.Equal(got, []byte(test.want)) {
+			t.Errorf("unexpected result for test %s:\ngot:\n%s\nwant:\n%s", test.name, got, test.want)
+		}
+	}
+}
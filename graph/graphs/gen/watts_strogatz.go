@@ -0,0 +1,102 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gen
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/internal/set"
+)
+
+// WattsStrogatz constructs a Watts–Strogatz small-world graph of order n in the
+// destination, dst. The graph is constructed from a ring lattice in which each
+// node is joined to the k/2 nodes following it around the ring, by rewiring
+// each lattice edge in turn with the probability, beta, to join the first node
+// of the edge to a node chosen uniformly from the nodes it is not already
+// joined to. With beta equal to zero the graph is the ring lattice and with
+// beta equal to one it is close to a random graph with the same number of
+// edges. The degree, k, must be even and less than n, and beta must be in
+// [0, 1]. If src is not nil it is used as the random source, otherwise
+// rand.Float64 is used. The graph is constructed in O(nk) expected time when
+// k is small relative to n.
+//
+// The algorithm is described in doi:10.1038/30918.
+func WattsStrogatz(dst graph.UndirectedBuilder, n, k int, beta float64, src rand.Source) error {
+	if k < 0 || k%2 != 0 || (k != 0 && k >= n) {
+		return fmt.Errorf("gen: bad degree: k=%d", k)
+	}
+	if beta < 0 || beta > 1 {
+		return fmt.Errorf("gen: bad rewiring probability: beta=%v", beta)
+	}
+	var (
+		rnd  func() float64
+		rndN func(int) int
+	)
+	if src == nil {
+		rnd = rand.Float64
+		rndN = rand.IntN
+	} else {
+		r := rand.New(src)
+		rnd = r.Float64
+		rndN = r.IntN
+	}
+
+	nodes := make([]graph.Node, n)
+	for i := range nodes {
+		u := dst.NewNode()
+		dst.AddNode(u)
+		nodes[i] = u
+	}
+
+	adj := make([]set.Ints[int], n)
+	for i := range adj {
+		adj[i] = make(set.Ints[int])
+	}
+	for u := 0; u < n; u++ {
+		for j := 1; j <= k/2; j++ {
+			v := (u + j) % n
+			adj[u].Add(v)
+			adj[v].Add(u)
+		}
+	}
+
+	// Rewire the lattice edges in the order they
+	// were formed, skipping nodes that are joined
+	// to all other nodes.
+	for j := 1; j <= k/2; j++ {
+		for u := 0; u < n; u++ {
+			v := (u + j) % n
+			if !adj[u].Has(v) || adj[u].Count() == n-1 || rnd() >= beta {
+				continue
+			}
+			w := rndN(n)
+			for w == u || adj[u].Has(w) {
+				w = rndN(n)
+			}
+			adj[u].Remove(v)
+			adj[v].Remove(u)
+			adj[u].Add(w)
+			adj[w].Add(u)
+		}
+	}
+
+	var to []int
+	for u, s := range adj {
+		to = to[:0]
+		for v := range s {
+			if u < v {
+				to = append(to, v)
+			}
+		}
+		slices.Sort(to)
+		for _, v := range to {
+			dst.SetEdge(dst.NewEdge(nodes[u], nodes[v]))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gen
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+func TestWattsStrogatz(t *testing.T) {
+	t.Parallel()
+	for n := 1; n <= 20; n++ {
+		for k := 0; k < n; k += 2 {
+			for beta := 0.; beta <= 1; beta += 0.25 {
+				g := &gnUndirected{UndirectedBuilder: simple.NewUndirectedGraph()}
+				orig := g.NewNode()
+				g.AddNode(orig)
+				err := WattsStrogatz(g, n, k, beta, rand.NewPCG(uint64(n), uint64(k)))
+				if err != nil {
+					t.Fatalf("unexpected error: n=%d, k=%d, beta=%v: %v", n, k, beta, err)
+				}
+				if g.From(orig.ID()).Len() != 0 {
+					t.Errorf("edge added from already existing node: n=%d, k=%d, beta=%v", n, k, beta)
+				}
+				if g.addBackwards {
+					t.Errorf("edge added with From.ID > To.ID: n=%d, k=%d, beta=%v", n, k, beta)
+				}
+				if g.addSelfLoop {
+					t.Errorf("unexpected self edge: n=%d, k=%d, beta=%v", n, k, beta)
+				}
+				if g.addMultipleEdge {
+					t.Errorf("unexpected multiple edge: n=%d, k=%d, beta=%v", n, k, beta)
+				}
+				if got := g.Nodes().Len(); got != n+1 {
+					t.Errorf("unexpected number of nodes: got:%d want:%d", got, n+1)
+				}
+				if got, want := g.UndirectedBuilder.(*simple.UndirectedGraph).Edges().Len(), n*k/2; got != want {
+					t.Errorf("unexpected number of edges: n=%d, k=%d, beta=%v: got:%d want:%d", n, k, beta, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestWattsStrogatzLattice(t *testing.T) {
+	t.Parallel()
+	const n, k = 10, 4
+	g := simple.NewUndirectedGraph()
+	err := WattsStrogatz(g, n, k, 0, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for u := 0; u < n; u++ {
+		for j := 1; j <= k/2; j++ {
+			if !g.HasEdgeBetween(int64(u), int64((u+j)%n)) {
+				t.Errorf("missing lattice edge %d--%d", u, (u+j)%n)
+			}
+		}
+	}
+	if got := g.Edges().Len(); got != n*k/2 {
+		t.Errorf("unexpected number of edges: got:%d want:%d", got, n*k/2)
+	}
+}
+
+func TestWattsStrogatzDeterministic(t *testing.T) {
+	t.Parallel()
+	var first [][2]int64
+	for i := 0; i < 3; i++ {
+		g := simple.NewUndirectedGraph()
+		err := WattsStrogatz(g, 30, 4, 0.3, rand.NewPCG(1, 1))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		var edges [][2]int64
+		for _, e := range graph.EdgesOf(g.Edges()) {
+			u, v := e.From().ID(), e.To().ID()
+			if v < u {
+				u, v = v, u
+			}
+			edges = append(edges, [2]int64{u, v})
+		}
+		set := make(map[[2]int64]bool)
+		for _, e := range edges {
+			set[e] = true
+		}
+		if i == 0 {
+			first = edges
+			continue
+		}
+		if len(edges) != len(first) {
+			t.Fatalf("unexpected number of edges: got:%d want:%d", len(edges), len(first))
+		}
+		for _, e := range first {
+			if !set[e] {
+				t.Errorf("graph not deterministic for fixed source: missing edge %v", e)
+			}
+		}
+	}
+}
+
+func TestWattsStrogatzBadParameters(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		n, k int
+		beta float64
+		want string
+	}{
+		{n: 10, k: 3, beta: 0.5, want: "gen: bad degree: k=3"},
+		{n: 10, k: 10, beta: 0.5, want: "gen: bad degree: k=10"},
+		{n: 10, k: -2, beta: 0.5, want: "gen: bad degree: k=-2"},
+		{n: 10, k: 2, beta: -0.5, want: "gen: bad rewiring probability: beta=-0.5"},
+		{n: 10, k: 2, beta: 1.5, want: "gen: bad rewiring probability: beta=1.5"},
+	} {
+		err := WattsStrogatz(simple.NewUndirectedGraph(), test.n, test.k, test.beta, nil)
+		if err == nil || err.Error() != test.want {
+			t.Errorf("unexpected error for n=%d, k=%d, beta=%v: got:%v want:%s", test.n, test.k, test.beta, err, test.want)
+		}
+	}
+}
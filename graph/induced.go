@@ -0,0 +1,252 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graph
+
+import (
+	"math"
+	"slices"
+)
+
+// InducedSubgraph returns a read-only view of the subgraph of g induced by
+// nodes, holding the nodes of g with the IDs of nodes and all the edges of g
+// between them. Nodes with IDs that are not in g are ignored. The view holds
+// a reference to g and the node set rather than a copy of the edges, so
+// changes to the edges of g are reflected in the view; Copy or CopyWeighted
+// may be used to construct an independent copy of the subgraph. The Nodes
+// method of the view returns the nodes in the order they appear in nodes.
+//
+// The returned graph is a Directed if g is a Directed and an Undirected if g
+// is an Undirected. If g is a Weighted the returned graph is also a Weighted,
+// and is a WeightedDirected or a WeightedUndirected if g is. The Weight method
+// of a weighted view returns the weight held by g for pairs of nodes in the
+// subgraph, and +Inf and false for pairs including a node not in the
+// subgraph.
+func InducedSubgraph(g Graph, nodes []Node) Graph {
+	s := &induced{g: g, set: make(map[int64]Node, len(nodes))}
+	for _, n := range nodes {
+		id := n.ID()
+		if _, ok := s.set[id]; ok {
+			continue
+		}
+		u := g.Node(id)
+		if u == nil {
+			continue
+		}
+		s.set[id] = u
+		s.nodes = append(s.nodes, u)
+	}
+
+	_, isDirected := g.(Directed)
+	_, isUndirected := g.(Undirected)
+	_, isWeighted := g.(Weighted)
+	_, isWeightedUndirected := g.(WeightedUndirected)
+	switch {
+	case isWeighted && isDirected:
+		return inducedWeightedDirected{inducedWeighted{s}}
+	case isWeightedUndirected && isUndirected:
+		return inducedWeightedUndirected{inducedUndirectedWeighted{inducedWeighted{s}}}
+	case isWeighted && isUndirected:
+		return inducedUndirectedWeighted{inducedWeighted{s}}
+	case isWeighted:
+		return inducedWeighted{s}
+	case isDirected:
+		return inducedDirected{s}
+	case isUndirected:
+		return inducedUndirected{s}
+	default:
+		return s
+	}
+}
+
+// induced is a view of an induced subgraph.
+type induced struct {
+	g Graph
+
+	// set holds the nodes of the subgraph
+	// keyed by their IDs and nodes holds
+	// them in order.
+	set   map[int64]Node
+	nodes []Node
+}
+
+// has returns whether both x and y are in the subgraph.
+func (s *induced) has(xid, yid int64) bool {
+	_, xok := s.set[xid]
+	_, yok := s.set[yid]
+	return xok && yok
+}
+
+// filter returns the nodes in it that are in the subgraph.
+func (s *induced) filter(it Nodes) Nodes {
+	var nodes []Node
+	for it.Next() {
+		n := it.Node()
+		if _, ok := s.set[n.ID()]; ok {
+			nodes = append(nodes, n)
+		}
+	}
+	if len(nodes) == 0 {
+		return Empty
+	}
+	return &nodeSlice{nodes: nodes, pos: -1}
+}
+
+// Node returns the node with the given ID if it exists in the subgraph,
+// and nil otherwise.
+func (s *induced) Node(id int64) Node { return s.set[id] }
+
+// Nodes returns all the nodes in the subgraph.
+func (s *induced) Nodes() Nodes {
+	if len(s.nodes) == 0 {
+		return Empty
+	}
+	// Copy the nodes so that callers holding the
+	// result of NodeSlice cannot alter the view.
+	return &nodeSlice{nodes: slices.Clone(s.nodes), pos: -1}
+}
+
+// From returns all nodes in the subgraph that can be reached directly from u.
+func (s *induced) From(uid int64) Nodes {
+	if _, ok := s.set[uid]; !ok {
+		return Empty
+	}
+	return s.filter(s.g.From(uid))
+}
+
+// HasEdgeBetween returns whether an edge exists between nodes x and y.
+func (s *induced) HasEdgeBetween(xid, yid int64) bool {
+	return s.has(xid, yid) && s.g.HasEdgeBetween(xid, yid)
+}
+
+// Edge returns the edge from u to v if such an edge exists and nil otherwise.
+// The node v must be directly reachable from u as defined by the From method.
+func (s *induced) Edge(uid, vid int64) Edge {
+	if !s.has(uid, vid) {
+		return nil
+	}
+	return s.g.Edge(uid, vid)
+}
+
+func (s *induced) hasEdgeFromTo(uid, vid int64) bool {
+	return s.has(uid, vid) && s.g.(Directed).HasEdgeFromTo(uid, vid)
+}
+
+func (s *induced) to(vid int64) Nodes {
+	if _, ok := s.set[vid]; !ok {
+		return Empty
+	}
+	return s.filter(s.g.(Directed).To(vid))
+}
+
+func (s *induced) edgeBetween(xid, yid int64) Edge {
+	if !s.has(xid, yid) {
+		return nil
+	}
+	return s.g.(Undirected).EdgeBetween(xid, yid)
+}
+
+// inducedDirected is a view of an induced subgraph of a Directed.
+type inducedDirected struct{ *induced }
+
+// HasEdgeFromTo returns whether an edge exists from u to v.
+func (s inducedDirected) HasEdgeFromTo(uid, vid int64) bool { return s.hasEdgeFromTo(uid, vid) }
+
+// To returns all nodes in the subgraph that can reach directly to v.
+func (s inducedDirected) To(vid int64) Nodes { return s.to(vid) }
+
+// inducedUndirected is a view of an induced subgraph of an Undirected.
+type inducedUndirected struct{ *induced }
+
+// EdgeBetween returns the edge between nodes x and y.
+func (s inducedUndirected) EdgeBetween(xid, yid int64) Edge { return s.edgeBetween(xid, yid) }
+
+// inducedWeighted is a view of an induced subgraph of a Weighted.
+type inducedWeighted struct{ *induced }
+
+// WeightedEdge returns the weighted edge from u to v if such an edge exists
+// and nil otherwise.
+func (s inducedWeighted) WeightedEdge(uid, vid int64) WeightedEdge {
+	if !s.has(uid, vid) {
+		return nil
+	}
+	return s.g.(Weighted).WeightedEdge(uid, vid)
+}
+
+// Weight returns the weight for the edge between x and y if Edge(x, y)
+// returns a non-nil Edge. If x or y is not in the subgraph, Weight returns
+// +Inf and false.
+func (s inducedWeighted) Weight(xid, yid int64) (w float64, ok bool) {
+	if !s.has(xid, yid) {
+		return math.Inf(1), false
+	}
+	return s.g.(Weighted).Weight(xid, yid)
+}
+
+// inducedWeightedDirected is a view of an induced subgraph of a
+// WeightedDirected.
+type inducedWeightedDirected struct{ inducedWeighted }
+
+// HasEdgeFromTo returns whether an edge exists from u to v.
+func (s inducedWeightedDirected) HasEdgeFromTo(uid, vid int64) bool { return s.hasEdgeFromTo(uid, vid) }
+
+// To returns all nodes in the subgraph that can reach directly to v.
+func (s inducedWeightedDirected) To(vid int64) Nodes { return s.to(vid) }
+
+// inducedUndirectedWeighted is a view of an induced subgraph of an
+// Undirected that is also a Weighted.
+type inducedUndirectedWeighted struct{ inducedWeighted }
+
+// EdgeBetween returns the edge between nodes x and y.
+func (s inducedUndirectedWeighted) EdgeBetween(xid, yid int64) Edge { return s.edgeBetween(xid, yid) }
+
+// inducedWeightedUndirected is a view of an induced subgraph of a
+// WeightedUndirected that is also an Undirected.
+type inducedWeightedUndirected struct{ inducedUndirectedWeighted }
+
+// WeightedEdgeBetween returns the weighted edge between nodes x and y.
+func (s inducedWeightedUndirected) WeightedEdgeBetween(xid, yid int64) WeightedEdge {
+	if !s.has(xid, yid) {
+		return nil
+	}
+	return s.g.(WeightedUndirected).WeightedEdgeBetween(xid, yid)
+}
+
+// nodeSlice is a Nodes iterator over a slice of nodes.
+type nodeSlice struct {
+	nodes []Node
+	pos   int
+}
+
+func (n *nodeSlice) Len() int {
+	if n.pos >= len(n.nodes) {
+		return 0
+	}
+	return len(n.nodes) - n.pos - 1
+}
+
+func (n *nodeSlice) Next() bool {
+	if n.pos < len(n.nodes) {
+		n.pos++
+	}
+	return n.pos < len(n.nodes)
+}
+
+func (n *nodeSlice) Node() Node {
+	if n.pos < 0 || n.pos >= len(n.nodes) {
+		return nil
+	}
+	return n.nodes[n.pos]
+}
+
+func (n *nodeSlice) Reset() { n.pos = -1 }
+
+func (n *nodeSlice) NodeSlice() []Node {
+	if n.pos >= len(n.nodes) {
+		return nil
+	}
+	nodes := n.nodes[n.pos+1:]
+	n.pos = len(n.nodes)
+	return nodes
+}
@@ -0,0 +1,270 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graph_test
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+func TestInducedSubgraphUndirected(t *testing.T) {
+	t.Parallel()
+	g := simple.NewUndirectedGraph()
+	for _, e := range [][2]int64{{0, 1}, {1, 2}, {2, 3}, {3, 0}, {0, 2}, {4, 5}} {
+		g.SetEdge(simple.Edge{F: simple.Node(e[0]), T: simple.Node(e[1])})
+	}
+
+	// Node 6 is not in g and node 2 is repeated.
+	nodes := []graph.Node{simple.Node(2), simple.Node(0), simple.Node(1), simple.Node(6), simple.Node(2)}
+	s := graph.InducedSubgraph(g, nodes)
+	if _, ok := s.(graph.Undirected); !ok {
+		t.Fatalf("subgraph of undirected graph is not undirected: %T", s)
+	}
+	if _, ok := s.(graph.Directed); ok {
+		t.Errorf("subgraph of undirected graph is directed: %T", s)
+	}
+
+	if got, want := idsOf(s.Nodes()), []int64{2, 0, 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected nodes: got:%v want:%v", got, want)
+	}
+	if s.Node(3) != nil {
+		t.Error("unexpected node 3 in subgraph")
+	}
+	if s.Node(0) == nil {
+		t.Error("missing node 0 in subgraph")
+	}
+	for _, test := range []struct {
+		id   int64
+		want []int64
+	}{
+		{id: 0, want: []int64{1, 2}},
+		{id: 1, want: []int64{0, 2}},
+		{id: 2, want: []int64{0, 1}},
+		{id: 3, want: nil},
+		{id: 6, want: nil},
+	} {
+		got := idsOf(s.From(test.id))
+		slices.Sort(got)
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("unexpected neighbours of %d: got:%v want:%v", test.id, got, test.want)
+		}
+	}
+	if s.HasEdgeBetween(2, 3) || s.Edge(2, 3) != nil || s.(graph.Undirected).EdgeBetween(3, 0) != nil {
+		t.Error("unexpected edge to node outside subgraph")
+	}
+	if !s.HasEdgeBetween(0, 2) || s.Edge(2, 0) == nil || s.(graph.Undirected).EdgeBetween(0, 1) == nil {
+		t.Error("missing edge in subgraph")
+	}
+
+	// The view reflects changes to g.
+	g.SetEdge(simple.Edge{F: simple.Node(1), T: simple.Node(3)})
+	if got, want := idsOf(s.From(1)), []int64{0, 2}; !reflect.DeepEqual(sorted(got), want) {
+		t.Errorf("unexpected neighbours of 1 after edge to outside node: got:%v want:%v", got, want)
+	}
+	g.RemoveEdge(0, 1)
+	if s.HasEdgeBetween(0, 1) {
+		t.Error("removed edge still in subgraph")
+	}
+}
+
+func TestInducedSubgraphDirected(t *testing.T) {
+	t.Parallel()
+	g := simple.NewDirectedGraph()
+	for _, e := range [][2]int64{{0, 1}, {1, 2}, {2, 0}, {2, 3}, {3, 1}} {
+		g.SetEdge(simple.Edge{F: simple.Node(e[0]), T: simple.Node(e[1])})
+	}
+
+	s := graph.InducedSubgraph(g, []graph.Node{simple.Node(1), simple.Node(2), simple.Node(3)})
+	d, ok := s.(graph.Directed)
+	if !ok {
+		t.Fatalf("subgraph of directed graph is not directed: %T", s)
+	}
+	if _, ok := s.(graph.Weighted); ok {
+		t.Errorf("subgraph of unweighted graph is weighted: %T", s)
+	}
+	for _, test := range []struct {
+		id       int64
+		from, to []int64
+	}{
+		{id: 0, from: nil, to: nil},
+		{id: 1, from: []int64{2}, to: []int64{3}},
+		{id: 2, from: []int64{3}, to: []int64{1}},
+		{id: 3, from: []int64{1}, to: []int64{2}},
+	} {
+		if got := sorted(idsOf(d.From(test.id))); !reflect.DeepEqual(got, test.from) {
+			t.Errorf("unexpected nodes from %d: got:%v want:%v", test.id, got, test.from)
+		}
+		if got := sorted(idsOf(d.To(test.id))); !reflect.DeepEqual(got, test.to) {
+			t.Errorf("unexpected nodes to %d: got:%v want:%v", test.id, got, test.to)
+		}
+	}
+	if d.HasEdgeFromTo(2, 0) || d.HasEdgeFromTo(2, 1) {
+		t.Error("unexpected edge in subgraph")
+	}
+	if !d.HasEdgeFromTo(1, 2) {
+		t.Error("missing edge in subgraph")
+	}
+}
+
+func TestInducedSubgraphWeighted(t *testing.T) {
+	t.Parallel()
+	g := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+	for _, e := range []simple.WeightedEdge{
+		{F: simple.Node(0), T: simple.Node(1), W: 2},
+		{F: simple.Node(1), T: simple.Node(2), W: 3},
+		{F: simple.Node(2), T: simple.Node(0), W: 4},
+	} {
+		g.SetWeightedEdge(e)
+	}
+
+	s := graph.InducedSubgraph(g, []graph.Node{simple.Node(0), simple.Node(1)})
+	w, ok := s.(graph.WeightedUndirected)
+	if !ok {
+		t.Fatalf("subgraph of weighted undirected graph is not weighted undirected: %T", s)
+	}
+	for _, test := range []struct {
+		x, y int64
+		w    float64
+		ok   bool
+	}{
+		{x: 0, y: 0, w: 0, ok: true},
+		{x: 0, y: 1, w: 2, ok: true},
+		{x: 1, y: 0, w: 2, ok: true},
+		{x: 1, y: 2, w: math.Inf(1), ok: false},
+		{x: 2, y: 2, w: math.Inf(1), ok: false},
+	} {
+		got, ok := w.Weight(test.x, test.y)
+		if got != test.w || ok != test.ok {
+			t.Errorf("unexpected weight for %d-%d: got:(%v, %t) want:(%v, %t)", test.x, test.y, got, ok, test.w, test.ok)
+		}
+	}
+	if e := w.WeightedEdgeBetween(1, 0); e == nil || e.Weight() != 2 {
+		t.Errorf("unexpected weighted edge between 1 and 0: %v", e)
+	}
+	if w.WeightedEdge(1, 2) != nil || w.WeightedEdgeBetween(2, 0) != nil {
+		t.Error("unexpected weighted edge to node outside subgraph")
+	}
+}
+
+// undirectedWeighted is an Undirected and a Weighted
+// that is not a WeightedUndirected.
+type undirectedWeighted struct {
+	graph.Undirected
+	w graph.Weighted
+}
+
+func (g undirectedWeighted) WeightedEdge(uid, vid int64) graph.WeightedEdge {
+	return g.w.WeightedEdge(uid, vid)
+}
+
+func (g undirectedWeighted) Weight(xid, yid int64) (w float64, ok bool) {
+	return g.w.Weight(xid, yid)
+}
+
+func TestInducedSubgraphUndirectedWeighted(t *testing.T) {
+	t.Parallel()
+	wg := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+	for _, e := range []simple.WeightedEdge{
+		{F: simple.Node(0), T: simple.Node(1), W: 2},
+		{F: simple.Node(1), T: simple.Node(2), W: 3},
+	} {
+		wg.SetWeightedEdge(e)
+	}
+	g := undirectedWeighted{Undirected: wg, w: wg}
+
+	s := graph.InducedSubgraph(g, []graph.Node{simple.Node(0), simple.Node(1)})
+	if _, ok := s.(graph.WeightedUndirected); ok {
+		t.Errorf("subgraph of graph that is not weighted undirected is weighted undirected: %T", s)
+	}
+	if _, ok := s.(graph.Weighted); !ok {
+		t.Errorf("subgraph of weighted graph is not weighted: %T", s)
+	}
+	u, ok := s.(graph.Undirected)
+	if !ok {
+		t.Fatalf("subgraph of undirected graph is not undirected: %T", s)
+	}
+	if u.EdgeBetween(1, 0) == nil {
+		t.Error("missing edge between 1 and 0")
+	}
+	if u.EdgeBetween(1, 2) != nil {
+		t.Error("unexpected edge to node outside subgraph")
+	}
+}
+
+func ExampleInducedSubgraph() {
+	g := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+	for _, e := range []simple.WeightedEdge{
+		{F: simple.Node(0), T: simple.Node(1), W: 2},
+		{F: simple.Node(1), T: simple.Node(2), W: 3},
+		{F: simple.Node(2), T: simple.Node(3), W: 4},
+		{F: simple.Node(3), T: simple.Node(0), W: 5},
+	} {
+		g.SetWeightedEdge(e)
+	}
+
+	// Copy the subgraph induced by nodes 0, 1 and 2
+	// into a new graph, keeping the edge weights.
+	sub := graph.InducedSubgraph(g, []graph.Node{simple.Node(0), simple.Node(1), simple.Node(2)})
+	dst := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+	graph.CopyWeighted(dst, sub.(graph.Weighted))
+
+	edges := graph.WeightedEdgesOf(dst.WeightedEdges())
+	slices.SortFunc(edges, func(a, b graph.WeightedEdge) int {
+		return int(min(a.From().ID(), a.To().ID()) - min(b.From().ID(), b.To().ID()))
+	})
+	for _, e := range edges {
+		u, v := e.From().ID(), e.To().ID()
+		fmt.Printf("%d-%d: %v\n", min(u, v), max(u, v), e.Weight())
+	}
+
+	// Output:
+	// 0-1: 2
+	// 1-2: 3
+}
+
+func TestInducedSubgraphNodesNotAliased(t *testing.T) {
+	t.Parallel()
+	g := simple.NewUndirectedGraph()
+	for _, e := range [][2]int64{{0, 1}, {1, 2}, {2, 3}} {
+		g.SetEdge(simple.Edge{F: simple.Node(e[0]), T: simple.Node(e[1])})
+	}
+	s := graph.InducedSubgraph(g, []graph.Node{simple.Node(3), simple.Node(1), simple.Node(2)})
+
+	nodes := graph.NodesOf(s.Nodes())
+	slices.SortFunc(nodes, func(a, b graph.Node) int { return cmp.Compare(a.ID(), b.ID()) })
+	nodes[0] = simple.Node(7)
+
+	if got, want := idsOf(s.Nodes()), []int64{3, 1, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected nodes after mutating NodesOf result: got:%v want:%v", got, want)
+	}
+	for _, id := range []int64{1, 2, 3} {
+		if n := s.Node(id); n == nil || n.ID() != id {
+			t.Errorf("unexpected node for ID %d after mutating NodesOf result: got:%v", id, n)
+		}
+	}
+	if s.Node(7) != nil {
+		t.Error("unexpected node 7 in subgraph")
+	}
+}
+
+func idsOf(it graph.Nodes) []int64 {
+	var ids []int64
+	for it.Next() {
+		ids = append(ids, it.Node().ID())
+	}
+	return ids
+}
+
+func sorted(ids []int64) []int64 {
+	slices.Sort(ids)
+	return ids
+}
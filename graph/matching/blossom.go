@@ -0,0 +1,194 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matching
+
+import (
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+// MaximumMatching returns a maximum cardinality matching of the undirected
+// graph g as a set of node pairs, using Edmonds' blossom algorithm. Unlike
+// bipartite matching algorithms, MaximumMatching handles graphs with odd
+// cycles by contracting each odd cycle, a blossom, found during the search
+// for an augmenting path into a single node and expanding it when the path
+// is augmented. Self loops are ignored.
+//
+// Each pair in the returned matching holds the node with the lower ID first,
+// and the pairs are sorted by the ID of their first node. The time complexity
+// of MaximumMatching is O(|V|^3).
+func MaximumMatching(g graph.Undirected) [][2]graph.Node {
+	nodes := graph.NodesOf(g.Nodes())
+	order.ByID(nodes)
+	indexOf := make(map[int64]int, len(nodes))
+	for i, u := range nodes {
+		indexOf[u.ID()] = i
+	}
+	adj := make([][]int, len(nodes))
+	for i, u := range nodes {
+		uid := u.ID()
+		to := g.From(uid)
+		for to.Next() {
+			vid := to.Node().ID()
+			if vid != uid {
+				adj[i] = append(adj[i], indexOf[vid])
+			}
+		}
+	}
+
+	b := newBlossomSearch(adj)
+	for v := range nodes {
+		if b.match[v] != -1 {
+			continue
+		}
+		// Augment along the alternating
+		// path from v to u if it exists.
+		for u := b.findPath(v); u != -1; {
+			pu := b.parent[u]
+			next := b.match[pu]
+			b.match[u] = pu
+			b.match[pu] = u
+			u = next
+		}
+	}
+
+	var matching [][2]graph.Node
+	for i, j := range b.match {
+		if i < j {
+			matching = append(matching, [2]graph.Node{nodes[i], nodes[j]})
+		}
+	}
+	return matching
+}
+
+// blossomSearch holds the state for Edmonds' blossom algorithm on a
+// graph with nodes indexed by their position in adj.
+type blossomSearch struct {
+	adj [][]int
+
+	// match is the node matched with
+	// each node or -1 if the node is
+	// unmatched.
+	match []int
+	// parent is the predecessor of each
+	// node in the alternating tree, or
+	// -1 if the node is not in the tree.
+	parent []int
+	// base is the base of the blossom
+	// containing each node.
+	base []int
+
+	inTree    []bool
+	inBlossom []bool
+	onPath    []bool
+
+	queue []int
+}
+
+func newBlossomSearch(adj [][]int) *blossomSearch {
+	n := len(adj)
+	b := blossomSearch{
+		adj:       adj,
+		match:     make([]int, n),
+		parent:    make([]int, n),
+		base:      make([]int, n),
+		inTree:    make([]bool, n),
+		inBlossom: make([]bool, n),
+		onPath:    make([]bool, n),
+	}
+	for i := range b.match {
+		b.match[i] = -1
+	}
+	return &b
+}
+
+// findPath searches for an augmenting path starting at the unmatched node
+// root, returning the unmatched node at the end of the path or -1 if no
+// augmenting path exists. The path can be recovered by following parent
+// and match from the returned node.
+func (b *blossomSearch) findPath(root int) int {
+	for i := range b.adj {
+		b.inTree[i] = false
+		b.parent[i] = -1
+		b.base[i] = i
+	}
+	b.inTree[root] = true
+	b.queue = append(b.queue[:0], root)
+	for len(b.queue) != 0 {
+		v := b.queue[0]
+		b.queue = b.queue[1:]
+		for _, u := range b.adj[v] {
+			if b.base[v] == b.base[u] || b.match[v] == u {
+				continue
+			}
+			if u == root || (b.match[u] != -1 && b.parent[b.match[u]] != -1) {
+				// u is an outer node of the tree, so the
+				// edge (v, u) closes an odd cycle. Contract
+				// the blossom onto its base.
+				base := b.lowestCommonAncestor(v, u)
+				for i := range b.inBlossom {
+					b.inBlossom[i] = false
+				}
+				b.markPath(v, base, u)
+				b.markPath(u, base, v)
+				for i := range b.adj {
+					if b.inBlossom[b.base[i]] {
+						b.base[i] = base
+						if !b.inTree[i] {
+							b.inTree[i] = true
+							b.queue = append(b.queue, i)
+						}
+					}
+				}
+			} else if b.parent[u] == -1 {
+				b.parent[u] = v
+				if b.match[u] == -1 {
+					return u
+				}
+				w := b.match[u]
+				b.inTree[w] = true
+				b.queue = append(b.queue, w)
+			}
+		}
+	}
+	return -1
+}
+
+// lowestCommonAncestor returns the base of the lowest common ancestor
+// of the nodes u and v in the alternating tree.
+func (b *blossomSearch) lowestCommonAncestor(u, v int) int {
+	for i := range b.onPath {
+		b.onPath[i] = false
+	}
+	for {
+		u = b.base[u]
+		b.onPath[u] = true
+		if b.match[u] == -1 {
+			break
+		}
+		u = b.parent[b.match[u]]
+	}
+	for {
+		v = b.base[v]
+		if b.onPath[v] {
+			return v
+		}
+		v = b.parent[b.match[v]]
+	}
+}
+
+// markPath marks the blossoms on the path from v to the blossom base,
+// setting the parents of the nodes on the path so that the blossom can
+// be traversed in either direction when augmenting. The child is the
+// node on the other side of the edge that closed the blossom.
+func (b *blossomSearch) markPath(v, base, child int) {
+	for b.base[v] != base {
+		b.inBlossom[b.base[v]] = true
+		b.inBlossom[b.base[b.match[v]]] = true
+		b.parent[v] = child
+		child = b.match[v]
+		v = b.parent[b.match[v]]
+	}
+}
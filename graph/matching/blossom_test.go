@@ -0,0 +1,106 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matching
+
+import (
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+var maximumMatchingTests = []struct {
+	name  string
+	edges [][2]int64
+	nodes int64
+
+	want int
+}{
+	{
+		name: "empty",
+		want: 0,
+	},
+	{
+		name:  "isolated",
+		nodes: 3,
+		want:  0,
+	},
+	{
+		name:  "triangle",
+		edges: [][2]int64{{0, 1}, {1, 2}, {2, 0}},
+		want:  1,
+	},
+	{
+		name:  "pentagon",
+		edges: [][2]int64{{0, 1}, {1, 2}, {2, 3}, {3, 4}, {4, 0}},
+		want:  2,
+	},
+	{
+		// A triangle with a pendant path. A search that does
+		// not contract the blossom cannot find the augmenting
+		// path from 5 through the triangle to 3 after the
+		// triangle and the path have been partly matched.
+		name:  "blossom with stem",
+		edges: [][2]int64{{0, 1}, {1, 2}, {2, 0}, {0, 3}, {1, 4}, {4, 5}},
+		want:  3,
+	},
+	{
+		name: "nested blossoms",
+		edges: [][2]int64{
+			{0, 1}, {1, 2}, {2, 0},
+			{2, 3}, {3, 4}, {4, 0},
+			{4, 5}, {5, 6}, {6, 7},
+			{1, 8},
+		},
+		want: 4,
+	},
+	{
+		name: "petersen",
+		edges: [][2]int64{
+			{0, 1}, {1, 2}, {2, 3}, {3, 4}, {4, 0},
+			{0, 5}, {1, 6}, {2, 7}, {3, 8}, {4, 9},
+			{5, 7}, {7, 9}, {9, 6}, {6, 8}, {8, 5},
+		},
+		want: 5,
+	},
+}
+
+func TestMaximumMatching(t *testing.T) {
+	t.Parallel()
+	for _, test := range maximumMatchingTests {
+		g := simple.NewUndirectedGraph()
+		for id := int64(0); id < test.nodes; id++ {
+			g.AddNode(simple.Node(id))
+		}
+		for _, e := range test.edges {
+			g.SetEdge(simple.Edge{F: simple.Node(e[0]), T: simple.Node(e[1])})
+		}
+		got := MaximumMatching(g)
+		if len(got) != test.want {
+			t.Errorf("unexpected matching size for %q: got:%d want:%d", test.name, len(got), test.want)
+		}
+		checkMatching(t, test.name, g, got)
+	}
+}
+
+// checkMatching checks that m is a valid matching of g.
+func checkMatching(t *testing.T, name string, g graph.Undirected, m [][2]graph.Node) {
+	t.Helper()
+	seen := make(map[int64]bool)
+	for _, p := range m {
+		uid, vid := p[0].ID(), p[1].ID()
+		if !g.HasEdgeBetween(uid, vid) || uid == vid {
+			t.Errorf("matched pair for %q is not an edge: %d--%d", name, uid, vid)
+		}
+		if uid > vid {
+			t.Errorf("unexpected pair order for %q: %d--%d", name, uid, vid)
+		}
+		if seen[uid] || seen[vid] {
+			t.Errorf("node matched more than once for %q: %d--%d", name, uid, vid)
+		}
+		seen[uid] = true
+		seen[vid] = true
+	}
+}
@@ -0,0 +1,273 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matching
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+// randomMatchingTests compare the matching algorithms with an exhaustive
+// search over matchings on random problems of each size.
+var randomMatchingTests = []struct {
+	name   string
+	sizes  []int
+	trials int
+
+	// test checks the algorithm on a random problem of size n.
+	test func(t *testing.T, rnd *rand.Rand, n int)
+}{
+	{
+		name:   "MinCostAssignment",
+		sizes:  []int{1, 2, 3, 4, 5, 6},
+		trials: 20,
+		test: func(t *testing.T, rnd *rand.Rand, n int) {
+			c := randomCost(rnd, n, false, 0.1)
+			assignment, total := MinCostAssignment(func(i, j int) float64 { return c[i][j] }, n)
+
+			// An assignment is a perfect matching of the complete
+			// bipartite graph with rows [0, n) and columns [n, 2n).
+			cost := func(i, j int) float64 {
+				if i < n && j >= n {
+					return c[i][j-n]
+				}
+				return math.Inf(1)
+			}
+			size, want := exhaustiveMatching(2*n, cost)
+			if size < n {
+				want = math.Inf(1)
+			}
+			if total != want {
+				t.Errorf("unexpected total for %v: got:%v want:%v", c, total, want)
+			}
+			if math.IsInf(want, 1) {
+				if assignment != nil {
+					t.Errorf("unexpected assignment for %v: %v", c, assignment)
+				}
+				return
+			}
+			if len(assignment) != n {
+				t.Errorf("unexpected assignment length: got:%d want:%d", len(assignment), n)
+				return
+			}
+			mate := make([]int, 2*n)
+			for i := range mate {
+				mate[i] = -1
+			}
+			for i, j := range assignment {
+				if j < 0 || j >= n || mate[n+j] >= 0 {
+					t.Errorf("invalid assignment for %v: %v", c, assignment)
+					return
+				}
+				mate[i], mate[n+j] = n+j, i
+			}
+			checkMate(t, "assignment", mate, cost, total)
+		},
+	},
+	{
+		name:   "MaximumMatching",
+		sizes:  []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+		trials: 20,
+		test: func(t *testing.T, rnd *rand.Rand, n int) {
+			c := randomCost(rnd, n, true, 0.7)
+			cost := func(i, j int) float64 { return c[i][j] }
+			g := simple.NewUndirectedGraph()
+			for id := int64(0); id < int64(n); id++ {
+				g.AddNode(simple.Node(id))
+			}
+			for i := range n {
+				for j := i + 1; j < n; j++ {
+					if !math.IsInf(c[i][j], 1) {
+						g.SetEdge(simple.Edge{F: simple.Node(i), T: simple.Node(j)})
+					}
+				}
+			}
+			m := MaximumMatching(g)
+			checkMatching(t, "random", g, m)
+			if want, _ := exhaustiveMatching(n, cost); len(m) != want {
+				t.Errorf("unexpected matching size: got:%d want:%d", len(m), want)
+			}
+		},
+	},
+	{
+		name:   "MinWeightPerfectMatching",
+		sizes:  []int{2, 4, 6, 8, 10, 12},
+		trials: 50,
+		test: func(t *testing.T, rnd *rand.Rand, n int) {
+			var pInf float64
+			if rnd.IntN(3) == 0 {
+				pInf = 0.4
+			}
+			c := randomCost(rnd, n, true, pInf)
+			mate, total := MinWeightPerfectMatching(func(i, j int) float64 { return c[i][j] }, n)
+			size, want := exhaustiveMatching(n, func(i, j int) float64 { return c[i][j] })
+			if 2*size < n {
+				want = math.Inf(1)
+			}
+			if total != want {
+				t.Errorf("unexpected total for %v: got:%v want:%v", c, total, want)
+			}
+			if !math.IsInf(want, 1) {
+				checkPerfectMate(t, "random", c, mate, total)
+			}
+		},
+	},
+	{
+		// Non-integer costs check that the exact comparisons
+		// of slacks and duals made by the blossom algorithm
+		// are robust to rounding.
+		name:   "MinWeightPerfectMatching float",
+		sizes:  []int{2, 4, 6, 8, 10, 12},
+		trials: 50,
+		test: func(t *testing.T, rnd *rand.Rand, n int) {
+			c := randomEuclideanCost(rnd, n)
+			mate, total := MinWeightPerfectMatching(func(i, j int) float64 { return c[i][j] }, n)
+			_, want := exhaustiveMatching(n, func(i, j int) float64 { return c[i][j] })
+			if !scalar.EqualWithinAbsOrRel(total, want, 1e-12, 1e-12) {
+				t.Errorf("unexpected total for %v: got:%v want:%v", c, total, want)
+			}
+			if len(mate) != n || slices.Contains(mate, -1) {
+				t.Errorf("matching is not perfect: %v", mate)
+			}
+		},
+	},
+}
+
+func TestMatchingRandom(t *testing.T) {
+	t.Parallel()
+	for _, test := range randomMatchingTests {
+		rnd := rand.New(rand.NewPCG(1, 1))
+		for _, n := range test.sizes {
+			for trial := 0; trial < test.trials; trial++ {
+				test.test(t, rnd, n)
+			}
+		}
+	}
+}
+
+// randomCost returns an n×n cost matrix holding integer costs in [-5, 15),
+// with each cost replaced by +Inf with probability pInf. If symmetric is true,
+// the matrix is symmetric with a zero diagonal.
+func randomCost(rnd *rand.Rand, n int, symmetric bool, pInf float64) [][]float64 {
+	cost := make([][]float64, n)
+	for i := range cost {
+		cost[i] = make([]float64, n)
+	}
+	for i := range n {
+		for j := range n {
+			if symmetric && j <= i {
+				continue
+			}
+			w := float64(rnd.IntN(20) - 5)
+			if rnd.Float64() < pInf {
+				w = math.Inf(1)
+			}
+			cost[i][j] = w
+			if symmetric {
+				cost[j][i] = w
+			}
+		}
+	}
+	return cost
+}
+
+// randomEuclideanCost returns an n×n cost matrix holding the distances
+// between n points placed uniformly at random in a square of side 100.
+func randomEuclideanCost(rnd *rand.Rand, n int) [][]float64 {
+	x := make([]float64, n)
+	y := make([]float64, n)
+	for i := range n {
+		x[i], y[i] = 100*rnd.Float64(), 100*rnd.Float64()
+	}
+	cost := make([][]float64, n)
+	for i := range cost {
+		cost[i] = make([]float64, n)
+		for j := range n {
+			cost[i][j] = math.Hypot(x[i]-x[j], y[i]-y[j])
+		}
+	}
+	return cost
+}
+
+// exhaustiveMatching returns the size of a maximum cardinality matching of the
+// graph on n vertices where i and j are joined when cost(i, j) is finite, and
+// the minimum total cost of a matching of that size, by exhaustive search over
+// all matchings. The cost function is only called with i < j.
+func exhaustiveMatching(n int, cost func(i, j int) float64) (size int, total float64) {
+	matched := make([]bool, n)
+	var search func(i, k int, w float64)
+	search = func(i, k int, w float64) {
+		for i < n && matched[i] {
+			i++
+		}
+		if i == n {
+			if k > size || (k == size && w < total) {
+				size, total = k, w
+			}
+			return
+		}
+		matched[i] = true
+		search(i+1, k, w)
+		for j := i + 1; j < n; j++ {
+			c := cost(i, j)
+			if matched[j] || math.IsInf(c, 1) {
+				continue
+			}
+			matched[j] = true
+			search(i+1, k+1, w+c)
+			matched[j] = false
+		}
+		matched[i] = false
+	}
+	search(0, 0, 0)
+	return size, total
+}
+
+// checkMate checks that mate is a matching, with mate[i] holding the vertex
+// matched to i or -1 if i is unmatched, that only joins vertices with a finite
+// cost and has the given total cost.
+func checkMate(t *testing.T, name string, mate []int, cost func(i, j int) float64, total float64) {
+	t.Helper()
+	var got float64
+	for i, j := range mate {
+		if j < 0 {
+			continue
+		}
+		if j == i || j >= len(mate) || mate[j] != i {
+			t.Errorf("invalid matching for %q: %v", name, mate)
+			return
+		}
+		if i < j {
+			c := cost(i, j)
+			if math.IsInf(c, 1) {
+				t.Errorf("matching for %q joins unconnected vertices %d and %d", name, i, j)
+			}
+			got += c
+		}
+	}
+	if got != total {
+		t.Errorf("total does not match matching for %q: got:%v want:%v", name, total, got)
+	}
+}
+
+// checkPerfectMate checks that mate is a perfect matching with the given
+// total cost.
+func checkPerfectMate(t *testing.T, name string, cost [][]float64, mate []int, total float64) {
+	t.Helper()
+	if len(mate) != len(cost) {
+		t.Errorf("unexpected matching length for %q: got:%d want:%d", name, len(mate), len(cost))
+		return
+	}
+	if slices.Contains(mate, -1) {
+		t.Errorf("matching for %q is not perfect: %v", name, mate)
+		return
+	}
+	checkMate(t, name, mate, func(i, j int) float64 { return cost[i][j] }, total)
+}
@@ -0,0 +1,148 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matching
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/graph"
+)
+
+// MinCostAssignment returns the minimum cost assignment of n rows to n columns
+// where the cost of assigning row i to column j is cost(i, j), using the
+// Hungarian method in O(n^3) time. The column assigned to row i is held in
+// assignment[i] and the total cost of the assignment is returned in total.
+//
+// A cost of +Inf forbids the assignment of a row to a column. If no
+// assignment with a finite total cost exists, MinCostAssignment returns nil
+// and +Inf. MinCostAssignment will panic if cost returns NaN or -Inf.
+//
+// Rectangular problems may be solved by padding the cost matrix to square
+// with zero cost rows or columns; the padded rows or columns are then
+// assigned to the unmatched columns or rows. HungarianMatching does this
+// for bipartite graphs.
+func MinCostAssignment(cost func(i, j int) float64, n int) (assignment []int, total float64) {
+	if n == 0 {
+		return []int{}, 0
+	}
+
+	// The implementation follows the shortest augmenting
+	// path formulation of the Hungarian method using row
+	// and column potentials u and v, with rows and columns
+	// indexed from 1 and the 0th column acting as the root
+	// of each augmenting path search.
+	c := make([]float64, n*n)
+	for i := range n {
+		for j := range n {
+			w := cost(i, j)
+			if math.IsNaN(w) || math.IsInf(w, -1) {
+				panic("matching: invalid assignment cost")
+			}
+			c[i*n+j] = w
+		}
+	}
+	u := make([]float64, n+1)
+	v := make([]float64, n+1)
+	rowOf := make([]int, n+1) // rowOf[j] is the row assigned to column j, or 0.
+	way := make([]int, n+1)
+	minv := make([]float64, n+1)
+	used := make([]bool, n+1)
+	for i := 1; i <= n; i++ {
+		rowOf[0] = i
+		j0 := 0
+		for j := range minv {
+			minv[j] = math.Inf(1)
+			used[j] = false
+		}
+		for {
+			used[j0] = true
+			i0 := rowOf[j0]
+			delta := math.Inf(1)
+			j1 := -1
+			for j := 1; j <= n; j++ {
+				if used[j] {
+					continue
+				}
+				cur := c[(i0-1)*n+j-1] - u[i0] - v[j]
+				if cur < minv[j] {
+					minv[j] = cur
+					way[j] = j0
+				}
+				if minv[j] < delta {
+					delta = minv[j]
+					j1 = j
+				}
+			}
+			if j1 < 0 {
+				// There is no finite cost
+				// augmenting path for row i.
+				return nil, math.Inf(1)
+			}
+			for j := range used {
+				if used[j] {
+					u[rowOf[j]] += delta
+					v[j] -= delta
+				} else {
+					minv[j] -= delta
+				}
+			}
+			j0 = j1
+			if rowOf[j0] == 0 {
+				break
+			}
+		}
+		// Augment along the path found.
+		for j0 != 0 {
+			j1 := way[j0]
+			rowOf[j0] = rowOf[j1]
+			j0 = j1
+		}
+	}
+
+	assignment = make([]int, n)
+	for j := 1; j <= n; j++ {
+		assignment[rowOf[j]-1] = j - 1
+	}
+	for i, j := range assignment {
+		total += c[i*n+j]
+	}
+	return assignment, total
+}
+
+// HungarianMatching returns the minimum weight matching between the left and
+// right nodes of the bipartite graph g that matches every node of the smaller
+// of left and right, and the total weight of the matching. Only edges of g
+// joining a node in left to a node in right are used in the matching. Each
+// pair in the returned matching holds a left node followed by a right node.
+// If no such matching exists, HungarianMatching returns nil and +Inf.
+//
+// If left and right differ in length, the smaller side is padded with nodes
+// joined to every node on the other side by zero weight edges before finding
+// the matching with MinCostAssignment, so the time complexity is
+// O(max(|left|, |right|)^3). HungarianMatching will panic if g has a NaN or
+// -Inf edge weight between left and right nodes.
+func HungarianMatching(g graph.WeightedUndirected, left, right []graph.Node) (matching [][2]graph.Node, total float64) {
+	n := max(len(left), len(right))
+	cost := func(i, j int) float64 {
+		if i >= len(left) || j >= len(right) {
+			return 0
+		}
+		w, ok := g.Weight(left[i].ID(), right[j].ID())
+		if !ok || left[i].ID() == right[j].ID() {
+			return math.Inf(1)
+		}
+		return w
+	}
+	assignment, total := MinCostAssignment(cost, n)
+	if assignment == nil {
+		return nil, total
+	}
+	for i, j := range assignment {
+		if i < len(left) && j < len(right) {
+			matching = append(matching, [2]graph.Node{left[i], right[j]})
+		}
+	}
+	return matching, total
+}
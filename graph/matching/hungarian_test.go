@@ -0,0 +1,157 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matching
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+var minCostAssignmentTests = []struct {
+	name string
+	cost [][]float64
+
+	wantAssignment []int
+	wantTotal      float64
+}{
+	{
+		name:           "empty",
+		cost:           [][]float64{},
+		wantAssignment: []int{},
+		wantTotal:      0,
+	},
+	{
+		name:           "single",
+		cost:           [][]float64{{5}},
+		wantAssignment: []int{0},
+		wantTotal:      5,
+	},
+	{
+		name: "workers",
+		cost: [][]float64{
+			{9, 2, 7, 8},
+			{6, 4, 3, 7},
+			{5, 8, 1, 8},
+			{7, 6, 9, 4},
+		},
+		wantAssignment: []int{1, 0, 2, 3},
+		wantTotal:      13,
+	},
+	{
+		name: "negative",
+		cost: [][]float64{
+			{-1, -2},
+			{-3, -5},
+		},
+		wantAssignment: []int{0, 1},
+		wantTotal:      -6,
+	},
+	{
+		name: "forbidden",
+		cost: [][]float64{
+			{1, math.Inf(1), 3},
+			{math.Inf(1), 2, math.Inf(1)},
+			{1, math.Inf(1), math.Inf(1)},
+		},
+		wantAssignment: []int{2, 1, 0},
+		wantTotal:      6,
+	},
+	{
+		name: "infeasible",
+		cost: [][]float64{
+			{1, math.Inf(1)},
+			{2, math.Inf(1)},
+		},
+		wantAssignment: nil,
+		wantTotal:      math.Inf(1),
+	},
+}
+
+func TestMinCostAssignment(t *testing.T) {
+	t.Parallel()
+	for _, test := range minCostAssignmentTests {
+		assignment, total := MinCostAssignment(func(i, j int) float64 { return test.cost[i][j] }, len(test.cost))
+		if !reflect.DeepEqual(assignment, test.wantAssignment) {
+			t.Errorf("unexpected assignment for %q: got:%v want:%v", test.name, assignment, test.wantAssignment)
+		}
+		if total != test.wantTotal {
+			t.Errorf("unexpected total for %q: got:%v want:%v", test.name, total, test.wantTotal)
+		}
+	}
+}
+
+func TestHungarianMatching(t *testing.T) {
+	t.Parallel()
+	g := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+	for _, e := range []simple.WeightedEdge{
+		{F: simple.Node(0), T: simple.Node(10), W: 5},
+		{F: simple.Node(0), T: simple.Node(11), W: 1},
+		{F: simple.Node(1), T: simple.Node(11), W: 2},
+		{F: simple.Node(1), T: simple.Node(12), W: 5},
+		{F: simple.Node(2), T: simple.Node(13), W: 3},
+	} {
+		g.SetWeightedEdge(e)
+	}
+	nodes := func(ids ...int64) []graph.Node {
+		n := make([]graph.Node, len(ids))
+		for i, id := range ids {
+			n[i] = g.Node(id)
+		}
+		return n
+	}
+
+	for _, test := range []struct {
+		name        string
+		left, right []graph.Node
+
+		want      [][2]int64
+		wantTotal float64
+	}{
+		{
+			name:      "more right",
+			left:      nodes(0, 1),
+			right:     nodes(10, 11, 12),
+			want:      [][2]int64{{0, 11}, {1, 12}},
+			wantTotal: 6,
+		},
+		{
+			name:      "more left",
+			left:      nodes(10, 11, 12),
+			right:     nodes(0, 1),
+			want:      [][2]int64{{11, 0}, {12, 1}},
+			wantTotal: 6,
+		},
+		{
+			name:      "square",
+			left:      nodes(0, 1, 2),
+			right:     nodes(10, 11, 13),
+			want:      [][2]int64{{0, 10}, {1, 11}, {2, 13}},
+			wantTotal: 10,
+		},
+		{
+			name:      "infeasible",
+			left:      nodes(0, 2),
+			right:     nodes(10, 11),
+			want:      nil,
+			wantTotal: math.Inf(1),
+		},
+	} {
+		matching, total := HungarianMatching(g, test.left, test.right)
+		var got [][2]int64
+		for _, p := range matching {
+			got = append(got, [2]int64{p[0].ID(), p[1].ID()})
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("unexpected matching for %q: got:%v want:%v", test.name, got, test.want)
+		}
+		if total != test.wantTotal {
+			t.Errorf("unexpected total for %q: got:%v want:%v", test.name, total, test.wantTotal)
+		}
+	}
+}
@@ -0,0 +1,689 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matching
+
+import (
+	"math"
+	"slices"
+)
+
+// MinWeightPerfectMatching returns the minimum weight perfect matching of the
+// complete graph on n vertices where the weight of the edge joining i and j is
+// cost(i, j), using Edmonds' weighted blossom algorithm in O(n^3) time. The
+// vertex matched to i is held in mate[i] and the total weight of the matching
+// is returned in total. The cost function is only called with i < j.
+//
+// A cost of +Inf forbids matching i with j. If n is odd or no perfect matching
+// with a finite total weight exists, MinWeightPerfectMatching returns nil and
+// +Inf. MinWeightPerfectMatching will panic if cost returns NaN or -Inf.
+func MinWeightPerfectMatching(cost func(i, j int) float64, n int) (mate []int, total float64) {
+	if n == 0 {
+		return []int{}, 0
+	}
+	if n%2 != 0 {
+		return nil, math.Inf(1)
+	}
+
+	var edges []weightedEdge
+	maxCost := math.Inf(-1)
+	for i := range n {
+		for j := i + 1; j < n; j++ {
+			w := cost(i, j)
+			if math.IsNaN(w) || math.IsInf(w, -1) {
+				panic("matching: invalid matching cost")
+			}
+			if math.IsInf(w, 1) {
+				continue
+			}
+			edges = append(edges, weightedEdge{i: i, j: j, w: w})
+			maxCost = max(maxCost, w)
+		}
+	}
+	// Every maximum cardinality matching of a complete graph
+	// with an even number of vertices is perfect, so the
+	// maximum weight maximum cardinality matching with the
+	// costs reflected about maxCost+1 is the minimum cost
+	// perfect matching. The reflected weights are positive.
+	c := make([]float64, len(edges))
+	for k := range edges {
+		c[k] = edges[k].w
+		edges[k].w = maxCost + 1 - edges[k].w
+	}
+	mate = newWeightedBlossom(n, edges).maxWeightMatching(true)
+	for k, e := range edges {
+		if mate[e.i] == e.j {
+			total += c[k]
+		}
+	}
+	for _, j := range mate {
+		if j < 0 {
+			return nil, math.Inf(1)
+		}
+	}
+	return mate, total
+}
+
+// weightedEdge is an edge of a weighted blossom search.
+type weightedEdge struct {
+	i, j int
+	w    float64
+}
+
+// weightedBlossom holds the state of a maximum weight matching search. The
+// implementation follows the primal-dual formulation of Edmonds' algorithm
+// described by Galil in "Efficient algorithms for finding maximum matching in
+// graphs", ACM Computing Surveys 18(1):23-38 (1986), and as implemented by
+// Joris van Rantwijk.
+//
+// Vertices are indexed from 0 to n-1 and non-trivial blossoms from n to 2n-1.
+// The two endpoints of edge k are indexed 2k and 2k+1, so the remote endpoint
+// of a vertex's edge end p is p^1.
+//
+// Slacks and duals are compared exactly, which is safe for non-integer
+// weights. An edge whose slack is rounded above zero is not lost, since it
+// is then found as the least-slack edge by the dual update, which allows it
+// explicitly. A blossom dual only reaches zero when it is new, or when the
+// dual update subtracts a delta taken from the dual itself, so it is then
+// exactly zero.
+type weightedBlossom struct {
+	n     int
+	edges []weightedEdge
+
+	// endpoint[p] is the vertex at endpoint p.
+	endpoint []int
+	// neighbourEnds[v] holds the remote endpoints
+	// of the edges incident to v.
+	neighbourEnds [][]int
+
+	// mate[v] is the remote endpoint of the
+	// matched edge of v, or -1.
+	mate []int
+
+	// label[b] is 0 for unlabeled, 1 for S and 2
+	// for T top-level blossoms and vertices, and
+	// labelEnd[b] is the endpoint through which
+	// the label was assigned.
+	label    []int
+	labelEnd []int
+
+	inBlossom       []int
+	blossomParent   []int
+	blossomChildren [][]int
+	blossomBase     []int
+	blossomEnds     [][]int
+
+	bestEdge         []int
+	blossomBestEdges [][]int
+	unusedBlossoms   []int
+
+	dual      []float64
+	allowEdge []bool
+	queue     []int
+}
+
+func newWeightedBlossom(n int, edges []weightedEdge) *weightedBlossom {
+	b := &weightedBlossom{
+		n:                n,
+		edges:            edges,
+		endpoint:         make([]int, 2*len(edges)),
+		neighbourEnds:    make([][]int, n),
+		mate:             make([]int, n),
+		label:            make([]int, 2*n),
+		labelEnd:         make([]int, 2*n),
+		inBlossom:        make([]int, n),
+		blossomParent:    make([]int, 2*n),
+		blossomChildren:  make([][]int, 2*n),
+		blossomBase:      make([]int, 2*n),
+		blossomEnds:      make([][]int, 2*n),
+		bestEdge:         make([]int, 2*n),
+		blossomBestEdges: make([][]int, 2*n),
+		dual:             make([]float64, 2*n),
+		allowEdge:        make([]bool, len(edges)),
+	}
+	var maxWeight float64
+	for k, e := range edges {
+		b.endpoint[2*k] = e.i
+		b.endpoint[2*k+1] = e.j
+		b.neighbourEnds[e.i] = append(b.neighbourEnds[e.i], 2*k+1)
+		b.neighbourEnds[e.j] = append(b.neighbourEnds[e.j], 2*k)
+		maxWeight = max(maxWeight, e.w)
+	}
+	for v := range n {
+		b.mate[v] = -1
+		b.inBlossom[v] = v
+		b.blossomBase[v] = v
+		b.blossomBase[n+v] = -1
+		b.dual[v] = maxWeight
+		b.unusedBlossoms = append(b.unusedBlossoms, n+v)
+	}
+	for i := range b.labelEnd {
+		b.labelEnd[i] = -1
+		b.blossomParent[i] = -1
+		b.bestEdge[i] = -1
+	}
+	return b
+}
+
+// slack returns twice the reduced cost of edge k.
+func (b *weightedBlossom) slack(k int) float64 {
+	e := b.edges[k]
+	return b.dual[e.i] + b.dual[e.j] - 2*e.w
+}
+
+// leaves returns the vertices contained in blossom t.
+func (b *weightedBlossom) leaves(t int) []int {
+	if t < b.n {
+		return []int{t}
+	}
+	var v []int
+	for _, c := range b.blossomChildren[t] {
+		v = append(v, b.leaves(c)...)
+	}
+	return v
+}
+
+// assignLabel assigns label t to the top-level blossom containing vertex w
+// through endpoint p.
+func (b *weightedBlossom) assignLabel(w, t, p int) {
+	for {
+		bw := b.inBlossom[w]
+		b.label[w], b.label[bw] = t, t
+		b.labelEnd[w], b.labelEnd[bw] = p, p
+		b.bestEdge[w], b.bestEdge[bw] = -1, -1
+		if t == 1 {
+			b.queue = append(b.queue, b.leaves(bw)...)
+			return
+		}
+		// The mate of the base of a T-blossom
+		// becomes an S-vertex.
+		base := b.blossomBase[bw]
+		w, t, p = b.endpoint[b.mate[base]], 1, b.mate[base]^1
+	}
+}
+
+// scanBlossom traces back from vertices v and w to find either a new blossom
+// base or an augmenting path. It returns the base vertex or -1 if an
+// augmenting path was found.
+func (b *weightedBlossom) scanBlossom(v, w int) int {
+	var path []int
+	base := -1
+	for v != -1 || w != -1 {
+		bv := b.inBlossom[v]
+		if b.label[bv]&4 != 0 {
+			base = b.blossomBase[bv]
+			break
+		}
+		path = append(path, bv)
+		b.label[bv] = 5
+		if b.labelEnd[bv] == -1 {
+			v = -1
+		} else {
+			v = b.endpoint[b.labelEnd[bv]]
+			bv = b.inBlossom[v]
+			v = b.endpoint[b.labelEnd[bv]]
+		}
+		if w != -1 {
+			v, w = w, v
+		}
+	}
+	for _, bv := range path {
+		b.label[bv] = 1
+	}
+	return base
+}
+
+// addBlossom constructs a new blossom with the given base containing edge k
+// that joins two S-vertices.
+func (b *weightedBlossom) addBlossom(base, k int) {
+	v, w := b.edges[k].i, b.edges[k].j
+	bb := b.inBlossom[base]
+	bv := b.inBlossom[v]
+	bw := b.inBlossom[w]
+
+	nb := b.unusedBlossoms[len(b.unusedBlossoms)-1]
+	b.unusedBlossoms = b.unusedBlossoms[:len(b.unusedBlossoms)-1]
+	b.blossomBase[nb] = base
+	b.blossomParent[nb] = -1
+	b.blossomParent[bb] = nb
+
+	var path, ends []int
+	for bv != bb {
+		b.blossomParent[bv] = nb
+		path = append(path, bv)
+		ends = append(ends, b.labelEnd[bv])
+		v = b.endpoint[b.labelEnd[bv]]
+		bv = b.inBlossom[v]
+	}
+	path = append(path, bb)
+	slices.Reverse(path)
+	slices.Reverse(ends)
+	ends = append(ends, 2*k)
+	for bw != bb {
+		b.blossomParent[bw] = nb
+		path = append(path, bw)
+		ends = append(ends, b.labelEnd[bw]^1)
+		w = b.endpoint[b.labelEnd[bw]]
+		bw = b.inBlossom[w]
+	}
+	b.blossomChildren[nb] = path
+	b.blossomEnds[nb] = ends
+
+	b.label[nb] = 1
+	b.labelEnd[nb] = b.labelEnd[bb]
+	b.dual[nb] = 0
+	for _, v := range b.leaves(nb) {
+		if b.label[b.inBlossom[v]] == 2 {
+			// This T-vertex now becomes an S-vertex.
+			b.queue = append(b.queue, v)
+		}
+		b.inBlossom[v] = nb
+	}
+
+	// Compute the least-slack edges to
+	// neighbouring S-blossoms.
+	bestEdgeTo := make([]int, 2*b.n)
+	for i := range bestEdgeTo {
+		bestEdgeTo[i] = -1
+	}
+	for _, bv := range path {
+		var edgeLists [][]int
+		if b.blossomBestEdges[bv] == nil {
+			for _, v := range b.leaves(bv) {
+				edgeList := make([]int, len(b.neighbourEnds[v]))
+				for i, p := range b.neighbourEnds[v] {
+					edgeList[i] = p / 2
+				}
+				edgeLists = append(edgeLists, edgeList)
+			}
+		} else {
+			edgeLists = [][]int{b.blossomBestEdges[bv]}
+		}
+		for _, edgeList := range edgeLists {
+			for _, k := range edgeList {
+				j := b.edges[k].j
+				if b.inBlossom[j] == nb {
+					j = b.edges[k].i
+				}
+				bj := b.inBlossom[j]
+				if bj != nb && b.label[bj] == 1 && (bestEdgeTo[bj] == -1 || b.slack(k) < b.slack(bestEdgeTo[bj])) {
+					bestEdgeTo[bj] = k
+				}
+			}
+		}
+		b.blossomBestEdges[bv] = nil
+		b.bestEdge[bv] = -1
+	}
+	var best []int
+	for _, k := range bestEdgeTo {
+		if k != -1 {
+			best = append(best, k)
+		}
+	}
+	if best == nil {
+		best = []int{}
+	}
+	b.blossomBestEdges[nb] = best
+	b.bestEdge[nb] = -1
+	for _, k := range best {
+		if b.bestEdge[nb] == -1 || b.slack(k) < b.slack(b.bestEdge[nb]) {
+			b.bestEdge[nb] = k
+		}
+	}
+}
+
+// at returns s[i] indexing from the end of s for negative i.
+func at(s []int, i int) int {
+	if i < 0 {
+		i += len(s)
+	}
+	return s[i]
+}
+
+// expandBlossom expands the top-level blossom t. If endStage is true, the
+// blossom is being expanded at the end of a stage and sub-blossoms with zero
+// dual are expanded recursively.
+func (b *weightedBlossom) expandBlossom(t int, endStage bool) {
+	for _, s := range b.blossomChildren[t] {
+		b.blossomParent[s] = -1
+		switch {
+		case s < b.n:
+			b.inBlossom[s] = s
+		case endStage && b.dual[s] == 0:
+			b.expandBlossom(s, endStage)
+		default:
+			for _, v := range b.leaves(s) {
+				b.inBlossom[v] = s
+			}
+		}
+	}
+
+	if !endStage && b.label[t] == 2 {
+		// Relabel the sub-blossoms on the even
+		// length path from the entry child to
+		// the base as alternating T and S, and
+		// leave the others unlabeled.
+		children := b.blossomChildren[t]
+		ends := b.blossomEnds[t]
+		entryChild := b.inBlossom[b.endpoint[b.labelEnd[t]^1]]
+		j := slices.Index(children, entryChild)
+		var jStep, endTrick int
+		if j&1 != 0 {
+			j -= len(children)
+			jStep = 1
+			endTrick = 0
+		} else {
+			jStep = -1
+			endTrick = 1
+		}
+		p := b.labelEnd[t]
+		for j != 0 {
+			b.label[b.endpoint[p^1]] = 0
+			b.label[b.endpoint[at(ends, j-endTrick)^endTrick^1]] = 0
+			b.assignLabel(b.endpoint[p^1], 2, p)
+			b.allowEdge[at(ends, j-endTrick)/2] = true
+			j += jStep
+			p = at(ends, j-endTrick) ^ endTrick
+			b.allowEdge[p/2] = true
+			j += jStep
+		}
+		bv := at(children, j)
+		b.label[b.endpoint[p^1]], b.label[bv] = 2, 2
+		b.labelEnd[b.endpoint[p^1]], b.labelEnd[bv] = p, p
+		b.bestEdge[bv] = -1
+		j += jStep
+		for at(children, j) != entryChild {
+			bv := at(children, j)
+			if b.label[bv] == 1 {
+				// This sub-blossom has become an
+				// S-blossom through another route.
+				j += jStep
+				continue
+			}
+			v := -1
+			for _, l := range b.leaves(bv) {
+				if b.label[l] != 0 {
+					v = l
+					break
+				}
+			}
+			if v != -1 {
+				// The sub-blossom is reachable from
+				// outside through a T-vertex.
+				b.label[v] = 0
+				b.label[b.endpoint[b.mate[b.blossomBase[bv]]]] = 0
+				b.assignLabel(v, 2, b.labelEnd[v])
+			}
+			j += jStep
+		}
+	}
+
+	b.label[t], b.labelEnd[t] = -1, -1
+	b.blossomChildren[t], b.blossomEnds[t] = nil, nil
+	b.blossomBase[t] = -1
+	b.blossomBestEdges[t] = nil
+	b.bestEdge[t] = -1
+	b.unusedBlossoms = append(b.unusedBlossoms, t)
+}
+
+// augmentBlossom swaps matched and unmatched edges over an alternating path
+// through blossom t between vertex v and the base vertex.
+func (b *weightedBlossom) augmentBlossom(t, v int) {
+	s := v
+	for b.blossomParent[s] != t {
+		s = b.blossomParent[s]
+	}
+	if s >= b.n {
+		b.augmentBlossom(s, v)
+	}
+	children := b.blossomChildren[t]
+	ends := b.blossomEnds[t]
+	i := slices.Index(children, s)
+	j := i
+	var jStep, endTrick int
+	if i&1 != 0 {
+		j -= len(children)
+		jStep = 1
+		endTrick = 0
+	} else {
+		jStep = -1
+		endTrick = 1
+	}
+	for j != 0 {
+		j += jStep
+		s = at(children, j)
+		p := at(ends, j-endTrick) ^ endTrick
+		if s >= b.n {
+			b.augmentBlossom(s, b.endpoint[p])
+		}
+		j += jStep
+		s = at(children, j)
+		if s >= b.n {
+			b.augmentBlossom(s, b.endpoint[p^1])
+		}
+		b.mate[b.endpoint[p]] = p ^ 1
+		b.mate[b.endpoint[p^1]] = p
+	}
+	// Rotate the children so the new base is first.
+	b.blossomChildren[t] = append(slices.Clone(children[i:]), children[:i]...)
+	b.blossomEnds[t] = append(slices.Clone(ends[i:]), ends[:i]...)
+	b.blossomBase[t] = b.blossomBase[b.blossomChildren[t][0]]
+}
+
+// augmentMatching swaps matched and unmatched edges over the augmenting path
+// through edge k.
+func (b *weightedBlossom) augmentMatching(k int) {
+	v, w := b.edges[k].i, b.edges[k].j
+	for _, sp := range [2][2]int{{v, 2*k + 1}, {w, 2 * k}} {
+		s, p := sp[0], sp[1]
+		for {
+			bs := b.inBlossom[s]
+			if bs >= b.n {
+				b.augmentBlossom(bs, s)
+			}
+			b.mate[s] = p
+			if b.labelEnd[bs] == -1 {
+				// Reached a single vertex.
+				break
+			}
+			t := b.endpoint[b.labelEnd[bs]]
+			bt := b.inBlossom[t]
+			s = b.endpoint[b.labelEnd[bt]]
+			j := b.endpoint[b.labelEnd[bt]^1]
+			if bt >= b.n {
+				b.augmentBlossom(bt, j)
+			}
+			b.mate[j] = b.labelEnd[bt]
+			p = b.labelEnd[bt] ^ 1
+		}
+	}
+}
+
+// maxWeightMatching returns the maximum weight matching of the search graph
+// as the mate of each vertex, or -1 for unmatched vertices. If maxCardinality
+// is true, the matching is the maximum weight matching among the maximum
+// cardinality matchings.
+func (b *weightedBlossom) maxWeightMatching(maxCardinality bool) []int {
+	n := b.n
+	for range n {
+		// Start a new stage.
+		for i := range b.label {
+			b.label[i] = 0
+			b.bestEdge[i] = -1
+		}
+		for i := n; i < 2*n; i++ {
+			b.blossomBestEdges[i] = nil
+		}
+		for i := range b.allowEdge {
+			b.allowEdge[i] = false
+		}
+		b.queue = b.queue[:0]
+		for v := range n {
+			if b.mate[v] == -1 && b.label[b.inBlossom[v]] == 0 {
+				b.assignLabel(v, 1, -1)
+			}
+		}
+
+		augmented := false
+		for {
+			for len(b.queue) != 0 && !augmented {
+				v := b.queue[len(b.queue)-1]
+				b.queue = b.queue[:len(b.queue)-1]
+				for _, p := range b.neighbourEnds[v] {
+					k := p / 2
+					w := b.endpoint[p]
+					if b.inBlossom[v] == b.inBlossom[w] {
+						continue
+					}
+					var kSlack float64
+					if !b.allowEdge[k] {
+						kSlack = b.slack(k)
+						if kSlack <= 0 {
+							b.allowEdge[k] = true
+						}
+					}
+					switch {
+					case b.allowEdge[k]:
+						switch {
+						case b.label[b.inBlossom[w]] == 0:
+							b.assignLabel(w, 2, p^1)
+						case b.label[b.inBlossom[w]] == 1:
+							base := b.scanBlossom(v, w)
+							if base >= 0 {
+								b.addBlossom(base, k)
+							} else {
+								b.augmentMatching(k)
+								augmented = true
+							}
+						case b.label[w] == 0:
+							b.label[w] = 2
+							b.labelEnd[w] = p ^ 1
+						}
+					case b.label[b.inBlossom[w]] == 1:
+						bv := b.inBlossom[v]
+						if b.bestEdge[bv] == -1 || kSlack < b.slack(b.bestEdge[bv]) {
+							b.bestEdge[bv] = k
+						}
+					case b.label[w] == 0:
+						if b.bestEdge[w] == -1 || kSlack < b.slack(b.bestEdge[w]) {
+							b.bestEdge[w] = k
+						}
+					}
+					if augmented {
+						break
+					}
+				}
+			}
+			if augmented {
+				break
+			}
+
+			// No augmenting path was found with
+			// the current duals, so update them.
+			deltaType := -1
+			var (
+				delta        float64
+				deltaEdge    int
+				deltaBlossom int
+			)
+			if !maxCardinality {
+				deltaType = 1
+				delta = slices.Min(b.dual[:n])
+			}
+			for v := range n {
+				if b.label[b.inBlossom[v]] == 0 && b.bestEdge[v] != -1 {
+					d := b.slack(b.bestEdge[v])
+					if deltaType == -1 || d < delta {
+						delta = d
+						deltaType = 2
+						deltaEdge = b.bestEdge[v]
+					}
+				}
+			}
+			for t := range 2 * n {
+				if b.blossomParent[t] == -1 && b.label[t] == 1 && b.bestEdge[t] != -1 {
+					d := b.slack(b.bestEdge[t]) / 2
+					if deltaType == -1 || d < delta {
+						delta = d
+						deltaType = 3
+						deltaEdge = b.bestEdge[t]
+					}
+				}
+			}
+			for t := n; t < 2*n; t++ {
+				if b.blossomBase[t] >= 0 && b.blossomParent[t] == -1 && b.label[t] == 2 && (deltaType == -1 || b.dual[t] < delta) {
+					delta = b.dual[t]
+					deltaType = 4
+					deltaBlossom = t
+				}
+			}
+			if deltaType == -1 {
+				// No further improvement is possible
+				// with maximum cardinality, so stop.
+				deltaType = 1
+				delta = max(0, slices.Min(b.dual[:n]))
+			}
+
+			for v := range n {
+				switch b.label[b.inBlossom[v]] {
+				case 1:
+					b.dual[v] -= delta
+				case 2:
+					b.dual[v] += delta
+				}
+			}
+			for t := n; t < 2*n; t++ {
+				if b.blossomBase[t] >= 0 && b.blossomParent[t] == -1 {
+					switch b.label[t] {
+					case 1:
+						b.dual[t] += delta
+					case 2:
+						b.dual[t] -= delta
+					}
+				}
+			}
+
+			switch deltaType {
+			case 1:
+				// The optimum has been reached.
+			case 2:
+				b.allowEdge[deltaEdge] = true
+				i, j := b.edges[deltaEdge].i, b.edges[deltaEdge].j
+				if b.label[b.inBlossom[i]] == 0 {
+					i = j
+				}
+				b.queue = append(b.queue, i)
+				continue
+			case 3:
+				b.allowEdge[deltaEdge] = true
+				b.queue = append(b.queue, b.edges[deltaEdge].i)
+				continue
+			case 4:
+				b.expandBlossom(deltaBlossom, false)
+				continue
+			}
+			break
+		}
+		if !augmented {
+			break
+		}
+
+		// End of stage: expand all S-blossoms
+		// with zero dual.
+		for t := n; t < 2*n; t++ {
+			if b.blossomParent[t] == -1 && b.blossomBase[t] >= 0 && b.label[t] == 1 && b.dual[t] == 0 {
+				b.expandBlossom(t, true)
+			}
+		}
+	}
+
+	mate := make([]int, n)
+	for v, p := range b.mate {
+		mate[v] = -1
+		if p >= 0 {
+			mate[v] = b.endpoint[p]
+		}
+	}
+	return mate
+}
@@ -0,0 +1,89 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matching
+
+import (
+	"math"
+	"testing"
+)
+
+var minWeightPerfectMatchingTests = []struct {
+	name string
+	cost [][]float64
+
+	wantTotal float64
+}{
+	{
+		name:      "empty",
+		cost:      [][]float64{},
+		wantTotal: 0,
+	},
+	{
+		name:      "odd",
+		cost:      [][]float64{{0, 1, 1}, {1, 0, 1}, {1, 1, 0}},
+		wantTotal: math.Inf(1),
+	},
+	{
+		name:      "pair",
+		cost:      [][]float64{{0, 3}, {3, 0}},
+		wantTotal: 3,
+	},
+	{
+		// The greedy choice of the cheapest edge,
+		// 1-2, forces the expensive edge 0-3.
+		name: "greedy trap",
+		cost: [][]float64{
+			{0, 2, 9, 100},
+			{2, 0, 1, 9},
+			{9, 1, 0, 2},
+			{100, 9, 2, 0},
+		},
+		wantTotal: 4,
+	},
+	{
+		// Two triangles joined by a single edge
+		// require contraction of an odd cycle.
+		name: "blossom",
+		cost: [][]float64{
+			{0, 1, 1, inf, inf, inf},
+			{1, 0, 1, inf, inf, inf},
+			{1, 1, 0, 5, inf, inf},
+			{inf, inf, 5, 0, 1, 1},
+			{inf, inf, inf, 1, 0, 1},
+			{inf, inf, inf, 1, 1, 0},
+		},
+		wantTotal: 7,
+	},
+	{
+		name: "infeasible",
+		cost: [][]float64{
+			{0, 1, 1, inf},
+			{1, 0, 1, inf},
+			{1, 1, 0, inf},
+			{inf, inf, inf, 0},
+		},
+		wantTotal: math.Inf(1),
+	},
+}
+
+var inf = math.Inf(1)
+
+func TestMinWeightPerfectMatching(t *testing.T) {
+	t.Parallel()
+	for _, test := range minWeightPerfectMatchingTests {
+		n := len(test.cost)
+		mate, total := MinWeightPerfectMatching(func(i, j int) float64 { return test.cost[i][j] }, n)
+		if total != test.wantTotal {
+			t.Errorf("unexpected total for %q: got:%v want:%v", test.name, total, test.wantTotal)
+		}
+		if math.IsInf(test.wantTotal, 1) {
+			if mate != nil {
+				t.Errorf("unexpected matching for %q: %v", test.name, mate)
+			}
+			continue
+		}
+		checkPerfectMate(t, test.name, test.cost, mate, total)
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package network
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/graph"
+)
+
+// DegreeAssortativity returns the degree assortativity coefficient of the
+// undirected graph g, the Pearson correlation coefficient of the degrees of
+// the nodes at either end of the edges of g,
+//
+//	r = (Σ_e j.k/m - (Σ_e (j+k)/2m)^2) / (Σ_e (j^2+k^2)/2m - (Σ_e (j+k)/2m)^2)
+//
+// where j and k are the degrees of the nodes joined by edge e and m is the
+// number of edges, as defined by Newman in doi:10.1103/PhysRevLett.89.208701.
+// The coefficient is in [-1, 1]; positive values indicate that nodes tend to
+// be joined to nodes of similar degree, and negative values that high degree
+// nodes tend to be joined to low degree nodes. The degree of a node is the
+// number of its neighbours, and self edges are ignored.
+//
+// If g has fewer than two edges, or the degrees at the ends of the edges do not
+// vary, as in a regular graph, the coefficient is undefined and
+// DegreeAssortativity returns NaN.
+func DegreeAssortativity(g graph.Undirected) float64 {
+	nodes := graph.NodesOf(g.Nodes())
+	deg := make(map[int64]float64, len(nodes))
+	for _, u := range nodes {
+		uid := u.ID()
+		to := g.From(uid)
+		for to.Next() {
+			if to.Node().ID() != uid {
+				deg[uid]++
+			}
+		}
+	}
+
+	var m, prod, sum, sumSq float64
+	for _, u := range nodes {
+		uid := u.ID()
+		to := g.From(uid)
+		for to.Next() {
+			vid := to.Node().ID()
+			if vid <= uid {
+				continue
+			}
+			j, k := deg[uid], deg[vid]
+			m++
+			prod += j * k
+			sum += (j + k) / 2
+			sumSq += (j*j + k*k) / 2
+		}
+	}
+	if m < 2 {
+		return math.NaN()
+	}
+	mean := sum / m
+	den := sumSq/m - mean*mean
+	if den == 0 {
+		return math.NaN()
+	}
+	return (prod/m - mean*mean) / den
+}
@@ -0,0 +1,105 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package network
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/stat"
+)
+
+var degreeAssortativityTests = []struct {
+	name  string
+	edges [][2]int64
+	want  float64
+}{
+	{
+		name: "empty",
+		want: math.NaN(),
+	},
+	{
+		name:  "single edge",
+		edges: [][2]int64{{0, 1}},
+		want:  math.NaN(),
+	},
+	{
+		name:  "star",
+		edges: [][2]int64{{0, 1}, {0, 2}, {0, 3}},
+		want:  -1,
+	},
+	{
+		name:  "path",
+		edges: [][2]int64{{0, 1}, {1, 2}, {2, 3}},
+		want:  -0.5,
+	},
+	{
+		name:  "cycle",
+		edges: [][2]int64{{0, 1}, {1, 2}, {2, 3}, {3, 0}},
+		want:  math.NaN(),
+	},
+	{
+		// Two disjoint stars of different sizes.
+		name:  "stars",
+		edges: [][2]int64{{0, 1}, {0, 2}, {3, 4}, {3, 5}, {3, 6}},
+		want:  -16.0 / 19,
+	},
+	{
+		// Edges only join nodes of equal degree.
+		name:  "clusters",
+		edges: [][2]int64{{0, 1}, {1, 2}, {2, 0}, {3, 4}, {4, 5}, {5, 3}, {6, 7}},
+		want:  1,
+	},
+}
+
+func TestDegreeAssortativity(t *testing.T) {
+	t.Parallel()
+	for _, test := range degreeAssortativityTests {
+		g := simple.NewUndirectedGraph()
+		for _, e := range test.edges {
+			g.SetEdge(simple.Edge{F: simple.Node(e[0]), T: simple.Node(e[1])})
+		}
+		got := DegreeAssortativity(g)
+		if !scalar.Same(got, test.want) && !scalar.EqualWithinAbsOrRel(got, test.want, 1e-12, 1e-12) {
+			t.Errorf("unexpected assortativity for %q: got:%v want:%v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestDegreeAssortativityPearson(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for trial := 0; trial < 20; trial++ {
+		g := simple.NewUndirectedGraph()
+		const n = 30
+		for i := 0; i < 3*n; i++ {
+			u, v := rnd.IntN(n), rnd.IntN(n)
+			if u != v {
+				g.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+			}
+		}
+		// The coefficient is the correlation of degrees
+		// over both orientations of each edge.
+		var x, y []float64
+		for _, e := range graph.EdgesOf(g.Edges()) {
+			j := float64(g.From(e.From().ID()).Len())
+			k := float64(g.From(e.To().ID()).Len())
+			x = append(x, j, k)
+			y = append(y, k, j)
+		}
+		want := stat.Correlation(x, y, nil)
+		got := DegreeAssortativity(g)
+		if !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+			t.Errorf("unexpected assortativity for graph %d: got:%v want:%v", trial, got, want)
+		}
+		if got < -1 || got > 1 {
+			t.Errorf("assortativity out of range for graph %d: %v", trial, got)
+		}
+	}
+}
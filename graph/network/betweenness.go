@@ -5,6 +5,7 @@
 package network
 
 import (
+	"container/heap"
 	"math"
 
 	"gonum.org/v1/gonum/graph"
@@ -59,6 +60,8 @@ func Betweenness(g graph.Graph) map[int64]float64 {
 //
 // If g is undirected, edges are retained such that u.ID < v.ID where u and v are
 // the nodes of e.
+//
+// The time complexity of EdgeBetweenness is O(|V|.|E|).
 func EdgeBetweenness(g graph.Graph) map[[2]int64]float64 {
 	// Modified from Brandes' original algorithm as described in Algorithm 7
 	// with the exception that node betweenness is not calculated:
@@ -143,6 +146,190 @@ func brandes(g graph.Graph, accumulate func(s graph.Node, stack linear.NodeStack
 	}
 }
 
+// BetweennessDijkstra returns the non-zero betweenness centrality for nodes in
+// the weighted graph g.
+//
+//	C_B(v) = \sum_{s ≠ v ≠ t ∈ V} (\sigma_{st}(v) / \sigma_{st})
+//
+// where \sigma_{st} and \sigma_{st}(v) are the number of shortest paths from s to t,
+// and the subset of those paths containing v respectively.
+//
+// Unlike BetweennessWeighted, BetweennessDijkstra does not require the shortest
+// paths of g to be computed in advance. The number of shortest paths through
+// each node is counted during a Dijkstra search from each node, so paths of
+// equal weight contribute fractionally without being enumerated. Path weights
+// are compared exactly, so paths whose weights differ only by floating point
+// rounding are not considered equal. If the graph does not implement
+// graph.Weighted, path.UniformCost is used, giving the same result as
+// Betweenness. BetweennessDijkstra will panic if g has a negative edge weight,
+// and the result is not defined if g has a zero edge weight.
+//
+// The time complexity of BetweennessDijkstra is O(|V|.|E|+|V|^2.log|V|).
+func BetweennessDijkstra(g graph.Graph) map[int64]float64 {
+	// Brandes' algorithm for finding betweenness centrality for nodes in
+	// a weighted graph:
+	//
+	// http://www.inf.uni-konstanz.de/algo/publications/b-fabc-01.pdf
+
+	cb := make(map[int64]float64)
+	brandesWeighted(g, func(s graph.Node, stack linear.NodeStack, p map[int64][]graph.Node, delta, sigma map[int64]float64) {
+		for stack.Len() != 0 {
+			w := stack.Pop()
+			for _, v := range p[w.ID()] {
+				delta[v.ID()] += sigma[v.ID()] / sigma[w.ID()] * (1 + delta[w.ID()])
+			}
+			if w.ID() != s.ID() {
+				if d := delta[w.ID()]; d != 0 {
+					cb[w.ID()] += d
+				}
+			}
+		}
+	})
+	return cb
+}
+
+// EdgeBetweennessDijkstra returns the non-zero betweenness centrality for edges
+// in the weighted graph g. For an edge e the centrality C_B is computed as
+//
+//	C_B(e) = \sum_{s ≠ t ∈ V} (\sigma_{st}(e) / \sigma_{st}),
+//
+// where \sigma_{st} and \sigma_{st}(e) are the number of shortest paths from s
+// to t, and the subset of those paths containing e, respectively.
+//
+// If g is undirected, edges are retained such that u.ID < v.ID where u and v are
+// the nodes of e.
+//
+// Shortest paths are found and counted as described for BetweennessDijkstra,
+// with the same requirements on edge weights. If the graph does not implement
+// graph.Weighted, path.UniformCost is used, giving the same result as
+// EdgeBetweenness.
+//
+// The time complexity of EdgeBetweennessDijkstra is O(|V|.|E|+|V|^2.log|V|).
+func EdgeBetweennessDijkstra(g graph.Graph) map[[2]int64]float64 {
+	_, isUndirected := g.(graph.Undirected)
+	cb := make(map[[2]int64]float64)
+	brandesWeighted(g, func(s graph.Node, stack linear.NodeStack, p map[int64][]graph.Node, delta, sigma map[int64]float64) {
+		for stack.Len() != 0 {
+			w := stack.Pop()
+			for _, v := range p[w.ID()] {
+				c := sigma[v.ID()] / sigma[w.ID()] * (1 + delta[w.ID()])
+				vid := v.ID()
+				wid := w.ID()
+				if isUndirected && wid < vid {
+					vid, wid = wid, vid
+				}
+				cb[[2]int64{vid, wid}] += c
+				delta[v.ID()] += c
+			}
+		}
+	})
+	return cb
+}
+
+// brandesWeighted is the common code for BetweennessDijkstra and
+// EdgeBetweennessDijkstra. It corresponds to brandes with the breadth
+// first search replaced by a Dijkstra search, as described in section
+// 3 of http://www.inf.uni-konstanz.de/algo/publications/b-fabc-01.pdf.
+// The stack holds the nodes in the order they are settled so it returns
+// nodes in order of non-increasing distance from s.
+func brandesWeighted(g graph.Graph, accumulate func(s graph.Node, stack linear.NodeStack, p map[int64][]graph.Node, delta, sigma map[int64]float64)) {
+	var weight path.Weighting
+	if wg, ok := g.(path.Weighted); ok {
+		weight = wg.Weight
+	} else {
+		weight = path.UniformCost(g)
+	}
+
+	var (
+		nodes = graph.NodesOf(g.Nodes())
+		stack linear.NodeStack
+		p     = make(map[int64][]graph.Node, len(nodes))
+		sigma = make(map[int64]float64, len(nodes))
+		d     = make(map[int64]float64, len(nodes))
+		delta = make(map[int64]float64, len(nodes))
+		done  = make(map[int64]bool, len(nodes))
+		queue distanceQueue
+	)
+	for _, s := range nodes {
+		stack = stack[:0]
+
+		for _, w := range nodes {
+			p[w.ID()] = p[w.ID()][:0]
+		}
+
+		for _, t := range nodes {
+			sigma[t.ID()] = 0
+			d[t.ID()] = math.Inf(1)
+			done[t.ID()] = false
+		}
+		sigma[s.ID()] = 1
+		d[s.ID()] = 0
+
+		heap.Push(&queue, distanceNode{node: s, dist: 0})
+		for queue.Len() != 0 {
+			mid := heap.Pop(&queue).(distanceNode)
+			v := mid.node
+			vid := v.ID()
+			if done[vid] {
+				continue
+			}
+			done[vid] = true
+			stack.Push(v)
+			to := g.From(vid)
+			for to.Next() {
+				w := to.Node()
+				wid := w.ID()
+				c, ok := weight(vid, wid)
+				if !ok {
+					panic("network: unexpected invalid weight")
+				}
+				if c < 0 {
+					panic("network: negative edge weight")
+				}
+				joint := d[vid] + c
+				switch {
+				case joint < d[wid]:
+					// Shorter path to w found via v.
+					d[wid] = joint
+					heap.Push(&queue, distanceNode{node: w, dist: joint})
+					sigma[wid] = sigma[vid]
+					p[wid] = append(p[wid][:0], v)
+				case joint == d[wid]:
+					// Another shortest path to w via v.
+					sigma[wid] += sigma[vid]
+					p[wid] = append(p[wid], v)
+				}
+			}
+		}
+
+		for _, v := range nodes {
+			delta[v.ID()] = 0
+		}
+
+		accumulate(s, stack, p, delta, sigma)
+	}
+}
+
+// distanceNode is a node and its distance from a search source.
+type distanceNode struct {
+	node graph.Node
+	dist float64
+}
+
+// distanceQueue implements a no-dec priority queue on distance.
+type distanceQueue []distanceNode
+
+func (q distanceQueue) Len() int            { return len(q) }
+func (q distanceQueue) Less(i, j int) bool  { return q[i].dist < q[j].dist }
+func (q distanceQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *distanceQueue) Push(n interface{}) { *q = append(*q, n.(distanceNode)) }
+func (q *distanceQueue) Pop() interface{} {
+	t := *q
+	var n interface{}
+	n, *q = t[len(t)-1], t[:len(t)-1]
+	return n
+}
+
 // BetweennessWeighted returns the non-zero betweenness centrality for nodes in the weighted
 // graph g used to construct the given shortest paths.
 //
@@ -8,10 +8,12 @@ import (
 	"cmp"
 	"fmt"
 	"math"
+	"math/rand/v2"
 	"slices"
 	"testing"
 
 	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/graph"
 	"gonum.org/v1/gonum/graph/path"
 	"gonum.org/v1/gonum/graph/simple"
 )
@@ -272,6 +274,73 @@ func TestBetweennessWeighted(t *testing.T) {
 	}
 }
 
+func TestBetweennessDijkstra(t *testing.T) {
+	for i, test := range betweennessTests {
+		g := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+		for u, e := range test.g {
+			// Add nodes that are not defined by an edge.
+			if g.Node(int64(u)) == nil {
+				g.AddNode(simple.Node(u))
+			}
+			for v := range e {
+				g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(u), T: simple.Node(v), W: 1})
+			}
+		}
+
+		got := BetweennessDijkstra(g)
+		prec := 1 - int(math.Log10(test.wantTol))
+		for n := range test.g {
+			gotN, gotOK := got[int64(n)]
+			wantN, wantOK := test.want[int64(n)]
+			if gotOK != wantOK {
+				t.Errorf("unexpected betweenness existence for test %d, node %c", i, n+'A')
+			}
+			if !scalar.EqualWithinAbsOrRel(gotN, wantN, test.wantTol, test.wantTol) {
+				t.Errorf("unexpected betweenness result for test %d:\ngot: %v\nwant:%v",
+					i, orderedFloats(got, prec), orderedFloats(test.want, prec))
+				break
+			}
+		}
+	}
+}
+
+func TestBetweennessDijkstraRandom(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for n := 0; n < 10; n++ {
+		const size = 30
+		var g interface {
+			graph.Weighted
+			graph.WeightedBuilder
+		}
+		if n%2 == 0 {
+			g = simple.NewWeightedDirectedGraph(0, math.Inf(1))
+		} else {
+			g = simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+		}
+		for i := 0; i < size; i++ {
+			g.AddNode(simple.Node(i))
+		}
+		for i := 0; i < size; i++ {
+			for j := 0; j < size; j++ {
+				if i != j && rnd.Float64() < 0.1 {
+					// Small integer weights give many
+					// shortest paths of equal weight.
+					g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(i), T: simple.Node(j), W: float64(1 + rnd.IntN(3))})
+				}
+			}
+		}
+
+		got := BetweennessDijkstra(g)
+		want := BetweennessWeighted(g, path.DijkstraAllPaths(g))
+		for _, u := range graph.NodesOf(g.Nodes()) {
+			id := u.ID()
+			if !scalar.EqualWithinAbsOrRel(got[id], want[id], 1e-10, 1e-10) {
+				t.Errorf("unexpected betweenness for node %d in graph %d: got:%v want:%v", id, n, got[id], want[id])
+			}
+		}
+	}
+}
+
 func TestEdgeBetweennessWeighted(t *testing.T) {
 	for i, test := range betweennessTests {
 		g := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
@@ -311,6 +380,76 @@ func TestEdgeBetweennessWeighted(t *testing.T) {
 	}
 }
 
+func TestEdgeBetweennessDijkstra(t *testing.T) {
+	for i, test := range betweennessTests {
+		g := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+		for u, e := range test.g {
+			// Add nodes that are not defined by an edge.
+			if g.Node(int64(u)) == nil {
+				g.AddNode(simple.Node(u))
+			}
+			for v := range e {
+				g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(u), T: simple.Node(v), W: 1})
+			}
+		}
+
+		got := EdgeBetweennessDijkstra(g)
+		prec := 1 - int(math.Log10(test.wantTol))
+	outer:
+		for u := range test.g {
+			for v := range test.g {
+				gotQ, gotOK := got[[2]int64{int64(u), int64(v)}]
+				wantQ, wantOK := test.wantEdges[[2]int64{int64(u), int64(v)}]
+				if gotOK != wantOK {
+					t.Errorf("unexpected betweenness result for test %d, edge (%c,%c)", i, u+'A', v+'A')
+				}
+				if !scalar.EqualWithinAbsOrRel(gotQ, wantQ, test.wantTol, test.wantTol) {
+					t.Errorf("unexpected betweenness result for test %d:\ngot: %v\nwant:%v",
+						i, orderedPairFloats(got, prec), orderedPairFloats(test.wantEdges, prec))
+					break outer
+				}
+			}
+		}
+	}
+}
+
+func TestEdgeBetweennessDijkstraRandom(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for n := 0; n < 10; n++ {
+		const size = 30
+		var g interface {
+			graph.Weighted
+			graph.WeightedBuilder
+		}
+		if n%2 == 0 {
+			g = simple.NewWeightedDirectedGraph(0, math.Inf(1))
+		} else {
+			g = simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+		}
+		for i := 0; i < size; i++ {
+			g.AddNode(simple.Node(i))
+		}
+		for i := 0; i < size; i++ {
+			for j := 0; j < size; j++ {
+				if i != j && rnd.Float64() < 0.1 {
+					g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(i), T: simple.Node(j), W: float64(1 + rnd.IntN(3))})
+				}
+			}
+		}
+
+		got := EdgeBetweennessDijkstra(g)
+		want := EdgeBetweennessWeighted(g, path.DijkstraAllPaths(g))
+		if len(got) != len(want) {
+			t.Errorf("unexpected number of edges with betweenness in graph %d: got:%d want:%d", n, len(got), len(want))
+		}
+		for e, w := range want {
+			if !scalar.EqualWithinAbsOrRel(got[e], w, 1e-10, 1e-10) {
+				t.Errorf("unexpected betweenness for edge %v in graph %d: got:%v want:%v", e, n, got[e], w)
+			}
+		}
+	}
+}
+
 func orderedPairFloats(w map[[2]int64]float64, prec int) []pairKeyFloatVal {
 	o := make([]pairKeyFloatVal, 0, len(w))
 	for k, v := range w {
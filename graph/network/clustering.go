@@ -0,0 +1,56 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package network
+
+import (
+	"gonum.org/v1/gonum/graph"
+)
+
+// ClusteringCoefficients returns the local clustering coefficient of each node
+// in the undirected graph g,
+//
+//	C(v) = 2 T(v) / (k_v (k_v - 1))
+//
+// where T(v) is the number of triangles containing v and k_v is the number of
+// neighbours of v, so C(v) is the fraction of pairs of neighbours of v that are
+// themselves neighbours. Nodes with fewer than two neighbours have a clustering
+// coefficient of zero. Self edges are ignored.
+//
+// The time complexity of ClusteringCoefficients is O(|E|^1.5).
+func ClusteringCoefficients(g graph.Undirected) map[int64]float64 {
+	t := countTriangles(g, true)
+	c := make(map[int64]float64, len(t.nodes))
+	for i, u := range t.nodes {
+		k := float64(t.deg[i])
+		if k < 2 {
+			c[u.ID()] = 0
+			continue
+		}
+		c[u.ID()] = 2 * float64(t.perNode[i]) / (k * (k - 1))
+	}
+	return c
+}
+
+// GlobalClusteringCoefficient returns the global clustering coefficient, or
+// transitivity, of the undirected graph g,
+//
+//	C = 3 T / P
+//
+// where T is the number of triangles in g and P is the number of connected
+// triples, paths of length two, in g. If g has no connected triples, the
+// coefficient is zero. Self edges are ignored.
+//
+// The time complexity of GlobalClusteringCoefficient is O(|E|^1.5).
+func GlobalClusteringCoefficient(g graph.Undirected) float64 {
+	t := countTriangles(g, false)
+	var triples float64
+	for _, k := range t.deg {
+		triples += float64(k) * float64(k-1) / 2
+	}
+	if triples == 0 {
+		return 0
+	}
+	return 3 * float64(t.total) / triples
+}
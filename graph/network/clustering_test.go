@@ -0,0 +1,132 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package network
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+var clusteringTests = []struct {
+	name  string
+	edges [][2]int64
+	nodes []int64 // Isolated nodes.
+
+	wantLocal  map[int64]float64
+	wantGlobal float64
+}{
+	{
+		name:       "empty",
+		wantLocal:  map[int64]float64{},
+		wantGlobal: 0,
+	},
+	{
+		name:       "isolated",
+		nodes:      []int64{0},
+		wantLocal:  map[int64]float64{0: 0},
+		wantGlobal: 0,
+	},
+	{
+		name:       "K4",
+		edges:      [][2]int64{{0, 1}, {0, 2}, {0, 3}, {1, 2}, {1, 3}, {2, 3}},
+		wantLocal:  map[int64]float64{0: 1, 1: 1, 2: 1, 3: 1},
+		wantGlobal: 1,
+	},
+	{
+		name:       "tree",
+		edges:      [][2]int64{{0, 1}, {0, 2}, {1, 3}, {1, 4}, {2, 5}},
+		wantLocal:  map[int64]float64{0: 0, 1: 0, 2: 0, 3: 0, 4: 0, 5: 0},
+		wantGlobal: 0,
+	},
+	{
+		// A triangle with a pendant node on 0.
+		name:       "paw",
+		edges:      [][2]int64{{0, 1}, {1, 2}, {2, 0}, {0, 3}},
+		wantLocal:  map[int64]float64{0: 1.0 / 3, 1: 1, 2: 1, 3: 0},
+		wantGlobal: 3.0 / 5,
+	},
+	{
+		// Two triangles sharing the edge 0-1.
+		name:       "diamond",
+		edges:      [][2]int64{{0, 1}, {0, 2}, {1, 2}, {0, 3}, {1, 3}},
+		wantLocal:  map[int64]float64{0: 2.0 / 3, 1: 2.0 / 3, 2: 1, 3: 1},
+		wantGlobal: 6.0 / 8,
+	},
+}
+
+func TestClusteringCoefficients(t *testing.T) {
+	t.Parallel()
+	for _, test := range clusteringTests {
+		g := simple.NewUndirectedGraph()
+		for _, id := range test.nodes {
+			g.AddNode(simple.Node(id))
+		}
+		for _, e := range test.edges {
+			g.SetEdge(simple.Edge{F: simple.Node(e[0]), T: simple.Node(e[1])})
+		}
+		local := ClusteringCoefficients(g)
+		if len(local) != len(test.wantLocal) {
+			t.Errorf("unexpected number of coefficients for %q: got:%d want:%d", test.name, len(local), len(test.wantLocal))
+		}
+		for id, want := range test.wantLocal {
+			if got := local[id]; !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+				t.Errorf("unexpected local coefficient of %d for %q: got:%v want:%v", id, test.name, got, want)
+			}
+		}
+		if got := GlobalClusteringCoefficient(g); !scalar.EqualWithinAbsOrRel(got, test.wantGlobal, 1e-12, 1e-12) {
+			t.Errorf("unexpected global coefficient for %q: got:%v want:%v", test.name, got, test.wantGlobal)
+		}
+	}
+}
+
+func TestClusteringCoefficientsRandom(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for trial := 0; trial < 20; trial++ {
+		g := simple.NewUndirectedGraph()
+		const n = 25
+		for i := 0; i < n; i++ {
+			g.AddNode(simple.Node(i))
+		}
+		for i := 0; i < 4*n; i++ {
+			u, v := rnd.IntN(n), rnd.IntN(n)
+			if u != v {
+				g.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+			}
+		}
+		local := ClusteringCoefficients(g)
+		var triangles, triples float64
+		for _, u := range graph.NodesOf(g.Nodes()) {
+			to := graph.NodesOf(g.From(u.ID()))
+			var links float64
+			for i, v := range to {
+				for _, w := range to[i+1:] {
+					if g.HasEdgeBetween(v.ID(), w.ID()) {
+						links++
+					}
+				}
+			}
+			k := float64(len(to))
+			var want float64
+			if k >= 2 {
+				want = 2 * links / (k * (k - 1))
+			}
+			if got := local[u.ID()]; !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+				t.Errorf("unexpected local coefficient of %d for graph %d: got:%v want:%v", u.ID(), trial, got, want)
+			}
+			triangles += links
+			triples += k * (k - 1) / 2
+		}
+		// Each triangle is counted at each of its nodes.
+		want := triangles / triples
+		if got := GlobalClusteringCoefficient(g); !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+			t.Errorf("unexpected global coefficient for graph %d: got:%v want:%v", trial, got, want)
+		}
+	}
+}
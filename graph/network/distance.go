@@ -8,6 +8,7 @@ import (
 	"math"
 
 	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/internal/linear"
 	"gonum.org/v1/gonum/graph/path"
 )
 
@@ -100,6 +101,94 @@ func Harmonic(g graph.Graph, p path.AllShortest) map[int64]float64 {
 	return h
 }
 
+// HarmonicCentrality returns the harmonic centrality for nodes in the
+// unweighted graph g.
+//
+//	H(v)= \sum_{u ≠ v} 1 / d(u,v)
+//
+// where d(u,v) is the number of edges in a shortest path from u to v. For
+// directed graphs the incoming paths are used. Unreachable nodes contribute
+// 1/∞ = 0 to the sum, so the harmonic centrality is well defined for
+// disconnected graphs.
+//
+// Unlike Harmonic, HarmonicCentrality does not require the shortest paths of
+// g to be computed in advance. Distances are found by a breadth-first search
+// from each node, so the memory required is O(|V|).
+//
+// The time complexity of HarmonicCentrality is O(|V|.|E|).
+func HarmonicCentrality(g graph.Graph) map[int64]float64 {
+	nodes := graph.NodesOf(g.Nodes())
+	h := make(map[int64]float64, len(nodes))
+	for _, u := range nodes {
+		h[u.ID()] = 0
+	}
+	var (
+		d     = make(map[int64]int, len(nodes))
+		queue linear.NodeQueue
+	)
+	for _, s := range nodes {
+		for _, n := range nodes {
+			d[n.ID()] = -1
+		}
+		d[s.ID()] = 0
+		queue.Enqueue(s)
+		for queue.Len() != 0 {
+			u := queue.Dequeue()
+			uid := u.ID()
+			to := g.From(uid)
+			for to.Next() {
+				vid := to.Node().ID()
+				if d[vid] >= 0 {
+					continue
+				}
+				d[vid] = d[uid] + 1
+				h[vid] += 1 / float64(d[vid])
+				queue.Enqueue(to.Node())
+			}
+		}
+	}
+	return h
+}
+
+// HarmonicCentralityWeighted returns the harmonic centrality for nodes in the
+// weighted graph g.
+//
+//	H(v)= \sum_{u ≠ v} 1 / d(u,v)
+//
+// where d(u,v) is the weight of a shortest path from u to v. For directed
+// graphs the incoming paths are used. Unreachable nodes contribute 1/∞ = 0
+// to the sum, so the harmonic centrality is well defined for disconnected
+// graphs. If the graph does not implement graph.Weighted, path.UniformCost is
+// used, giving the same result as HarmonicCentrality.
+//
+// Unlike Harmonic, HarmonicCentralityWeighted does not require the shortest
+// paths of g to be computed in advance. Distances are found by a Dijkstra
+// search from each node, so the memory required is O(|V|).
+// HarmonicCentralityWeighted will panic if g has a negative edge weight.
+//
+// The time complexity of HarmonicCentralityWeighted is O(|V|.|E|+|V|^2.log|V|).
+func HarmonicCentralityWeighted(g graph.Graph) map[int64]float64 {
+	nodes := graph.NodesOf(g.Nodes())
+	h := make(map[int64]float64, len(nodes))
+	for _, u := range nodes {
+		h[u.ID()] = 0
+	}
+	for _, s := range nodes {
+		sid := s.ID()
+		p := path.DijkstraFrom(s, g)
+		for _, v := range nodes {
+			vid := v.ID()
+			if vid == sid {
+				continue
+			}
+			if d := p.WeightTo(vid); !math.IsInf(d, 1) {
+				h[vid] += 1 / d
+			}
+		}
+	}
+	return h
+}
+
 // Residual returns the Dangalchev's residual closeness for nodes in the graph
 // g used to construct the given shortest paths.
 //
@@ -130,3 +219,101 @@ func Residual(g graph.Graph, p path.AllShortest) map[int64]float64 {
 	}
 	return r
 }
+
+// Eccentricity returns the eccentricity of each node in the graph g.
+//
+//	ε(u) = max_v d(u,v)
+//
+// where d(u,v) is the weight of a shortest path from u to v. For directed
+// graphs the outgoing paths are used. If any node is not reachable from u,
+// the eccentricity of u is +Inf, so every node of a disconnected graph has
+// infinite eccentricity; ReachableEccentricity may be used to consider only
+// reachable nodes. If the graph does not implement graph.Weighted,
+// path.UniformCost is used, giving the number of edges in shortest paths.
+// Eccentricity will panic if g has a negative edge weight.
+//
+// The time complexity of Eccentricity is O(|V|.|E|+|V|^2.log|V|).
+func Eccentricity(g graph.Graph) map[int64]float64 {
+	return eccentricity(g, false)
+}
+
+// ReachableEccentricity returns the eccentricity of each node in the graph g
+// as Eccentricity does, but considering only the nodes reachable from each
+// node, so the returned values are finite and, for an undirected graph, the
+// eccentricity of each node is its eccentricity within its connected
+// component.
+func ReachableEccentricity(g graph.Graph) map[int64]float64 {
+	return eccentricity(g, true)
+}
+
+func eccentricity(g graph.Graph, reachable bool) map[int64]float64 {
+	nodes := graph.NodesOf(g.Nodes())
+	e := make(map[int64]float64, len(nodes))
+	for _, u := range nodes {
+		p := path.DijkstraFrom(u, g)
+		var max float64
+		for _, v := range nodes {
+			d := p.WeightTo(v.ID())
+			if reachable && math.IsInf(d, 1) {
+				continue
+			}
+			max = math.Max(max, d)
+		}
+		e[u.ID()] = max
+	}
+	return e
+}
+
+// Diameter returns the diameter of the graph g, the greatest eccentricity of
+// the nodes in g as defined by Eccentricity. The diameter of a disconnected
+// graph, or of a directed graph that is not strongly connected, is +Inf. The
+// greatest value returned by ReachableEccentricity may be used to find the
+// largest diameter of the connected components of an undirected graph. If g
+// has no nodes, Diameter returns zero. Diameter will panic if g has a negative
+// edge weight.
+func Diameter(g graph.Graph) float64 {
+	var max float64
+	for _, e := range eccentricity(g, false) {
+		max = math.Max(max, e)
+	}
+	return max
+}
+
+// DiameterApprox returns an approximation of the diameter of the component
+// of the graph g containing the node start using the double sweep heuristic.
+// A shortest path search from start finds the reachable node furthest from
+// start, and the greatest distance to a node reachable from that node is
+// returned. For undirected graphs the returned value is a lower bound on the
+// diameter of the connected component containing start, and is often exact.
+// The time complexity of DiameterApprox is that of two shortest path searches.
+//
+// If start is not in g, DiameterApprox returns zero. If the graph does not
+// implement graph.Weighted, path.UniformCost is used. DiameterApprox will
+// panic if g has a negative edge weight.
+func DiameterApprox(g graph.Graph, start graph.Node) float64 {
+	if g.Node(start.ID()) == nil {
+		return 0
+	}
+	nodes := graph.NodesOf(g.Nodes())
+	far, _ := furthest(path.DijkstraFrom(start, g), nodes)
+	_, d := furthest(path.DijkstraFrom(far, g), nodes)
+	return d
+}
+
+// furthest returns the node furthest from the root of p among the nodes
+// reachable from it, and its distance. Ties are broken by lowest node ID.
+func furthest(p path.Shortest, nodes []graph.Node) (graph.Node, float64) {
+	n := p.From()
+	var max float64
+	for _, v := range nodes {
+		d := p.WeightTo(v.ID())
+		if math.IsInf(d, 1) {
+			continue
+		}
+		if d > max || (d == max && v.ID() < n.ID()) {
+			n = v
+			max = d
+		}
+	}
+	return n, max
+}
@@ -6,9 +6,12 @@ package network
 
 import (
 	"math"
+	"math/rand/v2"
+	"reflect"
 	"testing"
 
 	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/graph"
 	"gonum.org/v1/gonum/graph/path"
 	"gonum.org/v1/gonum/graph/simple"
 )
@@ -192,6 +195,23 @@ func TestDistanceCentralityUndirected(t *testing.T) {
 			}
 		}
 
+		for _, fn := range []struct {
+			name string
+			fn   func(graph.Graph) map[int64]float64
+		}{
+			{name: "HarmonicCentrality", fn: HarmonicCentrality},
+			{name: "HarmonicCentralityWeighted", fn: HarmonicCentralityWeighted},
+		} {
+			got = fn.fn(g)
+			for n := range test.g {
+				if !scalar.EqualWithinAbsOrRel(got[int64(n)], test.harmonic[int64(n)], tol, tol) {
+					t.Errorf("unexpected %s for test %d:\ngot: %v\nwant:%v",
+						fn.name, i, orderedFloats(got, prec), orderedFloats(test.harmonic, prec))
+					break
+				}
+			}
+		}
+
 		got = Residual(g, p)
 		for n := range test.g {
 			if !scalar.EqualWithinAbsOrRel(got[int64(n)], test.residual[int64(n)], tol, tol) {
@@ -382,6 +402,23 @@ func TestDistanceCentralityDirected(t *testing.T) {
 			}
 		}
 
+		for _, fn := range []struct {
+			name string
+			fn   func(graph.Graph) map[int64]float64
+		}{
+			{name: "HarmonicCentrality", fn: HarmonicCentrality},
+			{name: "HarmonicCentralityWeighted", fn: HarmonicCentralityWeighted},
+		} {
+			got = fn.fn(g)
+			for n := range test.g {
+				if !scalar.EqualWithinAbsOrRel(got[int64(n)], test.harmonic[int64(n)], tol, tol) {
+					t.Errorf("unexpected %s for test %d:\ngot: %v\nwant:%v",
+						fn.name, i, orderedFloats(got, prec), orderedFloats(test.harmonic, prec))
+					break
+				}
+			}
+		}
+
 		got = Residual(g, p)
 		for n := range test.g {
 			if !scalar.EqualWithinAbsOrRel(got[int64(n)], test.residual[int64(n)], tol, tol) {
@@ -392,3 +429,163 @@ func TestDistanceCentralityDirected(t *testing.T) {
 		}
 	}
 }
+
+func TestHarmonicCentralityWeighted(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for n := 0; n < 10; n++ {
+		const size = 40
+		var g interface {
+			graph.Weighted
+			graph.WeightedBuilder
+		}
+		if n%2 == 0 {
+			g = simple.NewWeightedDirectedGraph(0, math.Inf(1))
+		} else {
+			g = simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+		}
+		for i := 0; i < size; i++ {
+			g.AddNode(simple.Node(i))
+		}
+		for i := 0; i < size; i++ {
+			for j := 0; j < size; j++ {
+				// Sparse graphs are likely to be disconnected.
+				if i != j && rnd.Float64() < 0.03 {
+					g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(i), T: simple.Node(j), W: 0.5 + rnd.Float64()})
+				}
+			}
+		}
+		p, ok := path.FloydWarshall(g)
+		if !ok {
+			t.Fatalf("unexpected negative cycle in graph %d", n)
+		}
+		want := Harmonic(g, p)
+		got := HarmonicCentralityWeighted(g)
+		for _, u := range graph.NodesOf(g.Nodes()) {
+			id := u.ID()
+			if !scalar.EqualWithinAbsOrRel(got[id], want[id], tol, tol) {
+				t.Errorf("unexpected harmonic centrality for node %d in graph %d: got:%v want:%v", id, n, got[id], want[id])
+			}
+		}
+	}
+}
+
+var eccentricityTests = []struct {
+	name string
+	g    []set
+
+	eccentricity map[int64]float64
+	reachable    map[int64]float64
+	diameter     float64
+}{
+	{
+		name: "path",
+		g: []set{
+			A: linksTo(B),
+			B: linksTo(C),
+			C: linksTo(D),
+			D: nil,
+		},
+		eccentricity: map[int64]float64{A: 3, B: 2, C: 2, D: 3},
+		reachable:    map[int64]float64{A: 3, B: 2, C: 2, D: 3},
+		diameter:     3,
+	},
+	{
+		name: "star",
+		g: []set{
+			A: linksTo(B, C, D, E),
+			B: nil,
+			C: nil,
+			D: nil,
+			E: nil,
+		},
+		eccentricity: map[int64]float64{A: 1, B: 2, C: 2, D: 2, E: 2},
+		reachable:    map[int64]float64{A: 1, B: 2, C: 2, D: 2, E: 2},
+		diameter:     2,
+	},
+	{
+		name: "disconnected",
+		g: []set{
+			A: linksTo(B),
+			B: linksTo(C),
+			C: nil,
+			D: linksTo(E),
+			E: nil,
+		},
+		eccentricity: map[int64]float64{A: math.Inf(1), B: math.Inf(1), C: math.Inf(1), D: math.Inf(1), E: math.Inf(1)},
+		reachable:    map[int64]float64{A: 2, B: 1, C: 2, D: 1, E: 1},
+		diameter:     math.Inf(1),
+	},
+	{
+		name: "single",
+		g: []set{
+			A: nil,
+		},
+		eccentricity: map[int64]float64{A: 0},
+		reachable:    map[int64]float64{A: 0},
+		diameter:     0,
+	},
+}
+
+func TestEccentricity(t *testing.T) {
+	for _, test := range eccentricityTests {
+		g := simple.NewUndirectedGraph()
+		for u, e := range test.g {
+			// Add nodes that are not defined by an edge.
+			if g.Node(int64(u)) == nil {
+				g.AddNode(simple.Node(u))
+			}
+			for v := range e {
+				g.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+			}
+		}
+		if got := Eccentricity(g); !reflect.DeepEqual(got, test.eccentricity) {
+			t.Errorf("unexpected eccentricity for %q: got:%v want:%v", test.name, got, test.eccentricity)
+		}
+		if got := ReachableEccentricity(g); !reflect.DeepEqual(got, test.reachable) {
+			t.Errorf("unexpected reachable eccentricity for %q: got:%v want:%v", test.name, got, test.reachable)
+		}
+		if got := Diameter(g); got != test.diameter {
+			t.Errorf("unexpected diameter for %q: got:%v want:%v", test.name, got, test.diameter)
+		}
+	}
+}
+
+func TestDiameterApprox(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for n := 0; n < 10; n++ {
+		const size = 40
+		g := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+		for i := 0; i < size; i++ {
+			g.AddNode(simple.Node(i))
+		}
+		// Build a random tree, for which the
+		// double sweep is exact.
+		for i := 1; i < size; i++ {
+			g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(i), T: simple.Node(rnd.IntN(i)), W: 0.5 + rnd.Float64()})
+		}
+		want := Diameter(g)
+		for _, start := range []int64{0, size / 2, size - 1} {
+			got := DiameterApprox(g, simple.Node(start))
+			if math.Abs(got-want) > 1e-12 {
+				t.Errorf("unexpected approximate diameter of tree %d from %d: got:%v want:%v", n, start, got, want)
+			}
+		}
+
+		// Adding edges may make the approximation inexact,
+		// but it remains a lower bound.
+		for i := 0; i < size; i++ {
+			u, v := rnd.IntN(size), rnd.IntN(size)
+			if u != v {
+				g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(u), T: simple.Node(v), W: 0.5 + rnd.Float64()})
+			}
+		}
+		want = Diameter(g)
+		if got := DiameterApprox(g, simple.Node(0)); got > want {
+			t.Errorf("approximate diameter of graph %d exceeds diameter: got:%v want<=%v", n, got, want)
+		}
+	}
+	if got := DiameterApprox(simple.NewUndirectedGraph(), simple.Node(0)); got != 0 {
+		t.Errorf("unexpected approximate diameter of empty graph: got:%v", got)
+	}
+}
@@ -18,6 +18,11 @@ import (
 // vector difference between iterations is below tol. The returned map is
 // keyed on the graph node IDs.
 // If g is a graph.WeightedDirected, an edge-weighted PageRank is calculated.
+// In an edge-weighted PageRank the share of a node's rank passed along each of
+// its out-edges is the weight of the edge divided by the total weight of the
+// node's out-edges. Nodes with a total out-edge weight of zero are treated as
+// dangling nodes and their rank is redistributed uniformly over all nodes, as
+// for nodes without out-edges in the unweighted PageRank.
 func PageRank(g graph.Directed, damp, tol float64) map[int64]float64 {
 	if g, ok := g.(graph.WeightedDirected); ok {
 		return edgeWeightedPageRank(g, damp, tol)
@@ -30,6 +35,11 @@ func PageRank(g graph.Directed, damp, tol float64) map[int64]float64 {
 // vector difference between iterations is below tol. The returned map is
 // keyed on the graph node IDs.
 // If g is a graph.WeightedDirected, an edge-weighted PageRank is calculated.
+// In an edge-weighted PageRank the share of a node's rank passed along each of
+// its out-edges is the weight of the edge divided by the total weight of the
+// node's out-edges. Nodes with a total out-edge weight of zero are treated as
+// dangling nodes and their rank is redistributed uniformly over all nodes, as
+// for nodes without out-edges in the unweighted PageRank.
 func PageRankSparse(g graph.Directed, damp, tol float64) map[int64]float64 {
 	if g, ok := g.(graph.WeightedDirected); ok {
 		return edgeWeightedPageRankSparse(g, damp, tol)
@@ -37,6 +47,119 @@ func PageRankSparse(g graph.Directed, damp, tol float64) map[int64]float64 {
 	return pageRankSparse(g, damp, tol)
 }
 
+// PageRankPersonalized returns the personalized PageRank weights for nodes of
+// the directed graph g using the given damping factor and terminating when the
+// 2-norm of the vector difference between iterations is below tol. The returned
+// map is keyed on the graph node IDs.
+//
+// Instead of teleporting uniformly to all nodes of g, the random surfer of
+// personalized PageRank restarts according to the restart distribution, which
+// maps node IDs to restart probabilities. Dangling nodes, nodes without
+// out-edges, also redistribute their weight according to the restart
+// distribution. Nodes that are not in restart have a restart probability of
+// zero. PageRankPersonalized will panic if restart holds a node that is not
+// in g, holds a negative probability, or does not sum to 1 within 1e-8.
+//
+// If g is a graph.WeightedDirected, an edge-weighted PageRank is calculated.
+func PageRankPersonalized(g graph.Directed, damp, tol float64, restart map[int64]float64) map[int64]float64 {
+	// PageRankPersonalized is implemented according to "How Google Finds
+	// Your Needle in the Web's Haystack" with the uniform teleportation
+	// vector 1/n.1 replaced by the restart distribution r.
+	//
+	// G.I^k = alpha.H.I^k + alpha.r.A.I^k + (1-alpha).r.1.I^k
+	//
+	// http://www.ams.org/samplings/feature-column/fcarc-pagerank
+
+	nodes := graph.NodesOf(g.Nodes())
+	if len(nodes) == 0 {
+		return make(map[int64]float64)
+	}
+	indexOf := make(map[int64]int, len(nodes))
+	for i, n := range nodes {
+		indexOf[n.ID()] = i
+	}
+
+	r := make([]float64, len(nodes))
+	var total float64
+	for id, p := range restart {
+		i, ok := indexOf[id]
+		if !ok {
+			panic("network: restart node not in graph")
+		}
+		if p < 0 {
+			panic("network: negative restart probability")
+		}
+		r[i] = p
+		total += p
+	}
+	if math.Abs(total-1) > 1e-8 {
+		panic("network: restart distribution does not sum to 1")
+	}
+
+	wg, isWeighted := g.(graph.WeightedDirected)
+	m := make(rowCompressedMatrix, len(nodes))
+	var dangling compressedRow
+	for j, u := range nodes {
+		to := graph.NodesOf(g.From(u.ID()))
+		if !isWeighted {
+			f := damp / float64(len(to))
+			for _, v := range to {
+				m.addTo(indexOf[v.ID()], j, f)
+			}
+			if len(to) == 0 {
+				dangling.addTo(j, damp)
+			}
+			continue
+		}
+		var z float64
+		for _, v := range to {
+			if w, ok := wg.Weight(u.ID(), v.ID()); ok {
+				z += w
+			}
+		}
+		if z != 0 {
+			for _, v := range to {
+				if w, ok := wg.Weight(u.ID(), v.ID()); ok {
+					m.addTo(indexOf[v.ID()], j, (w*damp)/z)
+				}
+			}
+		} else {
+			dangling.addTo(j, damp)
+		}
+	}
+
+	last := make([]float64, len(nodes))
+	for i := range last {
+		last[i] = 1
+	}
+	lastV := mat.NewVecDense(len(nodes), last)
+
+	// Start from the restart distribution.
+	vec := make([]float64, len(nodes))
+	copy(vec, r)
+	v := mat.NewVecDense(len(nodes), vec)
+
+	for {
+		lastV, v = v, lastV
+
+		m.mulVecUnitary(v, lastV)             // First term of the G matrix equation;
+		with := dangling.dotUnitary(lastV)    // Second term;
+		away := onesDotUnitary(1-damp, lastV) // Last term.
+
+		floats.AddScaled(v.RawVector().Data, with+away, r)
+		if normDiff(vec, last) < tol {
+			break
+		}
+	}
+
+	ranks := make(map[int64]float64, len(nodes))
+	for i, r := range v.RawVector().Data {
+		ranks[nodes[i].ID()] = r
+	}
+
+	return ranks
+}
+
 // edgeWeightedPageRank returns the PageRank weights for nodes of the weighted directed graph g
 // using the given damping factor and terminating when the 2-norm of the
 // vector difference between iterations is below tol. The returned map is
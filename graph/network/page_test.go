@@ -12,6 +12,7 @@ import (
 	"testing"
 
 	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/graph"
 	"gonum.org/v1/gonum/graph/simple"
 )
 
@@ -263,3 +264,123 @@ type keyFloatVal struct {
 }
 
 func (kv keyFloatVal) String() string { return fmt.Sprintf("%c:%.*f", kv.key+'A', kv.prec, kv.val) }
+
+func TestPageRankPersonalizedUniform(t *testing.T) {
+	for i, test := range pageRankTests {
+		g := simple.NewDirectedGraph()
+		for u, e := range test.g {
+			// Add nodes that are not defined by an edge.
+			if g.Node(int64(u)) == nil {
+				g.AddNode(simple.Node(u))
+			}
+			for v := range e {
+				g.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+			}
+		}
+		// A uniform restart distribution gives PageRank.
+		restart := make(map[int64]float64)
+		for u := range test.g {
+			restart[int64(u)] = 1 / float64(len(test.g))
+		}
+		got := PageRankPersonalized(g, test.damp, test.tol, restart)
+		prec := 1 - int(math.Log10(test.wantTol))
+		for n := range test.g {
+			if !scalar.EqualWithinAbsOrRel(got[int64(n)], test.want[int64(n)], test.wantTol, test.wantTol) {
+				t.Errorf("unexpected PageRank result for test %d:\ngot: %v\nwant:%v",
+					i, orderedFloats(got, prec), orderedFloats(test.want, prec))
+				break
+			}
+		}
+	}
+	for i, test := range edgeWeightedPageRankTests {
+		g := simple.NewWeightedDirectedGraph(test.self, test.absent)
+		for u, e := range test.g {
+			// Add nodes that are not defined by an edge.
+			if g.Node(int64(u)) == nil {
+				g.AddNode(simple.Node(u))
+			}
+			ws := test.edges[u]
+			for v := range e {
+				if w, ok := ws[v]; ok {
+					g.SetWeightedEdge(g.NewWeightedEdge(simple.Node(u), simple.Node(v), w))
+				}
+			}
+		}
+		restart := make(map[int64]float64)
+		for u := range test.g {
+			restart[int64(u)] = 1 / float64(len(test.g))
+		}
+		got := PageRankPersonalized(g, test.damp, test.tol, restart)
+		prec := 1 - int(math.Log10(test.wantTol))
+		for n := range test.g {
+			if !scalar.EqualWithinAbsOrRel(got[int64(n)], test.want[int64(n)], test.wantTol, test.wantTol) {
+				t.Errorf("unexpected edge-weighted PageRank result for test %d:\ngot: %v\nwant:%v",
+					i, orderedFloats(got, prec), orderedFloats(test.want, prec))
+				break
+			}
+		}
+	}
+}
+
+func TestPageRankPersonalized(t *testing.T) {
+	const (
+		damp = 0.85
+		tol  = 1e-12
+	)
+	g := simple.NewDirectedGraph()
+	for _, e := range []simple.Edge{
+		{F: simple.Node(A), T: simple.Node(B)},
+		{F: simple.Node(B), T: simple.Node(C)},
+		{F: simple.Node(C), T: simple.Node(A)},
+		{F: simple.Node(C), T: simple.Node(D)},
+		{F: simple.Node(E), T: simple.Node(D)},
+		// D is dangling.
+	} {
+		g.SetEdge(e)
+	}
+	restart := map[int64]float64{A: 0.75, E: 0.25}
+	got := PageRankPersonalized(g, damp, tol, restart)
+
+	// Check that the ranks are the stationary distribution
+	// of the personalized random surfer.
+	var sum, dangling float64
+	for _, n := range graph.NodesOf(g.Nodes()) {
+		sum += got[n.ID()]
+		if g.From(n.ID()).Len() == 0 {
+			dangling += got[n.ID()]
+		}
+	}
+	if !scalar.EqualWithinAbsOrRel(sum, 1, 1e-10, 1e-10) {
+		t.Errorf("unexpected sum of ranks: got:%v want:1", sum)
+	}
+	for _, v := range graph.NodesOf(g.Nodes()) {
+		want := (1-damp)*restart[v.ID()] + damp*dangling*restart[v.ID()]
+		for _, u := range graph.NodesOf(g.To(v.ID())) {
+			want += damp * got[u.ID()] / float64(g.From(u.ID()).Len())
+		}
+		if !scalar.EqualWithinAbsOrRel(got[v.ID()], want, 1e-10, 1e-10) {
+			t.Errorf("unexpected rank for node %c: got:%v want:%v", v.ID()+'A', got[v.ID()], want)
+		}
+	}
+	if got[B] == 0 || got[E] == 0 {
+		t.Errorf("expected non-zero rank for reachable nodes: %v", orderedFloats(got, 4))
+	}
+
+	for _, test := range []struct {
+		name    string
+		restart map[int64]float64
+	}{
+		{name: "missing node", restart: map[int64]float64{A: 0.5, 10: 0.5}},
+		{name: "negative", restart: map[int64]float64{A: 1.5, B: -0.5}},
+		{name: "not normalized", restart: map[int64]float64{A: 0.5}},
+	} {
+		panicked := func() (panicked bool) {
+			defer func() { panicked = recover() != nil }()
+			PageRankPersonalized(g, damp, tol, test.restart)
+			return false
+		}()
+		if !panicked {
+			t.Errorf("expected panic for %s restart distribution", test.name)
+		}
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package network
+
+import (
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+// CountTriangles returns the number of triangles in the undirected graph g.
+// Self edges are ignored. The triangles are counted using the forward
+// algorithm, directing each edge towards the end with more neighbours so that
+// no neighbour sets are materialized beyond the adjacency of each node.
+//
+// The time complexity of CountTriangles is O(|E|^1.5).
+func CountTriangles(g graph.Undirected) int64 {
+	return countTriangles(g, false).total
+}
+
+// TrianglesContaining returns the number of triangles in the undirected graph g
+// that contain the node n, the number of edges joining pairs of neighbours of
+// n. If n is not in g, TrianglesContaining returns zero. Self edges are ignored.
+//
+// The time complexity of TrianglesContaining is O(Σ k_v) over the neighbours
+// v of n, where k_v is the number of neighbours of v.
+func TrianglesContaining(g graph.Undirected, n graph.Node) int64 {
+	nid := n.ID()
+	if g.Node(nid) == nil {
+		return 0
+	}
+	neighbours := make(map[int64]bool)
+	to := g.From(nid)
+	for to.Next() {
+		if vid := to.Node().ID(); vid != nid {
+			neighbours[vid] = true
+		}
+	}
+	var count int64
+	for vid := range neighbours {
+		to := g.From(vid)
+		for to.Next() {
+			// Count each edge between neighbours
+			// once, from its lower ID end.
+			if wid := to.Node().ID(); wid > vid && neighbours[wid] {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+// triangleCounts holds the triangle counts of a graph.
+type triangleCounts struct {
+	// nodes holds the nodes of the graph sorted by ID
+	// and deg holds the number of neighbours of each,
+	// excluding self.
+	nodes []graph.Node
+	deg   []int
+
+	// total is the number of triangles in the graph
+	// and perNode, if counted, holds the number of
+	// triangles containing each node.
+	total   int64
+	perNode []int64
+}
+
+// countTriangles returns the triangle counts of g, including the counts for each
+// node if perNode is true, using the forward algorithm of Schank and Wagner.
+// Each edge is directed from the end with lower degree to the end with higher
+// degree, breaking ties by ID, and each triangle is found exactly once from
+// its lowest ranked node by intersecting the neighbours of the two other nodes
+// in the direction of increasing rank. Since every node has O(√|E|) neighbours
+// of higher rank, the time complexity is O(|E|^1.5).
+func countTriangles(g graph.Undirected, perNode bool) triangleCounts {
+	nodes := graph.NodesOf(g.Nodes())
+	order.ByID(nodes)
+	indexOf := make(map[int64]int, len(nodes))
+	for i, u := range nodes {
+		indexOf[u.ID()] = i
+	}
+	adj := make([][]int, len(nodes))
+	deg := make([]int, len(nodes))
+	for i, u := range nodes {
+		uid := u.ID()
+		to := g.From(uid)
+		for to.Next() {
+			if vid := to.Node().ID(); vid != uid {
+				adj[i] = append(adj[i], indexOf[vid])
+			}
+		}
+		deg[i] = len(adj[i])
+	}
+	before := func(i, j int) bool {
+		return deg[i] < deg[j] || (deg[i] == deg[j] && i < j)
+	}
+	for i, a := range adj {
+		fwd := a[:0]
+		for _, j := range a {
+			if before(i, j) {
+				fwd = append(fwd, j)
+			}
+		}
+		adj[i] = fwd
+	}
+
+	t := triangleCounts{nodes: nodes, deg: deg}
+	if perNode {
+		t.perNode = make([]int64, len(nodes))
+	}
+	mark := make([]int, len(nodes))
+	for i := range mark {
+		mark[i] = -1
+	}
+	for u, fwd := range adj {
+		for _, v := range fwd {
+			mark[v] = u
+		}
+		for _, v := range fwd {
+			for _, w := range adj[v] {
+				if mark[w] != u {
+					continue
+				}
+				t.total++
+				if perNode {
+					t.perNode[u]++
+					t.perNode[v]++
+					t.perNode[w]++
+				}
+			}
+		}
+	}
+	return t
+}
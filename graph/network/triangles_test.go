@@ -0,0 +1,125 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package network
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+var countTrianglesTests = []struct {
+	name  string
+	edges [][2]int64
+	want  int64
+	per   map[int64]int64
+}{
+	{
+		name: "empty",
+		want: 0,
+		per:  map[int64]int64{},
+	},
+	{
+		name:  "K4",
+		edges: [][2]int64{{0, 1}, {0, 2}, {0, 3}, {1, 2}, {1, 3}, {2, 3}},
+		want:  4,
+		per:   map[int64]int64{0: 3, 1: 3, 2: 3, 3: 3},
+	},
+	{
+		name:  "diamond",
+		edges: [][2]int64{{0, 1}, {0, 2}, {1, 2}, {0, 3}, {1, 3}},
+		want:  2,
+		per:   map[int64]int64{0: 2, 1: 2, 2: 1, 3: 1},
+	},
+	{
+		name:  "cycle",
+		edges: [][2]int64{{0, 1}, {1, 2}, {2, 3}, {3, 0}},
+		want:  0,
+		per:   map[int64]int64{0: 0, 1: 0, 2: 0, 3: 0},
+	},
+	{
+		// A hub joined to every node of a path.
+		name:  "fan",
+		edges: [][2]int64{{0, 1}, {0, 2}, {0, 3}, {0, 4}, {1, 2}, {2, 3}, {3, 4}},
+		want:  3,
+		per:   map[int64]int64{0: 3, 1: 1, 2: 2, 3: 2, 4: 1},
+	},
+}
+
+func TestCountTriangles(t *testing.T) {
+	t.Parallel()
+	for _, test := range countTrianglesTests {
+		g := simple.NewUndirectedGraph()
+		for _, e := range test.edges {
+			g.SetEdge(simple.Edge{F: simple.Node(e[0]), T: simple.Node(e[1])})
+		}
+		if got := CountTriangles(g); got != test.want {
+			t.Errorf("unexpected triangle count for %q: got:%d want:%d", test.name, got, test.want)
+		}
+		for id, want := range test.per {
+			if got := TrianglesContaining(g, simple.Node(id)); got != want {
+				t.Errorf("unexpected triangles containing %d for %q: got:%d want:%d", id, test.name, got, want)
+			}
+		}
+		if got := TrianglesContaining(g, simple.Node(-1)); got != 0 {
+			t.Errorf("unexpected triangles containing missing node for %q: got:%d", test.name, got)
+		}
+	}
+}
+
+func TestCountTrianglesRandom(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for trial := 0; trial < 20; trial++ {
+		g := simple.NewUndirectedGraph()
+		const n = 30
+		for i := 0; i < 5*n; i++ {
+			u, v := rnd.IntN(n), rnd.IntN(n)
+			if u != v {
+				g.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+			}
+		}
+		nodes := graph.NodesOf(g.Nodes())
+		var want, sum int64
+		for i, u := range nodes {
+			for j := i + 1; j < len(nodes); j++ {
+				v := nodes[j]
+				if !g.HasEdgeBetween(u.ID(), v.ID()) {
+					continue
+				}
+				for _, w := range nodes[j+1:] {
+					if g.HasEdgeBetween(u.ID(), w.ID()) && g.HasEdgeBetween(v.ID(), w.ID()) {
+						want++
+					}
+				}
+			}
+			sum += TrianglesContaining(g, u)
+		}
+		if got := CountTriangles(g); got != want {
+			t.Errorf("unexpected triangle count for graph %d: got:%d want:%d", trial, got, want)
+		}
+		if sum != 3*want {
+			t.Errorf("unexpected sum of per-node triangle counts for graph %d: got:%d want:%d", trial, sum, 3*want)
+		}
+	}
+}
+
+func BenchmarkCountTriangles(b *testing.B) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	g := simple.NewUndirectedGraph()
+	const n = 2000
+	for i := 0; i < 20*n; i++ {
+		u, v := rnd.IntN(n), rnd.IntN(n)
+		if u != v {
+			g.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+		}
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		CountTriangles(g)
+	}
+}
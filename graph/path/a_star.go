@@ -6,6 +6,7 @@ package path
 
 import (
 	"container/heap"
+	"math"
 
 	"gonum.org/v1/gonum/graph"
 	"gonum.org/v1/gonum/graph/internal/set"
@@ -30,35 +31,152 @@ func AStar(s, t graph.Node, g traverse.Graph, h Heuristic) (path Shortest, expan
 			return Shortest{from: s}, 0
 		}
 	}
+	var stats SearchStats
+	h = aStarHeuristic(g, h)
+	path, _ = aStar(s, []graph.Node{t}, g, func(n graph.Node) float64 { return h(n, t) }, &stats)
+	return path, stats.Expanded
+}
+
+// SearchStats holds counts of the work performed by a graph search.
+type SearchStats struct {
+	// Expanded is the number of nodes
+	// removed from the search queue.
+	Expanded int
+	// Relaxed is the number of edges
+	// to unsettled nodes that were
+	// relaxed, whether or not the
+	// relaxation improved the path
+	// to the node.
+	Relaxed int
+	// QueuePeak is the largest number
+	// of nodes held in the search queue.
+	QueuePeak int
+}
+
+// AStarStats finds the A*-shortest path from s to t in g using the heuristic h
+// as AStar does, returning the path and its weight along with statistics of the
+// work performed by the search. Collecting the statistics does not change the
+// behavior of the search. If t is not reachable from s, the returned path is nil
+// and the weight is +Inf.
+//
+// AStarStats can be used to compare the effectiveness of heuristics, since a
+// better admissible heuristic will expand fewer nodes.
+func AStarStats(s, t graph.Node, g traverse.Graph, h Heuristic) (path []graph.Node, weight float64, stats SearchStats) {
+	if g, ok := g.(graph.Graph); ok {
+		if g.Node(s.ID()) == nil || g.Node(t.ID()) == nil {
+			return nil, math.Inf(1), stats
+		}
+	}
+	h = aStarHeuristic(g, h)
+	pt, _ := aStar(s, []graph.Node{t}, g, func(n graph.Node) float64 { return h(n, t) }, &stats)
+	path, weight = pt.To(t.ID())
+	return path, weight, stats
+}
+
+// AStarMultiGoal finds the A*-shortest path from s to the nearest of the goal
+// nodes in g using the heuristic h, returning the path, its weight and the goal
+// that was reached. The search terminates as soon as the first goal is reached.
+// If no goal is reachable from s, the returned path and goal are nil and the
+// weight is +Inf.
+//
+// The path will be the shortest path to any of the goals if the heuristic is
+// admissible with respect to the nearest goal, that is, if for any node, n, h(n,
+// goals) is no greater than the least cost of a path from n to any of the goals.
+// The minimum of an admissible single goal heuristic over the goals satisfies
+// this requirement.
+//
+// If h is nil, AStarMultiGoal will use the minimum of the g.HeuristicCost method
+// over the goals if g implements HeuristicCoster, falling back to NullHeuristic
+// otherwise. If the graph does not implement Weighted, UniformCost is used.
+// AStarMultiGoal will panic if g has an A*-reachable negative edge weight.
+func AStarMultiGoal(s graph.Node, goals []graph.Node, g traverse.Graph, h func(n graph.Node, goals []graph.Node) float64) (path []graph.Node, weight float64, goal graph.Node) {
+	if g, ok := g.(graph.Graph); ok {
+		if g.Node(s.ID()) == nil {
+			return nil, math.Inf(1), nil
+		}
+		var present []graph.Node
+		for _, t := range goals {
+			if g.Node(t.ID()) != nil {
+				present = append(present, t)
+			}
+		}
+		goals = present
+	}
+	if len(goals) == 0 {
+		return nil, math.Inf(1), nil
+	}
+	if h == nil {
+		hc, ok := g.(HeuristicCoster)
+		if !ok {
+			h = func(_ graph.Node, _ []graph.Node) float64 { return 0 }
+		} else {
+			h = func(n graph.Node, goals []graph.Node) float64 {
+				best := math.Inf(1)
+				for _, t := range goals {
+					best = math.Min(best, hc.HeuristicCost(n, t))
+				}
+				return best
+			}
+		}
+	}
+
+	var stats SearchStats
+	pt, goal := aStar(s, goals, g, func(n graph.Node) float64 { return h(n, goals) }, &stats)
+	if goal == nil {
+		return nil, math.Inf(1), nil
+	}
+	path, weight = pt.To(goal.ID())
+	return path, weight, goal
+}
+
+// aStarHeuristic returns h, or the default heuristic for g if h is nil.
+func aStarHeuristic(g traverse.Graph, h Heuristic) Heuristic {
+	if h != nil {
+		return h
+	}
+	if g, ok := g.(HeuristicCoster); ok {
+		return g.HeuristicCost
+	}
+	return NullHeuristic
+}
+
+// aStar is the A* search implementation shared by AStar, AStarStats and
+// AStarMultiGoal. The search terminates when the first of the goals is
+// reached, returning the reached goal, with h estimating the cost of the path
+// from a node to the nearest goal. The work performed by the search is
+// recorded in stats.
+func aStar(s graph.Node, goals []graph.Node, g traverse.Graph, h func(graph.Node) float64, stats *SearchStats) (path Shortest, reached graph.Node) {
 	var weight Weighting
 	if wg, ok := g.(Weighted); ok {
 		weight = wg.Weight
 	} else {
 		weight = UniformCost(g)
 	}
-	if h == nil {
-		if g, ok := g.(HeuristicCoster); ok {
-			h = g.HeuristicCost
-		} else {
-			h = NullHeuristic
+
+	isGoal := make(set.Ints[int64], len(goals))
+	nodes := []graph.Node{s}
+	for _, t := range goals {
+		tid := t.ID()
+		if !isGoal.Has(tid) && tid != s.ID() {
+			nodes = append(nodes, t)
 		}
+		isGoal.Add(tid)
 	}
-
-	path = newShortestFrom(s, []graph.Node{s, t})
-	tid := t.ID()
+	path = newShortestFrom(s, nodes)
 
 	visited := make(set.Ints[int64])
 	open := &aStarQueue{indexOf: make(map[int64]int)}
-	heap.Push(open, aStarNode{node: s, gscore: 0, fscore: h(s, t)})
+	heap.Push(open, aStarNode{node: s, gscore: 0, fscore: h(s)})
+	stats.QueuePeak = 1
 
 	for open.Len() != 0 {
 		u := heap.Pop(open).(aStarNode)
 		uid := u.node.ID()
 		i := path.indexOf[uid]
-		expanded++
+		stats.Expanded++
 
-		if uid == tid {
-			break
+		if isGoal.Has(uid) {
+			return path, path.nodes[i]
 		}
 
 		visited.Add(uid)
@@ -81,18 +199,20 @@ func AStar(s, t graph.Node, g traverse.Graph, h Heuristic) (path Shortest, expan
 			if w < 0 {
 				panic("path: A* negative edge weight")
 			}
+			stats.Relaxed++
 			g := u.gscore + w
 			if n, ok := open.node(vid); !ok {
 				path.set(j, g, i)
-				heap.Push(open, aStarNode{node: v, gscore: g, fscore: g + h(v, t)})
+				heap.Push(open, aStarNode{node: v, gscore: g, fscore: g + h(v)})
+				stats.QueuePeak = max(stats.QueuePeak, open.Len())
 			} else if g < n.gscore {
 				path.set(j, g, i)
-				open.update(vid, g, g+h(v, t))
+				open.update(vid, g, g+h(v))
 			}
 		}
 	}
 
-	return path, expanded
+	return path, nil
 }
 
 // NullHeuristic is an admissible, consistent heuristic that will not speed up computation.
@@ -157,3 +277,8 @@ func (q *aStarQueue) node(id int64) (aStarNode, bool) {
 	}
 	return aStarNode{}, false
 }
+
+func (q *aStarQueue) has(id int64) bool {
+	_, ok := q.indexOf[id]
+	return ok
+}
@@ -312,3 +312,123 @@ func TestAStarNullHeuristic(t *testing.T) {
 		}
 	}
 }
+
+func TestAStarStats(t *testing.T) {
+	t.Parallel()
+	g := testgraphs.NewGrid(30, 30, true)
+	s, goal := g.NodeAt(0, 0), g.NodeAt(29, 29)
+	alt := NewLandmarkHeuristic(g, SelectLandmarks(g, 4))
+
+	var nullStats SearchStats
+	for _, test := range []struct {
+		name string
+		h    Heuristic
+	}{
+		{name: "null", h: NullHeuristic},
+		{name: "ALT", h: alt},
+	} {
+		p, weight, stats := AStarStats(s, goal, g, test.h)
+
+		pt, expanded := AStar(s, goal, g, test.h)
+		wantPath, wantWeight := pt.To(goal.ID())
+		if weight != wantWeight {
+			t.Errorf("%s: unexpected weight: got:%f want:%f", test.name, weight, wantWeight)
+		}
+		if !reflect.DeepEqual(p, wantPath) {
+			t.Errorf("%s: unexpected path:\ngot: %v\nwant:%v", test.name, p, wantPath)
+		}
+		if stats.Expanded != expanded {
+			t.Errorf("%s: unexpected number of expanded nodes: got:%d want:%d", test.name, stats.Expanded, expanded)
+		}
+		if stats.QueuePeak < 1 || stats.QueuePeak > stats.Relaxed+1 {
+			t.Errorf("%s: unexpected queue peak: got:%d with %d relaxations", test.name, stats.QueuePeak, stats.Relaxed)
+		}
+		if stats.Relaxed < stats.Expanded-1 {
+			t.Errorf("%s: unexpected number of relaxations: got:%d with %d expansions", test.name, stats.Relaxed, stats.Expanded)
+		}
+
+		switch test.name {
+		case "null":
+			nullStats = stats
+		case "ALT":
+			if stats.Expanded >= nullStats.Expanded {
+				t.Errorf("ALT heuristic did not reduce expansions: got:%d null:%d", stats.Expanded, nullStats.Expanded)
+			}
+		}
+	}
+
+	p, weight, stats := AStarStats(s, simple.Node(-1), g, nil)
+	if p != nil || !math.IsInf(weight, 1) || stats != (SearchStats{}) {
+		t.Errorf("unexpected result for missing target: path=%v weight=%f stats=%+v", p, weight, stats)
+	}
+}
+
+func TestAStarMultiGoal(t *testing.T) {
+	t.Parallel()
+	g := testgraphs.NewGrid(10, 10, true)
+	// Create a wall that makes the goal that is nearest
+	// by Manhattan distance further by path length.
+	for r := 0; r < 9; r++ {
+		g.Set(r, 3, false)
+	}
+	manhattan := func(u, v graph.Node) float64 {
+		ur, uc := g.RowCol(u.ID())
+		vr, vc := g.RowCol(v.ID())
+		return math.Abs(float64(ur-vr)) + math.Abs(float64(uc-vc))
+	}
+	minManhattan := func(n graph.Node, goals []graph.Node) float64 {
+		best := math.Inf(1)
+		for _, t := range goals {
+			best = math.Min(best, manhattan(n, t))
+		}
+		return best
+	}
+
+	for _, test := range []struct {
+		name  string
+		s     graph.Node
+		goals []graph.Node
+	}{
+		{name: "behind wall", s: g.NodeAt(0, 0), goals: []graph.Node{g.NodeAt(0, 5), g.NodeAt(9, 0)}},
+		{name: "single", s: g.NodeAt(0, 0), goals: []graph.Node{g.NodeAt(9, 9)}},
+		{name: "source is goal", s: g.NodeAt(0, 0), goals: []graph.Node{g.NodeAt(9, 9), g.NodeAt(0, 0)}},
+		{name: "unreachable", s: g.NodeAt(0, 0), goals: []graph.Node{g.NodeAt(0, 3)}},
+		{name: "missing", s: g.NodeAt(0, 0), goals: []graph.Node{simple.Node(-1)}},
+		{name: "none", s: g.NodeAt(0, 0)},
+	} {
+		pt := DijkstraFrom(test.s, g)
+		want := math.Inf(1)
+		for _, t := range test.goals {
+			want = math.Min(want, pt.WeightTo(t.ID()))
+		}
+
+		for _, h := range []struct {
+			name string
+			fn   func(graph.Node, []graph.Node) float64
+		}{
+			{name: "null"},
+			{name: "manhattan", fn: minManhattan},
+		} {
+			p, weight, goal := AStarMultiGoal(test.s, test.goals, g, h.fn)
+			if weight != want {
+				t.Errorf("%s %s: unexpected weight: got:%f want:%f", test.name, h.name, weight, want)
+			}
+			if math.IsInf(want, 1) {
+				if p != nil || goal != nil {
+					t.Errorf("%s %s: unexpected result for unreachable goals: path=%v goal=%v", test.name, h.name, p, goal)
+				}
+				continue
+			}
+			if goal == nil || pt.WeightTo(goal.ID()) != want {
+				t.Errorf("%s %s: unexpected goal: %v", test.name, h.name, goal)
+				continue
+			}
+			if len(p) == 0 || p[0].ID() != test.s.ID() || p[len(p)-1].ID() != goal.ID() {
+				t.Errorf("%s %s: unexpected path ends: %v", test.name, h.name, p)
+			}
+			if !topo.IsPathIn(g, p) {
+				t.Errorf("%s %s: got path that is not path in input graph: %v", test.name, h.name, p)
+			}
+		}
+	}
+}
@@ -0,0 +1,249 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package path
+
+import (
+	"container/heap"
+	"math"
+	"slices"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/internal/set"
+	"gonum.org/v1/gonum/graph/traverse"
+)
+
+// ARAStar implements the Anytime Repairing A* path search algorithm. ARAStar
+// finds a path from s to t by weighted A* search with the heuristic inflated by
+// a factor ε, and then repeatedly decreases ε and repairs the path, reusing the
+// search effort of earlier iterations. The weight of each path found is no
+// more than ε times the weight of a shortest path if the heuristic is
+// admissible, so the paths improve toward the shortest path as ε approaches 1.
+//
+//	Likhachev, Gordon and Thrun, "ARA*: Anytime A* with Provable Bounds on Sub-Optimality", NIPS 2003.
+type ARAStar struct {
+	s, t graph.Node
+	g    traverse.Graph
+
+	// goal is the node of the graph
+	// for t once it has been reached.
+	goal graph.Node
+
+	weight    Weighting
+	heuristic Heuristic
+
+	epsilon   float64
+	decrement float64
+	started   bool
+	done      bool
+
+	// gscore and prev hold the path weights
+	// and back-pointers of the search tree.
+	gscore map[int64]float64
+	prev   map[int64]graph.Node
+
+	open   *aStarQueue
+	closed set.Ints[int64]
+	// incons holds nodes that were improved
+	// after they were closed in the current
+	// iteration.
+	incons map[int64]graph.Node
+}
+
+// NewARAStar returns a new ARAStar for the path from s to t in g using the
+// heuristic h. The heuristic is initially inflated by the factor epsilon, and
+// each call to Improve after the first reduces the inflation factor by
+// decrement until it reaches 1. NewARAStar will panic if epsilon is less than
+// 1 or decrement is not positive.
+//
+// If h is nil, the ARAStar will use the g.HeuristicCost method if g implements
+// HeuristicCoster, falling back to NullHeuristic otherwise. If the graph does
+// not implement Weighted, UniformCost is used.
+func NewARAStar(s, t graph.Node, g traverse.Graph, h Heuristic, epsilon, decrement float64) *ARAStar {
+	if !(epsilon >= 1) || !(decrement > 0) {
+		panic("path: invalid ARA* inflation schedule")
+	}
+	a := &ARAStar{
+		s:    s,
+		t:    t,
+		g:    g,
+		goal: s,
+
+		heuristic: aStarHeuristic(g, h),
+
+		epsilon:   epsilon,
+		decrement: decrement,
+
+		gscore: map[int64]float64{s.ID(): 0},
+		prev:   make(map[int64]graph.Node),
+
+		open:   &aStarQueue{indexOf: make(map[int64]int)},
+		closed: make(set.Ints[int64]),
+		incons: make(map[int64]graph.Node),
+	}
+	if wg, ok := g.(Weighted); ok {
+		a.weight = wg.Weight
+	} else {
+		a.weight = UniformCost(g)
+	}
+	if g, ok := g.(graph.Graph); ok {
+		if g.Node(s.ID()) == nil || g.Node(t.ID()) == nil {
+			a.done = true
+			return a
+		}
+	}
+	heap.Push(a.open, aStarNode{node: s, gscore: 0, fscore: a.fscore(s, 0)})
+	return a
+}
+
+// Epsilon returns the inflation factor that will be used by the next
+// call to Improve.
+func (a *ARAStar) Epsilon() float64 {
+	return a.epsilon
+}
+
+// Improve performs one iteration of the search, returning the best path found
+// from s to t, its weight and whether the path is known to be a shortest path.
+// The first call to Improve searches with the initial inflation factor, and
+// later calls reduce the inflation factor and repair the path. Once optimal is
+// returned true, further calls return the same path without searching. If t
+// is not reachable from s, the returned path is nil, the weight is +Inf and
+// optimal is true. Improve will panic if g has an ARA*-reachable negative edge
+// weight.
+//
+// Callers with a time budget may stop calling Improve at any point and use
+// the last path returned.
+func (a *ARAStar) Improve() (path []graph.Node, weight float64, optimal bool) {
+	if !a.done {
+		if a.started {
+			a.reopen()
+		}
+		a.started = true
+		a.improvePath()
+		if a.epsilon == 1 || a.bound() <= 1 {
+			a.done = true
+		} else {
+			a.epsilon = math.Max(1, a.epsilon-a.decrement)
+		}
+	}
+	path, weight = a.path()
+	return path, weight, a.done
+}
+
+// fscore returns the inflated priority of n with path weight g.
+func (a *ARAStar) fscore(n graph.Node, g float64) float64 {
+	return g + a.epsilon*a.heuristic(n, a.t)
+}
+
+// goalWeight returns the weight of the best path found to t.
+func (a *ARAStar) goalWeight() float64 {
+	w, ok := a.gscore[a.t.ID()]
+	if !ok {
+		return math.Inf(1)
+	}
+	return w
+}
+
+// improvePath is the ImprovePath procedure of the ARA* paper.
+func (a *ARAStar) improvePath() {
+	tid := a.t.ID()
+	for a.open.Len() != 0 {
+		if a.fscore(a.t, a.goalWeight()) <= a.open.nodes[0].fscore {
+			break
+		}
+		u := heap.Pop(a.open).(aStarNode)
+		uid := u.node.ID()
+		a.closed.Add(uid)
+		if uid == tid {
+			continue
+		}
+
+		to := a.g.From(uid)
+		for to.Next() {
+			v := to.Node()
+			vid := v.ID()
+			w, ok := a.weight(uid, vid)
+			if !ok {
+				panic("path: ARA* unexpected invalid weight")
+			}
+			if w < 0 {
+				panic("path: ARA* negative edge weight")
+			}
+			g := u.gscore + w
+			if old, ok := a.gscore[vid]; ok && g >= old {
+				continue
+			}
+			a.gscore[vid] = g
+			a.prev[vid] = u.node
+			if vid == tid {
+				a.goal = v
+			}
+			switch {
+			case a.closed.Has(vid):
+				a.incons[vid] = v
+			case a.open.has(vid):
+				a.open.update(vid, g, a.fscore(v, g))
+			default:
+				heap.Push(a.open, aStarNode{node: v, gscore: g, fscore: a.fscore(v, g)})
+			}
+		}
+	}
+}
+
+// reopen moves the inconsistent nodes into the open set, updates the
+// priorities of the open set for the current inflation factor and clears
+// the closed set.
+func (a *ARAStar) reopen() {
+	for id, n := range a.incons {
+		if !a.open.has(id) {
+			a.open.Push(aStarNode{node: n, gscore: a.gscore[id]})
+		}
+		delete(a.incons, id)
+	}
+	for i, n := range a.open.nodes {
+		a.open.nodes[i].fscore = a.fscore(n.node, n.gscore)
+	}
+	heap.Init(a.open)
+	a.closed = make(set.Ints[int64])
+}
+
+// bound returns the sub-optimality bound of the best path found to t.
+func (a *ARAStar) bound() float64 {
+	best := math.Inf(1)
+	for _, n := range a.open.nodes {
+		best = math.Min(best, n.gscore+a.heuristic(n.node, a.t))
+	}
+	for id, n := range a.incons {
+		best = math.Min(best, a.gscore[id]+a.heuristic(n, a.t))
+	}
+	w := a.goalWeight()
+	if math.IsInf(w, 1) || w <= best {
+		// t is not reachable, or there are no
+		// nodes that may lead to a better path.
+		return 1
+	}
+	return math.Min(a.epsilon, w/best)
+}
+
+// path returns the best path found to t and its weight. The weight of the
+// path may be less than the weight recorded for t, since nodes on the path
+// may have been improved after the weight of t was last updated.
+func (a *ARAStar) path() (path []graph.Node, weight float64) {
+	if math.IsInf(a.goalWeight(), 1) {
+		return nil, math.Inf(1)
+	}
+	sid := a.s.ID()
+	n := a.goal
+	path = []graph.Node{n}
+	for n.ID() != sid {
+		n = a.prev[n.ID()]
+		path = append(path, n)
+	}
+	slices.Reverse(path)
+	for i := 1; i < len(path); i++ {
+		w, _ := a.weight(path[i-1].ID(), path[i].ID())
+		weight += w
+	}
+	return path, weight
+}
@@ -0,0 +1,109 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package path
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/graph/topo"
+)
+
+func TestARAStar(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for n := 0; n < 20; n++ {
+		const size = 200
+		xy := make([][2]float64, size)
+		for i := range xy {
+			xy[i] = [2]float64{rnd.Float64(), rnd.Float64()}
+		}
+		dist := func(u, v int64) float64 {
+			return math.Hypot(xy[u][0]-xy[v][0], xy[u][1]-xy[v][1])
+		}
+		g := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+		for i := 0; i < size; i++ {
+			g.AddNode(simple.Node(i))
+		}
+		for i := 0; i < size; i++ {
+			for j := i + 1; j < size; j++ {
+				if d := dist(int64(i), int64(j)); d < 0.15 {
+					// Inflate the edge weights so the Euclidean
+					// heuristic is admissible but not exact.
+					g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(i), T: simple.Node(j), W: d * (1 + rnd.Float64())})
+				}
+			}
+		}
+		h := func(u, v graph.Node) float64 { return dist(u.ID(), v.ID()) }
+
+		s, dst := simple.Node(rnd.IntN(size)), simple.Node(rnd.IntN(size))
+		want := DijkstraFrom(s, g).WeightTo(dst.ID())
+
+		const epsilon, decrement = 3, 0.5
+		a := NewARAStar(s, dst, g, h, epsilon, decrement)
+		last := math.Inf(1)
+		var optimal bool
+		for i := 0; !optimal; i++ {
+			if i > int((epsilon-1)/decrement) {
+				t.Fatalf("graph %d: too many iterations", n)
+			}
+			e := a.Epsilon()
+			var (
+				p      []graph.Node
+				weight float64
+			)
+			p, weight, optimal = a.Improve()
+			if weight > last {
+				t.Errorf("graph %d: path weight increased: got:%f last:%f", n, weight, last)
+			}
+			last = weight
+			if math.IsInf(want, 1) {
+				if p != nil || !math.IsInf(weight, 1) || !optimal {
+					t.Errorf("graph %d: unexpected result for unreachable target: path=%v weight=%f optimal=%t", n, p, weight, optimal)
+				}
+				continue
+			}
+			if weight > e*want*(1+1e-12) {
+				t.Errorf("graph %d: path weight exceeds bound: got:%f want<=%f", n, weight, e*want)
+			}
+			if !topo.IsPathIn(g, p) || p[0].ID() != s.ID() || p[len(p)-1].ID() != dst.ID() {
+				t.Errorf("graph %d: unexpected path: %v", n, p)
+			}
+			var sum float64
+			for k := 1; k < len(p); k++ {
+				sum += g.WeightedEdge(p[k-1].ID(), p[k].ID()).Weight()
+			}
+			if math.Abs(sum-weight) > 1e-12 {
+				t.Errorf("graph %d: unexpected path weight: got:%f want:%f", n, sum, weight)
+			}
+		}
+		if math.Abs(last-want) > 1e-12 {
+			t.Errorf("graph %d: unexpected optimal weight: got:%f want:%f", n, last, want)
+		}
+
+		// Further calls return the same result.
+		_, weight, optimal := a.Improve()
+		if weight != last || !optimal {
+			t.Errorf("graph %d: unexpected result after optimal: weight=%f optimal=%t", n, weight, optimal)
+		}
+	}
+}
+
+func TestARAStarSourceIsTarget(t *testing.T) {
+	t.Parallel()
+	g := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+	g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(0), T: simple.Node(1), W: 1})
+	p, weight, optimal := NewARAStar(simple.Node(0), simple.Node(0), g, nil, 2, 1).Improve()
+	if len(p) != 1 || p[0].ID() != 0 || weight != 0 || !optimal {
+		t.Errorf("unexpected result: path=%v weight=%f optimal=%t", p, weight, optimal)
+	}
+	p, weight, optimal = NewARAStar(simple.Node(0), simple.Node(2), g, nil, 2, 1).Improve()
+	if p != nil || !math.IsInf(weight, 1) || !optimal {
+		t.Errorf("unexpected result for missing target: path=%v weight=%f optimal=%t", p, weight, optimal)
+	}
+}
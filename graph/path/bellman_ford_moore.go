@@ -5,6 +5,9 @@
 package path
 
 import (
+	"math"
+	"slices"
+
 	"gonum.org/v1/gonum/graph"
 	"gonum.org/v1/gonum/graph/internal/linear"
 	"gonum.org/v1/gonum/graph/traverse"
@@ -17,6 +20,12 @@ import (
 // If g is a graph.Graph, all nodes of the graph will be stored in the shortest-path
 // tree, otherwise only nodes reachable from u will be stored.
 //
+// BellmanFordFrom uses the queue-based Bellman-Ford-Moore formulation of the
+// algorithm, so edges are only relaxed from nodes whose distance changed since
+// they were last visited. Rather than performing a fixed |V|-1 rounds of
+// relaxation, the search terminates as soon as no relaxation is possible, which
+// is when a full pass over the queued nodes would make no change.
+//
 // The time complexity of BellmanFordFrom is O(|V|.|E|).
 func BellmanFordFrom(u graph.Node, g traverse.Graph) (path Shortest, ok bool) {
 	if h, ok := g.(graph.Graph); ok {
@@ -91,6 +100,93 @@ func BellmanFordFrom(u graph.Node, g traverse.Graph) (path Shortest, ok bool) {
 	return path, true
 }
 
+// BellmanFordFromCycle returns a shortest-path tree for a shortest path from u to all
+// nodes in the graph g as BellmanFordFrom does. If a negative cycle is reachable from u,
+// the returned cycle holds the nodes of one negative cycle in traversal order, with the
+// first node repeated at the end, and found is true. The cycle is reconstructed by
+// following predecessors in the shortest-path tree, continuing relaxation of the graph
+// until the tree contains a cycle. If the graph does not implement Weighted, UniformCost
+// is used.
+//
+// If g is a graph.Graph, all nodes of the graph will be stored in the shortest-path
+// tree, otherwise only nodes reachable from u will be stored.
+//
+// The time complexity of BellmanFordFromCycle is O(|V|.|E|) when no negative cycle is
+// reachable from u.
+func BellmanFordFromCycle(u graph.Node, g traverse.Graph) (path Shortest, cycle []graph.Node, found bool) {
+	path, ok := BellmanFordFrom(u, g)
+	if ok {
+		return path, nil, false
+	}
+
+	var weight Weighting
+	if wg, ok := g.(Weighted); ok {
+		weight = wg.Weight
+	} else {
+		weight = UniformCost(g)
+	}
+
+	// Every cycle in the predecessor graph of a Bellman-Ford
+	// search is a negative cycle, and while a negative cycle
+	// is reachable from u, relaxation will continue until the
+	// predecessor graph contains a cycle.
+	for {
+		cycle = path.predecessorCycle()
+		if cycle != nil {
+			return path, cycle, true
+		}
+		for j := 0; j < len(path.nodes); j++ {
+			if math.IsInf(path.dist[j], 1) {
+				continue
+			}
+			uid := path.nodes[j].ID()
+			to := g.From(uid)
+			for to.Next() {
+				v := to.Node()
+				vid := v.ID()
+				k, ok := path.indexOf[vid]
+				if !ok {
+					k = path.add(v)
+				}
+				w, ok := weight(uid, vid)
+				if !ok {
+					panic("bellman-ford: unexpected invalid weight")
+				}
+				joint := path.dist[j] + w
+				if joint < path.dist[k] {
+					path.set(k, joint, j)
+				}
+			}
+		}
+	}
+}
+
+// predecessorCycle returns a cycle in the predecessor graph of p in traversal
+// order with the first node repeated at the end, or nil if there is no cycle.
+func (p Shortest) predecessorCycle() []graph.Node {
+	// walk holds the index of the walk that
+	// first visited each node, offset by one.
+	walk := make([]int, len(p.next))
+	for i := range p.next {
+		j := i
+		for j != -1 && walk[j] == 0 {
+			walk[j] = i + 1
+			j = p.next[j]
+		}
+		if j == -1 || walk[j] != i+1 {
+			continue
+		}
+		cycle := []graph.Node{p.nodes[j]}
+		for k := p.next[j]; k != j; k = p.next[k] {
+			cycle = append(cycle, p.nodes[k])
+		}
+		cycle = append(cycle, p.nodes[j])
+		slices.Reverse(cycle)
+		return cycle
+	}
+	return nil
+}
+
 // BellmanFordAllFrom returns a shortest-path tree for shortest paths from u to all nodes in
 // the graph g, or false indicating that a negative cycle exists in the graph. If the graph
 // does not implement Weighted, UniformCost is used.
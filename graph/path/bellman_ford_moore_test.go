@@ -11,6 +11,7 @@ import (
 
 	"gonum.org/v1/gonum/graph"
 	"gonum.org/v1/gonum/graph/path/internal/testgraphs"
+	"gonum.org/v1/gonum/graph/simple"
 	"gonum.org/v1/gonum/graph/traverse"
 	"gonum.org/v1/gonum/internal/order"
 )
@@ -78,6 +79,89 @@ func TestBellmanFordFrom(t *testing.T) {
 	}
 }
 
+func TestBellmanFordFromCycle(t *testing.T) {
+	t.Parallel()
+	for _, test := range testgraphs.ShortestPathTests {
+		g := test.Graph()
+		for _, e := range test.Edges {
+			g.SetWeightedEdge(e)
+		}
+
+		for _, tg := range []struct {
+			typ string
+			g   traverse.Graph
+		}{
+			{"complete", g.(graph.Graph)},
+			{"incremental", incremental{g.(graph.Weighted)}},
+		} {
+			_, ok := BellmanFordFrom(test.Query.From(), tg.g)
+			pt, cycle, found := BellmanFordFromCycle(test.Query.From(), tg.g)
+			if found == ok {
+				t.Errorf("%q %s: unexpected negative cycle detection: got:%t want:%t", test.Name, tg.typ, found, !ok)
+				continue
+			}
+			if pt.From().ID() != test.Query.From().ID() {
+				t.Errorf("%q %s: unexpected from node ID: got:%d want:%d", test.Name, tg.typ, pt.From().ID(), test.Query.From().ID())
+			}
+			if !found {
+				if cycle != nil {
+					t.Errorf("%q %s: unexpected cycle: %v", test.Name, tg.typ, cycle)
+				}
+				continue
+			}
+
+			if len(cycle) < 3 || cycle[0].ID() != cycle[len(cycle)-1].ID() {
+				t.Errorf("%q %s: cycle is not closed: %v", test.Name, tg.typ, cycle)
+				continue
+			}
+			var weight float64
+			for i, u := range cycle[:len(cycle)-1] {
+				w, ok := g.(graph.Weighted).Weight(u.ID(), cycle[i+1].ID())
+				if !ok || g.(graph.Graph).Edge(u.ID(), cycle[i+1].ID()) == nil {
+					t.Errorf("%q %s: cycle contains missing edge from %d to %d: %v", test.Name, tg.typ, u.ID(), cycle[i+1].ID(), cycle)
+				}
+				weight += w
+			}
+			if weight >= 0 {
+				t.Errorf("%q %s: cycle is not negative: weight=%f %v", test.Name, tg.typ, weight, cycle)
+			}
+		}
+	}
+}
+
+func TestBellmanFordFromEarlyTermination(t *testing.T) {
+	t.Parallel()
+	// A path graph stabilizes after a single pass, so
+	// each edge should be relaxed exactly once.
+	const n = 100
+	g := simple.NewWeightedDirectedGraph(0, math.Inf(1))
+	for i := 1; i < n; i++ {
+		g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(i - 1), T: simple.Node(i), W: -1})
+	}
+	wc := &weightCounter{WeightedDirectedGraph: g}
+	pt, ok := BellmanFordFrom(simple.Node(0), wc)
+	if !ok {
+		t.Fatal("unexpected negative cycle")
+	}
+	if got := pt.WeightTo(n - 1); got != -(n - 1) {
+		t.Errorf("unexpected weight: got:%f want:%d", got, -(n - 1))
+	}
+	if wc.calls != n-1 {
+		t.Errorf("unexpected number of edge relaxations: got:%d want:%d", wc.calls, n-1)
+	}
+}
+
+// weightCounter counts calls to Weight.
+type weightCounter struct {
+	*simple.WeightedDirectedGraph
+	calls int
+}
+
+func (g *weightCounter) Weight(xid, yid int64) (w float64, ok bool) {
+	g.calls++
+	return g.WeightedDirectedGraph.Weight(xid, yid)
+}
+
 func TestBellmanFordAllFrom(t *testing.T) {
 	t.Parallel()
 	for _, test := range testgraphs.ShortestPathTests {
@@ -6,6 +6,8 @@ package path
 
 import (
 	"fmt"
+	"math"
+	"math/rand/v2"
 	"sync"
 	"testing"
 
@@ -190,3 +192,157 @@ func BenchmarkBellmanFordFrom(b *testing.B) {
 		}
 	}
 }
+
+func BenchmarkFloydWarshall(b *testing.B) {
+	benchmarks := []struct {
+		name  string
+		graph graph.Directed
+	}{
+		{"500 tenth", gnpDirected_500_tenth()},
+		{"500 half", gnpDirected_500_half()},
+		{"500 full", gnpDirected_500_full()},
+	}
+
+	for _, bm := range benchmarks {
+		for _, fw := range []struct {
+			typ string
+			fn  func(graph.Graph) (AllShortest, bool)
+		}{
+			{typ: " serial", fn: FloydWarshall},
+			{typ: " parallel", fn: FloydWarshallParallel},
+		} {
+			b.Run(bm.name+fw.typ, func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					fw.fn(bm.graph)
+				}
+			})
+		}
+	}
+}
+
+var (
+	rggUndirected_1000  = randomGeometricUndirected(1000, 0.06)
+	rggUndirected_10000 = randomGeometricUndirected(10000, 0.02)
+)
+
+// randomGeometricUndirected returns a random geometric graph of n nodes placed
+// uniformly in the unit square with edges between nodes closer than r weighted
+// by their Euclidean distance.
+func randomGeometricUndirected(n int, r float64) func() graph.WeightedUndirected {
+	var once sync.Once
+	var cache graph.WeightedUndirected
+	return func() graph.WeightedUndirected {
+		once.Do(func() {
+			rnd := rand.New(rand.NewPCG(1, 1))
+			pos := make([][2]float64, n)
+			for i := range pos {
+				pos[i] = [2]float64{rnd.Float64(), rnd.Float64()}
+			}
+			g := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+			for i := range pos {
+				g.AddNode(simple.Node(i))
+				for j := 0; j < i; j++ {
+					d := math.Hypot(pos[i][0]-pos[j][0], pos[i][1]-pos[j][1])
+					if d < r {
+						g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(i), T: simple.Node(j), W: d})
+					}
+				}
+			}
+			cache = g
+		})
+		return cache
+	}
+}
+
+// settledCounter counts nodes settled by a search by counting calls to From.
+type settledCounter struct {
+	graph.WeightedUndirected
+	settled int
+}
+
+func (g *settledCounter) From(id int64) graph.Nodes {
+	g.settled++
+	return g.WeightedUndirected.From(id)
+}
+
+func BenchmarkDijkstraPointToPoint(b *testing.B) {
+	benchmarks := []struct {
+		name  string
+		graph graph.WeightedUndirected
+	}{
+		{"RGG 1000", rggUndirected_1000()},
+		{"RGG 10000", rggUndirected_10000()},
+	}
+
+	for _, bm := range benchmarks {
+		n := int64(bm.graph.Nodes().Len())
+		for _, fn := range []struct {
+			name string
+			fn   func(g graph.Graph, s, t graph.Node) ([]graph.Node, float64)
+		}{
+			{"FromTo", func(g graph.Graph, s, t graph.Node) ([]graph.Node, float64) { return DijkstraFromTo(s, t, g) }},
+			{"Bidirectional", DijkstraBidirectional},
+		} {
+			b.Run(bm.name+" "+fn.name, func(b *testing.B) {
+				g := &settledCounter{WeightedUndirected: bm.graph}
+				for i := 0; i < b.N; i++ {
+					p, _ := fn.fn(g, simple.Node(int64(i)%n), simple.Node(n-1-int64(i)%n))
+					if p == nil {
+						b.Fatal("unexpected disconnected query")
+					}
+				}
+				b.ReportMetric(float64(g.settled)/float64(b.N), "settled/op")
+			})
+		}
+	}
+}
+
+func BenchmarkMinimumSpanningTree(b *testing.B) {
+	benchmarks := []struct {
+		name  string
+		graph func() graph.WeightedUndirected
+	}{
+		{"rgg 1000", rggUndirected_1000},
+		{"rgg 10000", rggUndirected_10000},
+		{"complete 1000", completeWeightedUndirected_1000},
+	}
+
+	for _, bm := range benchmarks {
+		g := bm.graph().(UndirectedWeightLister)
+		for _, mst := range []struct {
+			typ string
+			fn  func(WeightedBuilder, UndirectedWeightLister) float64
+		}{
+			{typ: " kruskal", fn: Kruskal},
+			{typ: " boruvka", fn: Boruvka},
+		} {
+			b.Run(bm.name+mst.typ, func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					mst.fn(simple.NewWeightedUndirectedGraph(0, math.Inf(1)), g)
+				}
+			})
+		}
+	}
+}
+
+var completeWeightedUndirected_1000 = completeWeightedUndirected(1000)
+
+// completeWeightedUndirected returns a complete graph of n nodes with
+// uniformly distributed random edge weights.
+func completeWeightedUndirected(n int) func() graph.WeightedUndirected {
+	var once sync.Once
+	var cache graph.WeightedUndirected
+	return func() graph.WeightedUndirected {
+		once.Do(func() {
+			rnd := rand.New(rand.NewPCG(1, 1))
+			g := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+			for i := 0; i < n; i++ {
+				for j := 0; j < i; j++ {
+					g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(i), T: simple.Node(j), W: rnd.Float64()})
+				}
+			}
+			cache = g
+		})
+		return cache
+	}
+}
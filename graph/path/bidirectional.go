@@ -0,0 +1,117 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package path
+
+import (
+	"slices"
+
+	"gonum.org/v1/gonum/graph"
+)
+
+// BidirectionalBFS returns a path with the fewest edges from s to t in g, and
+// whether such a path exists. Edge weights are not considered. The search
+// alternates breadth-first expansion of complete levels from s along From and
+// from t backwards, expanding the smaller frontier at each step, and stops when
+// the two searches meet. If g is a graph.Directed that is not a
+// graph.Undirected, the backward search follows edges towards t using To,
+// otherwise edges are followed from t using From.
+//
+// If s or t is not in g, BidirectionalBFS returns nil and false. If s and t are
+// the same node, the returned path holds only s.
+func BidirectionalBFS(g graph.Graph, s, t graph.Node) ([]graph.Node, bool) {
+	if g.Node(s.ID()) == nil || g.Node(t.ID()) == nil {
+		return nil, false
+	}
+	if s.ID() == t.ID() {
+		return []graph.Node{s}, true
+	}
+
+	back := g
+	if d, ok := g.(graph.Directed); ok {
+		if _, ok := g.(graph.Undirected); !ok {
+			back = reverse(d)
+		}
+	}
+
+	fwd := newBFSSide(s)
+	bwd := newBFSSide(t)
+	for len(fwd.frontier) != 0 && len(bwd.frontier) != 0 {
+		var meet graph.Node
+		if len(fwd.frontier) <= len(bwd.frontier) {
+			meet = fwd.expand(g, bwd)
+		} else {
+			meet = bwd.expand(back, fwd)
+		}
+		if meet == nil {
+			continue
+		}
+
+		path := fwd.pathTo(meet)
+		slices.Reverse(path)
+		return append(path, bwd.pathTo(meet)[1:]...), true
+	}
+	return nil, false
+}
+
+// bfsSide is one direction of a bidirectional breadth-first search.
+type bfsSide struct {
+	// frontier is the set of nodes most
+	// recently reached by the search.
+	frontier []graph.Node
+
+	// prev and depth hold the node each
+	// reached node was reached from and
+	// the number of edges from the root.
+	prev  map[int64]graph.Node
+	depth map[int64]int
+}
+
+func newBFSSide(root graph.Node) *bfsSide {
+	return &bfsSide{
+		frontier: []graph.Node{root},
+		prev:     map[int64]graph.Node{root.ID(): nil},
+		depth:    map[int64]int{root.ID(): 0},
+	}
+}
+
+// expand advances the search by one complete level over g, returning the
+// reached node that is nearest to the roots of both searches among the nodes
+// that have been reached by other, or nil if none has been.
+func (b *bfsSide) expand(g graph.Graph, other *bfsSide) graph.Node {
+	var (
+		next []graph.Node
+		meet graph.Node
+		best int
+	)
+	for _, u := range b.frontier {
+		d := b.depth[u.ID()] + 1
+		to := g.From(u.ID())
+		for to.Next() {
+			v := to.Node()
+			vid := v.ID()
+			if _, seen := b.prev[vid]; seen {
+				continue
+			}
+			b.prev[vid] = u
+			b.depth[vid] = d
+			next = append(next, v)
+			if od, ok := other.depth[vid]; ok && (meet == nil || d+od < best) {
+				meet = v
+				best = d + od
+			}
+		}
+	}
+	b.frontier = next
+	return meet
+}
+
+// pathTo returns the path from n back to the root of the search.
+func (b *bfsSide) pathTo(n graph.Node) []graph.Node {
+	var path []graph.Node
+	for ; n != nil; n = b.prev[n.ID()] {
+		path = append(path, n)
+	}
+	return path
+}
@@ -0,0 +1,197 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package path
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/graph/traverse"
+)
+
+var bidirectionalBFSTests = []struct {
+	name     string
+	directed bool
+	edges    [][2]int64
+	s, t     int64
+
+	wantLen int
+	wantOK  bool
+}{
+	{
+		name:    "path",
+		edges:   [][2]int64{{0, 1}, {1, 2}, {2, 3}, {3, 4}},
+		s:       0,
+		t:       4,
+		wantLen: 5,
+		wantOK:  true,
+	},
+	{
+		name:    "same",
+		edges:   [][2]int64{{0, 1}},
+		s:       1,
+		t:       1,
+		wantLen: 1,
+		wantOK:  true,
+	},
+	{
+		// Multiple equal length paths from 0 to 5.
+		name:    "grid",
+		edges:   [][2]int64{{0, 1}, {0, 2}, {1, 3}, {2, 3}, {1, 4}, {2, 4}, {3, 5}, {4, 5}},
+		s:       0,
+		t:       5,
+		wantLen: 4,
+		wantOK:  true,
+	},
+	{
+		// A long path and a short path meeting
+		// at different depths of each search.
+		name:    "shortcut",
+		edges:   [][2]int64{{0, 1}, {1, 2}, {2, 3}, {3, 4}, {4, 5}, {0, 6}, {6, 7}, {7, 5}},
+		s:       0,
+		t:       5,
+		wantLen: 4,
+		wantOK:  true,
+	},
+	{
+		name:     "directed",
+		directed: true,
+		edges:    [][2]int64{{0, 1}, {1, 2}, {3, 0}, {2, 3}, {0, 3}},
+		s:        3,
+		t:        2,
+		wantLen:  4,
+		wantOK:   true,
+	},
+	{
+		name:     "directed unreachable",
+		directed: true,
+		edges:    [][2]int64{{0, 1}, {2, 1}},
+		s:        0,
+		t:        2,
+		wantOK:   false,
+	},
+	{
+		name:   "disconnected",
+		edges:  [][2]int64{{0, 1}, {2, 3}},
+		s:      0,
+		t:      3,
+		wantOK: false,
+	},
+	{
+		name:   "missing",
+		edges:  [][2]int64{{0, 1}},
+		s:      0,
+		t:      5,
+		wantOK: false,
+	},
+}
+
+func TestBidirectionalBFS(t *testing.T) {
+	t.Parallel()
+	for _, test := range bidirectionalBFSTests {
+		var g graph.Graph
+		if test.directed {
+			dg := simple.NewDirectedGraph()
+			for _, e := range test.edges {
+				dg.SetEdge(simple.Edge{F: simple.Node(e[0]), T: simple.Node(e[1])})
+			}
+			g = dg
+		} else {
+			ug := simple.NewUndirectedGraph()
+			for _, e := range test.edges {
+				ug.SetEdge(simple.Edge{F: simple.Node(e[0]), T: simple.Node(e[1])})
+			}
+			g = ug
+		}
+		path, ok := BidirectionalBFS(g, simple.Node(test.s), simple.Node(test.t))
+		if ok != test.wantOK {
+			t.Errorf("unexpected path existence for %q: got:%t want:%t", test.name, ok, test.wantOK)
+		}
+		if !ok {
+			if path != nil {
+				t.Errorf("unexpected path for %q: %v", test.name, path)
+			}
+			continue
+		}
+		if len(path) != test.wantLen {
+			t.Errorf("unexpected path length for %q: got:%d want:%d", test.name, len(path), test.wantLen)
+		}
+		checkHopPath(t, test.name, g, test.s, test.t, path)
+	}
+}
+
+func TestBidirectionalBFSRandom(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for trial := 0; trial < 20; trial++ {
+		const n = 60
+		var g graph.Graph
+		if trial%2 == 0 {
+			dg := simple.NewDirectedGraph()
+			for i := 0; i < n; i++ {
+				dg.AddNode(simple.Node(i))
+			}
+			for u := 0; u < n; u++ {
+				for v := 0; v < n; v++ {
+					if u != v && rnd.Float64() < 0.03 {
+						dg.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+					}
+				}
+			}
+			g = dg
+		} else {
+			ug := simple.NewUndirectedGraph()
+			for i := 0; i < n; i++ {
+				ug.AddNode(simple.Node(i))
+			}
+			for u := 0; u < n; u++ {
+				for v := u + 1; v < n; v++ {
+					if rnd.Float64() < 0.03 {
+						ug.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+					}
+				}
+			}
+			g = ug
+		}
+		for s := int64(0); s < n; s += 7 {
+			depth := make(map[int64]int)
+			var bf traverse.BreadthFirst
+			bf.Walk(g, simple.Node(s), func(n graph.Node, d int) bool {
+				depth[n.ID()] = d
+				return false
+			})
+			for tid := int64(0); tid < n; tid++ {
+				path, ok := BidirectionalBFS(g, simple.Node(s), simple.Node(tid))
+				d, want := depth[tid]
+				if ok != want {
+					t.Errorf("unexpected path existence from %d to %d: got:%t want:%t", s, tid, ok, want)
+					continue
+				}
+				if !ok {
+					continue
+				}
+				if len(path)-1 != d {
+					t.Errorf("unexpected path length from %d to %d: got:%d want:%d", s, tid, len(path)-1, d)
+				}
+				checkHopPath(t, "random", g, s, tid, path)
+			}
+		}
+	}
+}
+
+// checkHopPath checks that path is a path in g from s to t.
+func checkHopPath(t *testing.T, name string, g graph.Graph, s, tid int64, path []graph.Node) {
+	t.Helper()
+	if path[0].ID() != s || path[len(path)-1].ID() != tid {
+		t.Errorf("unexpected path end points for %q: %v", name, path)
+	}
+	for i := 1; i < len(path); i++ {
+		if g.Edge(path[i-1].ID(), path[i].ID()) == nil {
+			t.Errorf("path for %q follows non-edge %d->%d: %v", name, path[i-1].ID(), path[i].ID(), path)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package path
+
+import (
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+// randomConnectedGraph returns a random connected undirected graph on nodes
+// [0, n) formed from a random spanning tree and up to n additional random
+// edges, with integer edge weights in [1, 9].
+func randomConnectedGraph(n int, rnd *rand.Rand) *simple.WeightedUndirectedGraph {
+	g := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+	for i := 1; i < n; i++ {
+		g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(rnd.IntN(i)), T: simple.Node(i), W: float64(1 + rnd.IntN(9))})
+	}
+	for i := 0; i < n; i++ {
+		u, v := rnd.IntN(n), rnd.IntN(n)
+		if u != v && !g.HasEdgeBetween(int64(u), int64(v)) {
+			g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(u), T: simple.Node(v), W: float64(1 + rnd.IntN(9))})
+		}
+	}
+	return g
+}
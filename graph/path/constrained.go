@@ -0,0 +1,202 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package path
+
+import (
+	"container/heap"
+	"math"
+	"slices"
+
+	"gonum.org/v1/gonum/graph"
+)
+
+// ConstrainedShortestPath returns the lowest cost path from s to t in g whose
+// total resource consumption is no greater than budget, with the cost of the
+// path. If no path from s to t satisfies the budget, ConstrainedShortestPath
+// returns nil, +Inf and false. The cost of an edge is given by the Weighted
+// interface, or UniformCost if g does not implement Weighted, and the resource
+// consumed by the edge from u to v is given by resource(u, v). When more than
+// one path has the lowest cost, the path with the least resource consumption is
+// returned.
+//
+// ConstrainedShortestPath uses a label-setting search over (cost, resource)
+// pairs, discarding labels that are Pareto-dominated by another label at the
+// same node or that exceed the budget. In the worst case the number of labels
+// may grow exponentially with the number of nodes in g. ConstrainedShortestPath
+// will panic if g has an s-reachable negative edge weight or resource.
+func ConstrainedShortestPath(g graph.Graph, s, t graph.Node, resource func(u, v int64) float64, budget float64) (path []graph.Node, cost float64, ok bool) {
+	var weight Weighting
+	if wg, ok := g.(Weighted); ok {
+		weight = wg.Weight
+	} else {
+		weight = UniformCost(g)
+	}
+	consumed := func(xid, yid int64) (float64, bool) { return resource(xid, yid), true }
+	labels := paretoSearch(g, s, t, weight, consumed, budget, true)
+	if len(labels) == 0 {
+		return nil, math.Inf(1), false
+	}
+	return labels[0].path(), labels[0].cost, true
+}
+
+// ParetoShortestPaths returns every Pareto-optimal path from s to t in g over
+// the two objectives given by w1 and w2, with the objective vector of each
+// path. A path is Pareto-optimal if no other path is at least as good in both
+// objectives and better in one. The paths are returned in order of increasing
+// value of the first objective, and so of decreasing value of the second.
+// When more than one path has the same objective vector, only one is returned.
+// If w1 or w2 is nil, the Weighted interface is used for that objective, or
+// UniformCost if g does not implement Weighted. If t is not reachable from s,
+// ParetoShortestPaths returns no paths.
+//
+// ParetoShortestPaths uses a multi-label setting search with dominance checks
+// at each node. Both the number of labels held during the search and the
+// number of returned paths may grow exponentially with the number of nodes
+// in g in the worst case. When only one objective needs to be bounded, rather
+// than traded off against the other, ConstrainedShortestPath is a better
+// choice. ParetoShortestPaths will panic if g has an s-reachable edge with a
+// negative value for either objective.
+func ParetoShortestPaths(g graph.Graph, s, t graph.Node, w1, w2 Weighting) (paths [][]graph.Node, objectives [][2]float64) {
+	var weight Weighting
+	if wg, ok := g.(Weighted); ok {
+		weight = wg.Weight
+	} else {
+		weight = UniformCost(g)
+	}
+	if w1 == nil {
+		w1 = weight
+	}
+	if w2 == nil {
+		w2 = weight
+	}
+	for _, l := range paretoSearch(g, s, t, w1, w2, math.Inf(1), false) {
+		paths = append(paths, l.path())
+		objectives = append(objectives, [2]float64{l.cost, l.resource})
+	}
+	return paths, objectives
+}
+
+// paretoLabel is a label for a path in a bicriteria search.
+type paretoLabel struct {
+	node           graph.Node
+	cost, resource float64
+
+	// prev is the label of the path
+	// that this label extends.
+	prev *paretoLabel
+
+	// dominated indicates that the label
+	// has been dominated by another label
+	// at the same node since it was queued.
+	dominated bool
+}
+
+// path returns the path represented by the label.
+func (l *paretoLabel) path() []graph.Node {
+	var path []graph.Node
+	for ; l != nil; l = l.prev {
+		path = append(path, l.node)
+	}
+	slices.Reverse(path)
+	return path
+}
+
+// dominates returns whether l is at least as good as the
+// cost and resource pair in both criteria.
+func (l *paretoLabel) dominates(cost, resource float64) bool {
+	return l.cost <= cost && l.resource <= resource
+}
+
+// paretoSearch returns labels for the Pareto-optimal paths from s to t in g
+// over the cost and resource objectives with resource consumption no greater
+// than budget, in order of increasing cost. If first is true, the search
+// terminates with the first label found for t.
+func paretoSearch(g graph.Graph, s, t graph.Node, cost, resource Weighting, budget float64, first bool) []*paretoLabel {
+	if g.Node(s.ID()) == nil || g.Node(t.ID()) == nil || budget < 0 {
+		return nil
+	}
+
+	tid := t.ID()
+	labels := make(map[int64][]*paretoLabel)
+	root := &paretoLabel{node: s}
+	labels[s.ID()] = []*paretoLabel{root}
+	Q := paretoQueue{root}
+	var found []*paretoLabel
+	for Q.Len() != 0 {
+		l := heap.Pop(&Q).(*paretoLabel)
+		if l.dominated {
+			continue
+		}
+		uid := l.node.ID()
+		if uid == tid {
+			found = append(found, l)
+			if first {
+				break
+			}
+			continue
+		}
+		to := g.From(uid)
+	relax:
+		for to.Next() {
+			v := to.Node()
+			vid := v.ID()
+			c, ok := cost(uid, vid)
+			if !ok {
+				panic("path: unexpected invalid weight")
+			}
+			r, ok := resource(uid, vid)
+			if !ok {
+				panic("path: unexpected invalid weight")
+			}
+			if c < 0 || r < 0 {
+				panic("path: negative edge weight")
+			}
+			c += l.cost
+			r += l.resource
+			if r > budget {
+				continue
+			}
+
+			existing := labels[vid]
+			for _, o := range existing {
+				if o.dominates(c, r) {
+					continue relax
+				}
+			}
+			next := &paretoLabel{node: v, cost: c, resource: r, prev: l}
+			kept := existing[:0]
+			for _, o := range existing {
+				if next.dominates(o.cost, o.resource) {
+					o.dominated = true
+					continue
+				}
+				kept = append(kept, o)
+			}
+			labels[vid] = append(kept, next)
+			heap.Push(&Q, next)
+		}
+	}
+	return found
+}
+
+// paretoQueue is a priority queue of labels ordered by
+// cost and then by resource.
+type paretoQueue []*paretoLabel
+
+func (q paretoQueue) Len() int { return len(q) }
+func (q paretoQueue) Less(i, j int) bool {
+	if q[i].cost == q[j].cost {
+		return q[i].resource < q[j].resource
+	}
+	return q[i].cost < q[j].cost
+}
+func (q paretoQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *paretoQueue) Push(n interface{}) { *q = append(*q, n.(*paretoLabel)) }
+func (q *paretoQueue) Pop() interface{} {
+	t := *q
+	var n interface{}
+	n, *q = t[len(t)-1], t[:len(t)-1]
+	return n
+}
@@ -0,0 +1,257 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package path
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+// resourceEdge is an edge with a cost and a resource consumption.
+type resourceEdge struct {
+	from, to       int64
+	cost, resource float64
+}
+
+var constrainedShortestPathTests = []struct {
+	name     string
+	directed bool
+	edges    []resourceEdge
+
+	query  simple.Edge
+	budget float64
+
+	wantPath []int64
+	wantCost float64
+	wantOK   bool
+}{
+	{
+		name:     "unconstrained",
+		directed: true,
+		edges: []resourceEdge{
+			{from: 0, to: 1, cost: 1, resource: 5},
+			{from: 1, to: 3, cost: 1, resource: 5},
+			{from: 0, to: 2, cost: 3, resource: 1},
+			{from: 2, to: 3, cost: 3, resource: 1},
+		},
+		query:    simple.Edge{F: simple.Node(0), T: simple.Node(3)},
+		budget:   math.Inf(1),
+		wantPath: []int64{0, 1, 3},
+		wantCost: 2,
+		wantOK:   true,
+	},
+	{
+		name:     "constrained",
+		directed: true,
+		edges: []resourceEdge{
+			{from: 0, to: 1, cost: 1, resource: 5},
+			{from: 1, to: 3, cost: 1, resource: 5},
+			{from: 0, to: 2, cost: 3, resource: 1},
+			{from: 2, to: 3, cost: 3, resource: 1},
+		},
+		query:    simple.Edge{F: simple.Node(0), T: simple.Node(3)},
+		budget:   9,
+		wantPath: []int64{0, 2, 3},
+		wantCost: 6,
+		wantOK:   true,
+	},
+	{
+		// The cheapest path to the intermediate node 1
+		// consumes too much resource to reach 3 in budget.
+		name:     "dominance",
+		directed: true,
+		edges: []resourceEdge{
+			{from: 0, to: 1, cost: 1, resource: 8},
+			{from: 0, to: 2, cost: 2, resource: 1},
+			{from: 2, to: 1, cost: 2, resource: 1},
+			{from: 1, to: 3, cost: 1, resource: 1},
+			{from: 0, to: 4, cost: 10, resource: 0},
+			{from: 4, to: 3, cost: 10, resource: 0},
+		},
+		query:    simple.Edge{F: simple.Node(0), T: simple.Node(3)},
+		budget:   5,
+		wantPath: []int64{0, 2, 1, 3},
+		wantCost: 5,
+		wantOK:   true,
+	},
+	{
+		name:     "cost tie",
+		directed: false,
+		edges: []resourceEdge{
+			{from: 0, to: 1, cost: 1, resource: 2},
+			{from: 1, to: 3, cost: 1, resource: 2},
+			{from: 0, to: 2, cost: 1, resource: 1},
+			{from: 2, to: 3, cost: 1, resource: 1},
+		},
+		query:    simple.Edge{F: simple.Node(0), T: simple.Node(3)},
+		budget:   math.Inf(1),
+		wantPath: []int64{0, 2, 3},
+		wantCost: 2,
+		wantOK:   true,
+	},
+	{
+		name:     "over budget",
+		directed: true,
+		edges: []resourceEdge{
+			{from: 0, to: 1, cost: 1, resource: 5},
+			{from: 1, to: 3, cost: 1, resource: 5},
+			{from: 0, to: 2, cost: 3, resource: 3},
+			{from: 2, to: 3, cost: 3, resource: 3},
+		},
+		query:    simple.Edge{F: simple.Node(0), T: simple.Node(3)},
+		budget:   5,
+		wantCost: math.Inf(1),
+		wantOK:   false,
+	},
+	{
+		name:     "source is target",
+		directed: true,
+		edges: []resourceEdge{
+			{from: 0, to: 1, cost: 1, resource: 5},
+		},
+		query:    simple.Edge{F: simple.Node(0), T: simple.Node(0)},
+		budget:   0,
+		wantPath: []int64{0},
+		wantCost: 0,
+		wantOK:   true,
+	},
+	{
+		name:     "unreachable",
+		directed: true,
+		edges: []resourceEdge{
+			{from: 1, to: 0, cost: 1, resource: 1},
+		},
+		query:    simple.Edge{F: simple.Node(0), T: simple.Node(1)},
+		budget:   math.Inf(1),
+		wantCost: math.Inf(1),
+		wantOK:   false,
+	},
+}
+
+// resourceGraph returns a weighted graph holding the costs of edges and a
+// resource function for the edges.
+func resourceGraph(directed bool, edges []resourceEdge) (graph.Graph, func(u, v int64) float64) {
+	var g graph.WeightedEdgeAdder
+	if directed {
+		g = simple.NewWeightedDirectedGraph(0, math.Inf(1))
+	} else {
+		g = simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+	}
+	resources := make(map[[2]int64]float64)
+	for _, e := range edges {
+		g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(e.from), T: simple.Node(e.to), W: e.cost})
+		resources[[2]int64{e.from, e.to}] = e.resource
+		if !directed {
+			resources[[2]int64{e.to, e.from}] = e.resource
+		}
+	}
+	return g.(graph.Graph), func(u, v int64) float64 { return resources[[2]int64{u, v}] }
+}
+
+func TestConstrainedShortestPath(t *testing.T) {
+	t.Parallel()
+	for _, test := range constrainedShortestPathTests {
+		g, resource := resourceGraph(test.directed, test.edges)
+
+		p, cost, ok := ConstrainedShortestPath(g, test.query.From(), test.query.To(), resource, test.budget)
+		if ok != test.wantOK {
+			t.Errorf("unexpected ok for %q: got:%t want:%t", test.name, ok, test.wantOK)
+		}
+		if cost != test.wantCost {
+			t.Errorf("unexpected cost for %q: got:%v want:%v", test.name, cost, test.wantCost)
+		}
+		var got []int64
+		for _, n := range p {
+			got = append(got, n.ID())
+		}
+		if !reflect.DeepEqual(got, test.wantPath) {
+			t.Errorf("unexpected path for %q: got:%v want:%v", test.name, got, test.wantPath)
+		}
+	}
+}
+
+var paretoShortestPathsTests = []struct {
+	name     string
+	directed bool
+	edges    []resourceEdge
+
+	query simple.Edge
+
+	wantPaths      [][]int64
+	wantObjectives [][2]float64
+}{
+	{
+		name:     "frontier",
+		directed: true,
+		edges: []resourceEdge{
+			{from: 0, to: 1, cost: 1, resource: 5},
+			{from: 1, to: 4, cost: 1, resource: 5},
+			{from: 0, to: 2, cost: 2, resource: 2},
+			{from: 2, to: 4, cost: 2, resource: 2},
+			{from: 0, to: 3, cost: 5, resource: 0},
+			{from: 3, to: 4, cost: 5, resource: 0},
+			// Dominated by 0-2-4.
+			{from: 0, to: 5, cost: 3, resource: 3},
+			{from: 5, to: 4, cost: 3, resource: 3},
+		},
+		query:          simple.Edge{F: simple.Node(0), T: simple.Node(4)},
+		wantPaths:      [][]int64{{0, 1, 4}, {0, 2, 4}, {0, 3, 4}},
+		wantObjectives: [][2]float64{{2, 10}, {4, 4}, {10, 0}},
+	},
+	{
+		name:     "dominated at intermediate node",
+		directed: false,
+		edges: []resourceEdge{
+			{from: 0, to: 1, cost: 1, resource: 1},
+			{from: 0, to: 2, cost: 1, resource: 3},
+			{from: 2, to: 1, cost: 1, resource: 1},
+			{from: 1, to: 3, cost: 1, resource: 1},
+			{from: 0, to: 3, cost: 5, resource: 0},
+		},
+		query:          simple.Edge{F: simple.Node(0), T: simple.Node(3)},
+		wantPaths:      [][]int64{{0, 1, 3}, {0, 3}},
+		wantObjectives: [][2]float64{{2, 2}, {5, 0}},
+	},
+	{
+		name:     "source is target",
+		directed: true,
+		edges: []resourceEdge{
+			{from: 0, to: 1, cost: 1, resource: 1},
+		},
+		query:          simple.Edge{F: simple.Node(0), T: simple.Node(0)},
+		wantPaths:      [][]int64{{0}},
+		wantObjectives: [][2]float64{{0, 0}},
+	},
+	{
+		name:     "unreachable",
+		directed: true,
+		edges: []resourceEdge{
+			{from: 1, to: 0, cost: 1, resource: 1},
+		},
+		query: simple.Edge{F: simple.Node(0), T: simple.Node(1)},
+	},
+}
+
+func TestParetoShortestPaths(t *testing.T) {
+	t.Parallel()
+	for _, test := range paretoShortestPathsTests {
+		g, resource := resourceGraph(test.directed, test.edges)
+
+		paths, objectives := ParetoShortestPaths(g, test.query.From(), test.query.To(), nil, func(u, v int64) (float64, bool) {
+			return resource(u, v), true
+		})
+		got := pathIDs(paths)
+		if !reflect.DeepEqual(got, test.wantPaths) {
+			t.Errorf("unexpected paths for %q:\ngot: %v\nwant:%v", test.name, got, test.wantPaths)
+		}
+		if !reflect.DeepEqual(objectives, test.wantObjectives) {
+			t.Errorf("unexpected objectives for %q:\ngot: %v\nwant:%v", test.name, objectives, test.wantObjectives)
+		}
+	}
+}
@@ -6,8 +6,11 @@ package path
 
 import (
 	"container/heap"
+	"math"
+	"slices"
 
 	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/internal/set"
 	"gonum.org/v1/gonum/graph/traverse"
 )
 
@@ -25,15 +28,17 @@ func DijkstraFrom(u graph.Node, g traverse.Graph) Shortest {
 
 // DijkstraFromTo returns a shortest path from u to t in the graph g. The
 // result is equivalent to DijkstraFrom(u, g).To(t.ID()), but DijkstraFromTo
-// can be more efficient, as it can terminate early if t is reached. If the
+// can be more efficient, as the search terminates as soon as t is settled
+// rather than computing the complete shortest path tree from u. If t is not
+// reachable from u, the returned path is nil and the weight is +Inf. If the
 // graph does not implement Weighted, UniformCost is used. DijkstraFromTo will
 // panic if g has a u-reachable negative edge weight that is discovered before
-// reaching t.
+// reaching t, and will panic if t is nil.
 //
 // The time complexity of DijkstraFromTo is O(|E|.log|V|).
 func DijkstraFromTo(u, t graph.Node, g traverse.Graph) (path []graph.Node, weight float64) {
-	// An implementation of Bidirectional Dijkstra's algorithm could be even
-	// more efficient, but it requires a transposed (or undirected) graph.
+	// DijkstraBidirectional can be more efficient when the
+	// graph provides a transposed view via graph.Directed.
 	if t == nil {
 		panic("dijkstra: nil target node")
 	}
@@ -109,6 +114,315 @@ func dijkstraFrom(u, t graph.Node, g traverse.Graph) Shortest {
 	return path
 }
 
+// DijkstraFromWithin returns a shortest-path tree for shortest paths from u to
+// all nodes in the graph g that are within radius of u. The search does not
+// expand nodes farther than radius from u, so nodes outside the radius have a
+// weight of +Inf and no path in the returned Shortest, even when they are
+// reachable. A node that is adjacent to a node within the radius but that can
+// only be reached by paths with a weight greater than radius is reported by
+// the Beyond method of the returned Shortest. A node that is neither within
+// the radius nor reported by Beyond is either unreachable from u or is only
+// reachable through nodes beyond the radius. The node u is always within the
+// radius. If the graph does not implement Weighted, UniformCost is used.
+// DijkstraFromWithin will panic if g has a negative edge weight reachable from
+// u within the radius.
+//
+// Only nodes within the radius, and those found to be beyond it, are stored in
+// the shortest-path tree.
+//
+// The time complexity of DijkstraFromWithin is O(|E_r|.log|V_r|) where V_r and
+// E_r are the nodes within the radius and the edges leaving them.
+func DijkstraFromWithin(u graph.Node, g graph.Graph, radius float64) Shortest {
+	if g.Node(u.ID()) == nil {
+		return Shortest{from: u}
+	}
+	path := newShortestFrom(u, []graph.Node{u})
+	path.beyond = make(set.Ints[int64])
+
+	var weight Weighting
+	if wg, ok := g.(Weighted); ok {
+		weight = wg.Weight
+	} else {
+		weight = UniformCost(g)
+	}
+
+	Q := priorityQueue{{node: u, dist: 0}}
+	for Q.Len() != 0 {
+		mid := heap.Pop(&Q).(distanceNode)
+		k := path.indexOf[mid.node.ID()]
+		if mid.dist > path.dist[k] {
+			continue
+		}
+		mnid := mid.node.ID()
+		to := g.From(mnid)
+		for to.Next() {
+			v := to.Node()
+			vid := v.ID()
+			j, ok := path.indexOf[vid]
+			if !ok {
+				j = path.add(v)
+			}
+			w, ok := weight(mnid, vid)
+			if !ok {
+				panic("dijkstra: unexpected invalid weight")
+			}
+			if w < 0 {
+				panic("dijkstra: negative edge weight")
+			}
+			joint := path.dist[k] + w
+			if joint > radius {
+				if math.IsInf(path.dist[j], 1) {
+					path.beyond.Add(vid)
+				}
+				continue
+			}
+			if joint < path.dist[j] {
+				heap.Push(&Q, distanceNode{node: v, dist: joint})
+				path.set(j, joint, k)
+				path.beyond.Remove(vid)
+			}
+		}
+	}
+
+	return path
+}
+
+// KNearest returns the k nodes nearest to u in the graph g, excluding u, and
+// their distances from u in ascending order of distance. The search halts as
+// soon as k nodes have been settled. If fewer than k nodes are reachable from
+// u, all the reachable nodes are returned. When nodes are equidistant from u,
+// their relative order depends on the iteration order of g. If the graph does
+// not implement Weighted, UniformCost is used. KNearest will panic if g has a
+// negative edge weight that is discovered before k nodes are settled.
+//
+// The time complexity of KNearest is O(|E|.log|V|).
+func KNearest(u graph.Node, g graph.Graph, k int) (nodes []graph.Node, dist []float64) {
+	if k <= 0 || g.Node(u.ID()) == nil {
+		return nil, nil
+	}
+
+	var weight Weighting
+	if wg, ok := g.(Weighted); ok {
+		weight = wg.Weight
+	} else {
+		weight = UniformCost(g)
+	}
+
+	uid := u.ID()
+	best := map[int64]float64{uid: 0}
+	settled := make(set.Ints[int64])
+	Q := priorityQueue{{node: u, dist: 0}}
+	for Q.Len() != 0 {
+		mid := heap.Pop(&Q).(distanceNode)
+		mnid := mid.node.ID()
+		if settled.Has(mnid) {
+			continue
+		}
+		settled.Add(mnid)
+		if mnid != uid {
+			nodes = append(nodes, mid.node)
+			dist = append(dist, mid.dist)
+			if len(nodes) == k {
+				break
+			}
+		}
+		to := g.From(mnid)
+		for to.Next() {
+			v := to.Node()
+			vid := v.ID()
+			w, ok := weight(mnid, vid)
+			if !ok {
+				panic("dijkstra: unexpected invalid weight")
+			}
+			if w < 0 {
+				panic("dijkstra: negative edge weight")
+			}
+			joint := mid.dist + w
+			if d, ok := best[vid]; !ok || joint < d {
+				heap.Push(&Q, distanceNode{node: v, dist: joint})
+				best[vid] = joint
+			}
+		}
+	}
+
+	return nodes, dist
+}
+
+// DijkstraFromSources returns, for each node in g reachable from any of the
+// source nodes, the ID of the nearest source and the distance to it. The
+// search is a single Dijkstra search seeded with all the sources at distance
+// zero, so it is equivalent to, but cheaper than, calling DijkstraFrom for each
+// source. Each source is its own nearest source. When a node is equidistant
+// from more than one source, the source it is associated with depends on the
+// iteration order of g. If the graph does not implement Weighted, UniformCost
+// is used. DijkstraFromSources will panic if g has a negative edge weight
+// reachable from a source.
+//
+// The time complexity of DijkstraFromSources is O(|E|.log|V|).
+func DijkstraFromSources(sources []graph.Node, g graph.Graph) (nearest map[int64]int64, dist map[int64]float64) {
+	var weight Weighting
+	if wg, ok := g.(Weighted); ok {
+		weight = wg.Weight
+	} else {
+		weight = UniformCost(g)
+	}
+
+	nearest = make(map[int64]int64)
+	dist = make(map[int64]float64)
+	var Q priorityQueue
+	for _, u := range sources {
+		uid := u.ID()
+		if g.Node(uid) == nil {
+			continue
+		}
+		if _, ok := dist[uid]; ok {
+			continue
+		}
+		nearest[uid] = uid
+		dist[uid] = 0
+		Q = append(Q, distanceNode{node: u, dist: 0})
+	}
+	for Q.Len() != 0 {
+		mid := heap.Pop(&Q).(distanceNode)
+		mnid := mid.node.ID()
+		if mid.dist > dist[mnid] {
+			continue
+		}
+		to := g.From(mnid)
+		for to.Next() {
+			v := to.Node()
+			vid := v.ID()
+			w, ok := weight(mnid, vid)
+			if !ok {
+				panic("dijkstra: unexpected invalid weight")
+			}
+			if w < 0 {
+				panic("dijkstra: negative edge weight")
+			}
+			joint := mid.dist + w
+			if d, ok := dist[vid]; !ok || joint < d {
+				heap.Push(&Q, distanceNode{node: v, dist: joint})
+				dist[vid] = joint
+				nearest[vid] = nearest[mnid]
+			}
+		}
+	}
+
+	return nearest, dist
+}
+
+// DijkstraBidirectional returns a shortest path from s to t in the graph g
+// using simultaneous forward and reverse searches that terminate when the
+// sum of the minimum distances in the two search frontiers is no less than
+// the weight of the best path found connecting them. If g implements
+// graph.Directed, the reverse search follows edges into each node using To,
+// otherwise it uses From. If t is not reachable from s, the returned path is
+// nil and the weight is +Inf. If the graph does not implement Weighted,
+// UniformCost is used. DijkstraBidirectional will panic if g has a negative
+// edge weight that is discovered by either search.
+//
+// The time complexity of DijkstraBidirectional is O(|E|.log|V|), but for
+// point-to-point queries it will generally settle fewer nodes than
+// DijkstraFromTo.
+func DijkstraBidirectional(g graph.Graph, s, t graph.Node) (path []graph.Node, weight float64) {
+	if g.Node(s.ID()) == nil || g.Node(t.ID()) == nil {
+		return nil, math.Inf(1)
+	}
+	if s.ID() == t.ID() {
+		return []graph.Node{s}, 0
+	}
+
+	var weightOf Weighting
+	if wg, ok := g.(Weighted); ok {
+		weightOf = wg.Weight
+	} else {
+		weightOf = UniformCost(g)
+	}
+	to := g.From
+	if dg, ok := g.(graph.Directed); ok {
+		to = dg.To
+	}
+
+	fwd := newDijkstraFrontier(s, g.From, weightOf)
+	rev := newDijkstraFrontier(t, to, func(xid, yid int64) (float64, bool) { return weightOf(yid, xid) })
+
+	best := math.Inf(1)
+	var meet graph.Node
+	for fwd.queue.Len() != 0 && rev.queue.Len() != 0 {
+		// Stale queue elements are never smaller than the
+		// live minimum, so this test is conservative.
+		if fwd.queue[0].dist+rev.queue[0].dist >= best {
+			break
+		}
+
+		// Expand the search with the smaller frontier.
+		cur, other := fwd, rev
+		if rev.queue.Len() < fwd.queue.Len() {
+			cur, other = rev, fwd
+		}
+		mid := heap.Pop(&cur.queue).(distanceNode)
+		mnid := mid.node.ID()
+		if mid.dist > cur.dist[mnid] {
+			continue
+		}
+		next := cur.next(mnid)
+		for next.Next() {
+			v := next.Node()
+			vid := v.ID()
+			w, ok := cur.weight(mnid, vid)
+			if !ok {
+				panic("dijkstra: unexpected invalid weight")
+			}
+			if w < 0 {
+				panic("dijkstra: negative edge weight")
+			}
+			joint := mid.dist + w
+			if d, ok := cur.dist[vid]; !ok || joint < d {
+				cur.dist[vid] = joint
+				cur.prev[vid] = mid.node
+				heap.Push(&cur.queue, distanceNode{node: v, dist: joint})
+			}
+			if d, ok := other.dist[vid]; ok && cur.dist[vid]+d < best {
+				best = cur.dist[vid] + d
+				meet = v
+			}
+		}
+	}
+	if meet == nil {
+		return nil, math.Inf(1)
+	}
+
+	for n := meet; n != nil; n = fwd.prev[n.ID()] {
+		path = append(path, n)
+	}
+	slices.Reverse(path)
+	for n := rev.prev[meet.ID()]; n != nil; n = rev.prev[n.ID()] {
+		path = append(path, n)
+	}
+	return path, best
+}
+
+// dijkstraFrontier is the state of one direction of a
+// bidirectional Dijkstra search.
+type dijkstraFrontier struct {
+	next   func(id int64) graph.Nodes
+	weight Weighting
+
+	dist  map[int64]float64
+	prev  map[int64]graph.Node
+	queue priorityQueue
+}
+
+func newDijkstraFrontier(u graph.Node, next func(id int64) graph.Nodes, weight Weighting) *dijkstraFrontier {
+	return &dijkstraFrontier{
+		next:   next,
+		weight: weight,
+		dist:   map[int64]float64{u.ID(): 0},
+		prev:   make(map[int64]graph.Node),
+		queue:  priorityQueue{{node: u, dist: 0}},
+	}
+}
+
 // DijkstraAllFrom returns a shortest-path tree for shortest paths from u to all nodes in
 // the graph g. If the graph does not implement Weighted, UniformCost is used.
 // DijkstraAllFrom will panic if g has a u-reachable negative edge weight.
@@ -206,42 +520,47 @@ func dijkstraAllPaths(g graph.Graph, paths AllShortest) {
 	}
 
 	var Q priorityQueue
-	for i, u := range paths.nodes {
-		// Dijkstra's algorithm here is implemented essentially as
-		// described in Function B.2 in figure 6 of UTCS Technical
-		// Report TR-07-54 with the addition of handling multiple
-		// co-equal paths.
-		//
-		// http://www.cs.utexas.edu/ftp/techreports/tr07-54.pdf
-
-		// Q must be empty at this point.
-		heap.Push(&Q, distanceNode{node: u, dist: 0})
-		for Q.Len() != 0 {
-			mid := heap.Pop(&Q).(distanceNode)
-			k := paths.indexOf[mid.node.ID()]
-			if mid.dist < paths.dist.At(i, k) {
-				paths.dist.Set(i, k, mid.dist)
+	for i := range paths.nodes {
+		dijkstraAllPathsFrom(i, g, weight, paths, &Q)
+	}
+}
+
+// dijkstraAllPathsFrom populates the row of paths for the node indexed by i
+// using Dijkstra's algorithm. Q must be empty and is empty on return.
+func dijkstraAllPathsFrom(i int, g graph.Graph, weight Weighting, paths AllShortest, Q *priorityQueue) {
+	// Dijkstra's algorithm here is implemented essentially as
+	// described in Function B.2 in figure 6 of UTCS Technical
+	// Report TR-07-54 with the addition of handling multiple
+	// co-equal paths.
+	//
+	// http://www.cs.utexas.edu/ftp/techreports/tr07-54.pdf
+
+	heap.Push(Q, distanceNode{node: paths.nodes[i], dist: 0})
+	for Q.Len() != 0 {
+		mid := heap.Pop(Q).(distanceNode)
+		k := paths.indexOf[mid.node.ID()]
+		if mid.dist < paths.dist.At(i, k) {
+			paths.dist.Set(i, k, mid.dist)
+		}
+		mnid := mid.node.ID()
+		to := g.From(mnid)
+		for to.Next() {
+			v := to.Node()
+			vid := v.ID()
+			j := paths.indexOf[vid]
+			w, ok := weight(mnid, vid)
+			if !ok {
+				panic("dijkstra: unexpected invalid weight")
 			}
-			mnid := mid.node.ID()
-			to := g.From(mnid)
-			for to.Next() {
-				v := to.Node()
-				vid := v.ID()
-				j := paths.indexOf[vid]
-				w, ok := weight(mnid, vid)
-				if !ok {
-					panic("dijkstra: unexpected invalid weight")
-				}
-				if w < 0 {
-					panic("dijkstra: negative edge weight")
-				}
-				joint := paths.dist.At(i, k) + w
-				if joint < paths.dist.At(i, j) {
-					heap.Push(&Q, distanceNode{node: v, dist: joint})
-					paths.set(i, j, joint, k)
-				} else if joint == paths.dist.At(i, j) {
-					paths.add(i, j, k)
-				}
+			if w < 0 {
+				panic("dijkstra: negative edge weight")
+			}
+			joint := paths.dist.At(i, k) + w
+			if joint < paths.dist.At(i, j) {
+				heap.Push(Q, distanceNode{node: v, dist: joint})
+				paths.set(i, j, joint, k)
+			} else if joint == paths.dist.At(i, j) {
+				paths.add(i, j, k)
 			}
 		}
 	}
@@ -6,7 +6,9 @@ package path
 
 import (
 	"math"
+	"math/rand/v2"
 	"reflect"
+	"slices"
 	"testing"
 
 	"gonum.org/v1/gonum/graph"
@@ -93,6 +95,211 @@ func TestDijkstraFrom(t *testing.T) {
 	}
 }
 
+func TestDijkstraBidirectional(t *testing.T) {
+	t.Parallel()
+	for _, test := range testgraphs.ShortestPathTests {
+		if test.HasNegativeWeight {
+			continue
+		}
+		g := test.Graph()
+		for _, e := range test.Edges {
+			g.SetWeightedEdge(e)
+		}
+
+		p, weight := DijkstraBidirectional(g.(graph.Graph), test.Query.From(), test.Query.To())
+		if weight != test.Weight {
+			t.Errorf("%q: unexpected weight: got:%f want:%f", test.Name, weight, test.Weight)
+		}
+
+		var got []int64
+		for _, n := range p {
+			got = append(got, n.ID())
+		}
+		ok := len(got) == 0 && len(test.WantPaths) == 0
+		for _, sp := range test.WantPaths {
+			if reflect.DeepEqual(got, sp) {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			t.Errorf("%q: unexpected shortest path:\ngot: %v\nwant from:%v", test.Name, p, test.WantPaths)
+		}
+
+		np, weight := DijkstraBidirectional(g.(graph.Graph), test.NoPathFor.From(), test.NoPathFor.To())
+		if np != nil || !math.IsInf(weight, 1) {
+			t.Errorf("%q: unexpected path:\ngot: path=%v weight=%f\nwant:path=<nil> weight=+Inf",
+				test.Name, np, weight)
+		}
+	}
+}
+
+func TestDijkstraFromWithin(t *testing.T) {
+	t.Parallel()
+	g := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+	for i := 1; i < 4; i++ {
+		g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(i - 1), T: simple.Node(i), W: 1})
+	}
+	g.AddNode(simple.Node(9))
+
+	pt := DijkstraFromWithin(simple.Node(0), g, 1.5)
+	for _, test := range []struct {
+		id     int64
+		weight float64
+		beyond bool
+	}{
+		{id: 0, weight: 0},
+		{id: 1, weight: 1},
+		{id: 2, weight: math.Inf(1), beyond: true},
+		{id: 3, weight: math.Inf(1)},
+		{id: 9, weight: math.Inf(1)},
+	} {
+		if w := pt.WeightTo(test.id); w != test.weight {
+			t.Errorf("unexpected weight to %d: got:%f want:%f", test.id, w, test.weight)
+		}
+		if b := pt.Beyond(test.id); b != test.beyond {
+			t.Errorf("unexpected beyond for %d: got:%t want:%t", test.id, b, test.beyond)
+		}
+	}
+	if p, _ := pt.To(2); p != nil {
+		t.Errorf("unexpected path to node beyond radius: %v", p)
+	}
+
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for n := 0; n < 20; n++ {
+		g := simple.NewWeightedDirectedGraph(0, math.Inf(1))
+		const size = 30
+		for i := 0; i < size; i++ {
+			g.AddNode(simple.Node(i))
+		}
+		for i := 0; i < size; i++ {
+			for j := 0; j < size; j++ {
+				if i != j && rnd.Float64() < 0.1 {
+					g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(i), T: simple.Node(j), W: rnd.Float64()})
+				}
+			}
+		}
+		const radius = 1
+		full := DijkstraFrom(simple.Node(0), g)
+		pt := DijkstraFromWithin(simple.Node(0), g, radius)
+		for _, v := range graph.NodesOf(g.Nodes()) {
+			want := full.WeightTo(v.ID())
+			got := pt.WeightTo(v.ID())
+			switch {
+			case want <= radius:
+				if got != want {
+					t.Errorf("unexpected weight to %d in graph %d: got:%f want:%f", v.ID(), n, got, want)
+				}
+			case !math.IsInf(got, 1):
+				t.Errorf("unexpected weight to %d beyond radius in graph %d: got:%f want:+Inf", v.ID(), n, got)
+			}
+			if pt.Beyond(v.ID()) && (want <= radius || math.IsInf(want, 1)) {
+				t.Errorf("unexpected beyond for %d in graph %d with weight %f", v.ID(), n, want)
+			}
+		}
+	}
+}
+
+func TestKNearest(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for n := 0; n < 20; n++ {
+		g := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+		const size = 20
+		for i := 0; i < size; i++ {
+			g.AddNode(simple.Node(i))
+		}
+		for i := 0; i < size; i++ {
+			for j := 0; j < i; j++ {
+				if rnd.Float64() < 0.15 {
+					g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(i), T: simple.Node(j), W: rnd.Float64()})
+				}
+			}
+		}
+
+		full := DijkstraFrom(simple.Node(0), g)
+		var want []float64
+		for _, v := range graph.NodesOf(g.Nodes()) {
+			if d := full.WeightTo(v.ID()); v.ID() != 0 && !math.IsInf(d, 1) {
+				want = append(want, d)
+			}
+		}
+		slices.Sort(want)
+
+		for _, k := range []int{0, 1, 5, size + 1} {
+			nodes, dist := KNearest(simple.Node(0), g, k)
+			if len(nodes) != len(dist) {
+				t.Fatalf("mismatched result lengths for graph %d k=%d: %d != %d", n, k, len(nodes), len(dist))
+			}
+			wantK := want[:min(k, len(want))]
+			if len(wantK) == 0 {
+				wantK = nil
+			}
+			if !reflect.DeepEqual(dist, wantK) {
+				t.Errorf("unexpected distances for graph %d k=%d:\ngot: %v\nwant:%v", n, k, dist, wantK)
+			}
+			for i, v := range nodes {
+				if d := full.WeightTo(v.ID()); d != dist[i] {
+					t.Errorf("unexpected distance to %d for graph %d k=%d: got:%f want:%f", v.ID(), n, k, dist[i], d)
+				}
+			}
+		}
+	}
+}
+
+func TestDijkstraFromSources(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for n := 0; n < 20; n++ {
+		var g graph.WeightedEdgeAdder
+		if n%2 == 0 {
+			g = simple.NewWeightedDirectedGraph(0, math.Inf(1))
+		} else {
+			g = simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+		}
+		const size = 20
+		for i := 0; i < size; i++ {
+			g.(graph.NodeAdder).AddNode(simple.Node(i))
+		}
+		for i := 0; i < size; i++ {
+			for j := 0; j < size; j++ {
+				if i != j && rnd.Float64() < 0.1 {
+					g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(i), T: simple.Node(j), W: rnd.Float64()})
+				}
+			}
+		}
+		gg := g.(graph.Graph)
+		sources := []graph.Node{simple.Node(0), simple.Node(5), simple.Node(10), simple.Node(100)}
+
+		nearest, dist := DijkstraFromSources(sources, gg)
+
+		want := make(map[int64]float64)
+		for _, s := range sources[:3] {
+			pt := DijkstraFrom(s, gg)
+			for _, v := range graph.NodesOf(gg.Nodes()) {
+				d := pt.WeightTo(v.ID())
+				if math.IsInf(d, 1) {
+					continue
+				}
+				if w, ok := want[v.ID()]; !ok || d < w {
+					want[v.ID()] = d
+				}
+			}
+		}
+		if !reflect.DeepEqual(dist, want) {
+			t.Errorf("unexpected distances for graph %d:\ngot: %v\nwant:%v", n, dist, want)
+		}
+		for vid, sid := range nearest {
+			if d := DijkstraFrom(simple.Node(sid), gg).WeightTo(vid); d != dist[vid] {
+				t.Errorf("unexpected nearest source for %d in graph %d: source %d at %f, want distance %f", vid, n, sid, d, dist[vid])
+			}
+		}
+		if len(nearest) != len(dist) {
+			t.Errorf("mismatched result lengths for graph %d: %d != %d", n, len(nearest), len(dist))
+		}
+	}
+}
+
 func TestDijkstraAllFrom(t *testing.T) {
 	t.Parallel()
 	for _, test := range testgraphs.ShortestPathTests {
@@ -0,0 +1,358 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package path
+
+import (
+	"cmp"
+	"container/heap"
+	"math"
+	"slices"
+
+	"gonum.org/v1/gonum/graph"
+)
+
+// KEdgeDisjointShortestPaths returns up to k paths from s to t in g that share
+// no edges. If k is negative, all paths that can be found are returned. Paths
+// are found by successively finding the shortest path from s to t and removing
+// its edges from the graph, so the returned paths are in order of increasing
+// weight. If fewer than k edge-disjoint paths can be found, the paths that were
+// found are returned.
+//
+// Since the paths are found greedily, the total weight of the returned paths
+// is not guaranteed to be minimal and, in some graphs, a greedy choice may
+// prevent finding the maximum number of edge-disjoint paths.
+// KEdgeDisjointShortestPaths will panic if g contains a negative edge weight.
+func KEdgeDisjointShortestPaths(g graph.Graph, k int, s, t graph.Node) [][]graph.Node {
+	_, isDirected := g.(graph.Directed)
+	yk := yenKSPAdjuster{
+		Graph:      g,
+		isDirected: isDirected,
+	}
+	if wg, ok := g.(Weighted); ok {
+		yk.weight = wg.Weight
+	} else {
+		yk.weight = UniformCost(g)
+	}
+	yk.reset()
+
+	var paths [][]graph.Node
+	for k < 0 || len(paths) < k {
+		p, _ := DijkstraFromTo(s, t, yk)
+		if len(p) == 0 {
+			break
+		}
+		paths = append(paths, p)
+		if len(p) == 1 {
+			// The trivial path from s to itself
+			// has no edges to remove.
+			break
+		}
+		for i, u := range p[:len(p)-1] {
+			yk.removeEdge(u.ID(), p[i+1].ID())
+		}
+	}
+	return paths
+}
+
+// KNodeDisjointShortestPaths returns up to k paths from s to t in g that share
+// no nodes other than s and t, with the minimum total weight over all sets of
+// that many node-disjoint paths. If k is negative, the maximum number of
+// node-disjoint paths is returned. The returned paths are sorted by increasing
+// weight. If s and t are adjacent, the edge joining them is a valid path and
+// is returned as one of the disjoint paths. If s and t are the same node, the
+// single path holding only s is returned.
+//
+// The paths are found by splitting each node other than s and t into an in
+// and an out node joined by an edge of unit capacity, and finding a minimum
+// cost flow from s to t in the resulting network by successive shortest paths.
+// KNodeDisjointShortestPaths will panic if g contains a negative edge weight.
+func KNodeDisjointShortestPaths(g graph.Graph, k int, s, t graph.Node) [][]graph.Node {
+	paths, _ := minCostDisjointPaths(g, k, s, t, true)
+	return paths
+}
+
+// Suurballe returns a pair of paths from s to t in g that share no nodes other
+// than s and t with the minimum total weight, and the total weight of the pair.
+// If two such paths do not exist, Suurballe returns nil, +Inf and false. The
+// returned paths are sorted by increasing weight. If s and t are adjacent, the
+// edge joining them is a valid path. If s and t are the same node, no pair of
+// paths exists.
+//
+// The second path is found using Dijkstra's algorithm with costs reduced by
+// the shortest path distances found for the first path, so the total weight is
+// optimal, unlike removing the edges of the shortest path and searching again.
+// Suurballe will panic if g contains a negative edge weight.
+func Suurballe(g graph.Graph, s, t graph.Node) (paths [][]graph.Node, weight float64, ok bool) {
+	return suurballe(g, s, t, true)
+}
+
+// SuurballeEdgeDisjoint returns a pair of paths from s to t in g that share no
+// edges with the minimum total weight, and the total weight of the pair. The
+// paths may share nodes. If two such paths do not exist, SuurballeEdgeDisjoint
+// returns nil, +Inf and false. The returned paths are sorted by increasing
+// weight. SuurballeEdgeDisjoint will panic if g contains a negative edge weight.
+func SuurballeEdgeDisjoint(g graph.Graph, s, t graph.Node) (paths [][]graph.Node, weight float64, ok bool) {
+	return suurballe(g, s, t, false)
+}
+
+func suurballe(g graph.Graph, s, t graph.Node, nodeDisjoint bool) (paths [][]graph.Node, weight float64, ok bool) {
+	if s.ID() == t.ID() {
+		return nil, math.Inf(1), false
+	}
+	paths, weights := minCostDisjointPaths(g, 2, s, t, nodeDisjoint)
+	if len(paths) != 2 {
+		return nil, math.Inf(1), false
+	}
+	return paths, weights[0] + weights[1], true
+}
+
+// minCostDisjointPaths returns up to k paths from s to t in g with minimal
+// total weight that share no edges, or no nodes other than s and t if
+// nodeDisjoint is true, and the weights of the paths. If k is negative the
+// maximum number of disjoint paths is returned. The paths are sorted by
+// increasing weight.
+func minCostDisjointPaths(g graph.Graph, k int, s, t graph.Node, nodeDisjoint bool) (paths [][]graph.Node, weights []float64) {
+	sid := s.ID()
+	tid := t.ID()
+	if g.Node(sid) == nil || g.Node(tid) == nil {
+		return nil, nil
+	}
+	if sid == tid {
+		return [][]graph.Node{{g.Node(sid)}}, []float64{0}
+	}
+
+	var weight Weighting
+	if wg, ok := g.(Weighted); ok {
+		weight = wg.Weight
+	} else {
+		weight = UniformCost(g)
+	}
+
+	nodes := graph.NodesOf(g.Nodes())
+	indexOf := make(map[int64]int, len(nodes))
+	for i, n := range nodes {
+		indexOf[n.ID()] = i
+	}
+
+	// Construct the flow network. When node disjoint
+	// paths are required, the in node of the node
+	// indexed by i in nodes is 2i and the out node is
+	// 2i+1, otherwise both are i.
+	in := func(i int) int { return i }
+	out := func(i int) int { return i }
+	n := len(nodes)
+	if nodeDisjoint {
+		in = func(i int) int { return 2 * i }
+		out = func(i int) int { return 2*i + 1 }
+		n *= 2
+	}
+	f := newFlowNetwork(n)
+	if nodeDisjoint {
+		for i := range nodes {
+			f.addArc(in(i), out(i), 1, 0)
+		}
+	}
+	for i, u := range nodes {
+		uid := u.ID()
+		if uid == tid {
+			continue
+		}
+		to := g.From(uid)
+		for to.Next() {
+			vid := to.Node().ID()
+			if vid == sid {
+				continue
+			}
+			w, ok := weight(uid, vid)
+			if !ok {
+				panic("path: unexpected invalid weight")
+			}
+			if w < 0 {
+				panic("path: negative edge weight")
+			}
+			f.addArc(out(i), in(indexOf[vid]), 1, w)
+		}
+	}
+
+	src := out(indexOf[sid])
+	dst := in(indexOf[tid])
+	for k < 0 || f.flow < k {
+		if !f.augment(src, dst) {
+			break
+		}
+	}
+
+	// Decompose the flow into paths. Flow in both
+	// directions between a pair of nodes forms a
+	// zero weight cycle and is cancelled.
+	var flowed [][2]int
+	opposed := make(map[[2]int]int)
+	for i := 0; i < len(f.arcs); i += 2 {
+		a := f.arcs[i]
+		if a.cap != 0 || (nodeDisjoint && a.from/2 == a.to/2) {
+			// Skip arcs with no flow and the arcs
+			// joining split nodes.
+			continue
+		}
+		u, v := a.from, a.to
+		if nodeDisjoint {
+			u /= 2
+			v /= 2
+		}
+		if opposed[[2]int{v, u}] != 0 {
+			opposed[[2]int{v, u}]--
+			continue
+		}
+		opposed[[2]int{u, v}]++
+		flowed = append(flowed, [2]int{u, v})
+	}
+	used := make([][]int, len(nodes))
+	for _, e := range flowed {
+		if opposed[e] == 0 {
+			continue
+		}
+		opposed[e]--
+		used[e[0]] = append(used[e[0]], e[1])
+	}
+	si := indexOf[sid]
+	ti := indexOf[tid]
+	var found []yenShortest
+	for len(used[si]) != 0 {
+		path := []graph.Node{nodes[si]}
+		seen := map[int]int{si: 0}
+		for u := si; u != ti; {
+			v := used[u][len(used[u])-1]
+			used[u] = used[u][:len(used[u])-1]
+			if i, ok := seen[v]; ok {
+				// Remove any zero weight
+				// cycle from the path.
+				for _, n := range path[i+1:] {
+					delete(seen, indexOf[n.ID()])
+				}
+				path = path[:i+1]
+			} else {
+				seen[v] = len(path)
+				path = append(path, nodes[v])
+			}
+			u = v
+		}
+		var w float64
+		for i, u := range path[:len(path)-1] {
+			e, _ := weight(u.ID(), path[i+1].ID())
+			w += e
+		}
+		found = append(found, yenShortest{path: path, weight: w})
+	}
+	slices.SortStableFunc(found, func(a, b yenShortest) int {
+		return cmp.Compare(a.weight, b.weight)
+	})
+	for _, p := range found {
+		paths = append(paths, p.path)
+		weights = append(weights, p.weight)
+	}
+
+	return paths, weights
+}
+
+// flowNetwork is a residual flow network for finding minimum cost flows
+// by successive shortest paths.
+type flowNetwork struct {
+	// arcs holds the arcs of the network.
+	// The arc at index i^1 is the reverse
+	// of the arc at index i.
+	arcs []flowArc
+
+	// adj holds the indexes into arcs of
+	// the arcs leaving each node.
+	adj [][]int
+
+	// potential holds the node potentials
+	// used to keep reduced costs non-negative.
+	potential []float64
+
+	// flow is the current flow value.
+	flow int
+}
+
+// flowArc is an arc in a residual flow network.
+type flowArc struct {
+	from, to int
+	cap      int
+	cost     float64
+}
+
+// newFlowNetwork returns a new flow network with n nodes.
+func newFlowNetwork(n int) *flowNetwork {
+	return &flowNetwork{
+		adj:       make([][]int, n),
+		potential: make([]float64, n),
+	}
+}
+
+// addArc adds an arc from u to v with the given capacity and cost
+// and its zero capacity reverse arc.
+func (f *flowNetwork) addArc(u, v, cap int, cost float64) {
+	f.adj[u] = append(f.adj[u], len(f.arcs))
+	f.arcs = append(f.arcs, flowArc{from: u, to: v, cap: cap, cost: cost})
+	f.adj[v] = append(f.adj[v], len(f.arcs))
+	f.arcs = append(f.arcs, flowArc{from: v, to: u, cap: 0, cost: -cost})
+}
+
+// augment pushes one unit of flow along a shortest path from s to t in
+// the residual network, returning whether such a path exists. The costs
+// of arcs must be non-negative before the first call to augment.
+func (f *flowNetwork) augment(s, t int) bool {
+	dist := make([]float64, len(f.adj))
+	for i := range dist {
+		dist[i] = math.Inf(1)
+	}
+	prev := make([]int, len(f.adj))
+	for i := range prev {
+		prev[i] = -1
+	}
+	dist[s] = 0
+
+	Q := priorityQueue{{node: node(s), dist: 0}}
+	for Q.Len() != 0 {
+		mid := heap.Pop(&Q).(distanceNode)
+		u := int(mid.node.ID())
+		if mid.dist > dist[u] {
+			continue
+		}
+		for _, i := range f.adj[u] {
+			a := f.arcs[i]
+			if a.cap == 0 {
+				continue
+			}
+			// Clamp the reduced cost to avoid
+			// negative costs due to rounding.
+			rc := math.Max(0, a.cost+f.potential[u]-f.potential[a.to])
+			joint := dist[u] + rc
+			if joint < dist[a.to] {
+				dist[a.to] = joint
+				prev[a.to] = i
+				heap.Push(&Q, distanceNode{node: node(a.to), dist: joint})
+			}
+		}
+	}
+	if math.IsInf(dist[t], 1) {
+		return false
+	}
+
+	for i, d := range dist {
+		if !math.IsInf(d, 1) {
+			f.potential[i] += d
+		}
+	}
+	for v := t; v != s; {
+		i := prev[v]
+		f.arcs[i].cap--
+		f.arcs[i^1].cap++
+		v = f.arcs[i].from
+	}
+	f.flow++
+
+	return true
+}
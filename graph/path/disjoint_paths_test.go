@@ -0,0 +1,402 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package path
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+var kEdgeDisjointShortestPathsTests = []struct {
+	name  string
+	graph func() graph.WeightedEdgeAdder
+	edges []simple.WeightedEdge
+
+	query     simple.Edge
+	k         int
+	wantPaths [][]int64
+}{
+	{
+		name:      "empty graph",
+		graph:     func() graph.WeightedEdgeAdder { return simple.NewWeightedDirectedGraph(0, math.Inf(1)) },
+		query:     simple.Edge{F: simple.Node(0), T: simple.Node(1)},
+		k:         2,
+		wantPaths: nil,
+	},
+	{
+		name:  "diamond",
+		graph: func() graph.WeightedEdgeAdder { return simple.NewWeightedDirectedGraph(0, math.Inf(1)) },
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 1},
+			{F: simple.Node(1), T: simple.Node(3), W: 1},
+			{F: simple.Node(0), T: simple.Node(2), W: 2},
+			{F: simple.Node(2), T: simple.Node(3), W: 2},
+		},
+		query: simple.Edge{F: simple.Node(0), T: simple.Node(3)},
+		k:     3,
+		wantPaths: [][]int64{
+			{0, 1, 3},
+			{0, 2, 3},
+		},
+	},
+	{
+		name:  "diamond limited",
+		graph: func() graph.WeightedEdgeAdder { return simple.NewWeightedDirectedGraph(0, math.Inf(1)) },
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 1},
+			{F: simple.Node(1), T: simple.Node(3), W: 1},
+			{F: simple.Node(0), T: simple.Node(2), W: 2},
+			{F: simple.Node(2), T: simple.Node(3), W: 2},
+		},
+		query: simple.Edge{F: simple.Node(0), T: simple.Node(3)},
+		k:     1,
+		wantPaths: [][]int64{
+			{0, 1, 3},
+		},
+	},
+	{
+		name:  "shared bridge",
+		graph: func() graph.WeightedEdgeAdder { return simple.NewWeightedUndirectedGraph(0, math.Inf(1)) },
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 1},
+			{F: simple.Node(0), T: simple.Node(2), W: 1},
+			{F: simple.Node(1), T: simple.Node(3), W: 1},
+			{F: simple.Node(2), T: simple.Node(3), W: 2},
+			{F: simple.Node(3), T: simple.Node(4), W: 1},
+		},
+		query: simple.Edge{F: simple.Node(0), T: simple.Node(4)},
+		k:     -1,
+		wantPaths: [][]int64{
+			{0, 1, 3, 4},
+		},
+	},
+	{
+		name:  "node shared",
+		graph: func() graph.WeightedEdgeAdder { return simple.NewWeightedUndirectedGraph(0, math.Inf(1)) },
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 1},
+			{F: simple.Node(0), T: simple.Node(2), W: 2},
+			{F: simple.Node(1), T: simple.Node(3), W: 1},
+			{F: simple.Node(2), T: simple.Node(3), W: 2},
+			{F: simple.Node(3), T: simple.Node(4), W: 1},
+			{F: simple.Node(3), T: simple.Node(5), W: 2},
+			{F: simple.Node(4), T: simple.Node(6), W: 1},
+			{F: simple.Node(5), T: simple.Node(6), W: 2},
+		},
+		query: simple.Edge{F: simple.Node(0), T: simple.Node(6)},
+		k:     -1,
+		wantPaths: [][]int64{
+			{0, 1, 3, 4, 6},
+			{0, 2, 3, 5, 6},
+		},
+	},
+	{
+		name:  "source is target",
+		graph: func() graph.WeightedEdgeAdder { return simple.NewWeightedUndirectedGraph(0, math.Inf(1)) },
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 1},
+		},
+		query: simple.Edge{F: simple.Node(0), T: simple.Node(0)},
+		k:     -1,
+		wantPaths: [][]int64{
+			{0},
+		},
+	},
+}
+
+func TestKEdgeDisjointShortestPaths(t *testing.T) {
+	t.Parallel()
+	for _, test := range kEdgeDisjointShortestPathsTests {
+		g := test.graph()
+		for _, e := range test.edges {
+			g.SetWeightedEdge(e)
+		}
+
+		got := KEdgeDisjointShortestPaths(g.(graph.Graph), test.k, test.query.From(), test.query.To())
+		gotIDs := pathIDs(got)
+		if !reflect.DeepEqual(gotIDs, test.wantPaths) {
+			t.Errorf("unexpected result for %q:\ngot: %v\nwant:%v", test.name, gotIDs, test.wantPaths)
+		}
+	}
+}
+
+var kNodeDisjointShortestPathsTests = []struct {
+	name  string
+	graph func() graph.WeightedEdgeAdder
+	edges []simple.WeightedEdge
+
+	query     simple.Edge
+	k         int
+	wantPaths [][]int64
+}{
+	{
+		name:      "empty graph",
+		graph:     func() graph.WeightedEdgeAdder { return simple.NewWeightedDirectedGraph(0, math.Inf(1)) },
+		query:     simple.Edge{F: simple.Node(0), T: simple.Node(1)},
+		k:         2,
+		wantPaths: nil,
+	},
+	{
+		name:  "adjacent",
+		graph: func() graph.WeightedEdgeAdder { return simple.NewWeightedUndirectedGraph(0, math.Inf(1)) },
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 5},
+			{F: simple.Node(0), T: simple.Node(2), W: 1},
+			{F: simple.Node(2), T: simple.Node(1), W: 1},
+		},
+		query: simple.Edge{F: simple.Node(0), T: simple.Node(1)},
+		k:     -1,
+		wantPaths: [][]int64{
+			{0, 2, 1},
+			{0, 1},
+		},
+	},
+	{
+		name:  "node shared",
+		graph: func() graph.WeightedEdgeAdder { return simple.NewWeightedUndirectedGraph(0, math.Inf(1)) },
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 1},
+			{F: simple.Node(0), T: simple.Node(2), W: 2},
+			{F: simple.Node(1), T: simple.Node(3), W: 1},
+			{F: simple.Node(2), T: simple.Node(3), W: 2},
+			{F: simple.Node(3), T: simple.Node(4), W: 1},
+			{F: simple.Node(3), T: simple.Node(5), W: 2},
+			{F: simple.Node(4), T: simple.Node(6), W: 1},
+			{F: simple.Node(5), T: simple.Node(6), W: 2},
+		},
+		query: simple.Edge{F: simple.Node(0), T: simple.Node(6)},
+		k:     -1,
+		wantPaths: [][]int64{
+			{0, 1, 3, 4, 6},
+		},
+	},
+	{
+		// The greedy shortest path 0-1-2-3 blocks
+		// all other paths, but two node-disjoint
+		// paths exist.
+		name:  "trap",
+		graph: func() graph.WeightedEdgeAdder { return simple.NewWeightedDirectedGraph(0, math.Inf(1)) },
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 1},
+			{F: simple.Node(1), T: simple.Node(2), W: 1},
+			{F: simple.Node(2), T: simple.Node(3), W: 1},
+			{F: simple.Node(0), T: simple.Node(2), W: 3},
+			{F: simple.Node(1), T: simple.Node(3), W: 4},
+		},
+		query: simple.Edge{F: simple.Node(0), T: simple.Node(3)},
+		k:     2,
+		wantPaths: [][]int64{
+			{0, 2, 3},
+			{0, 1, 3},
+		},
+	},
+	{
+		name:  "trap limited",
+		graph: func() graph.WeightedEdgeAdder { return simple.NewWeightedDirectedGraph(0, math.Inf(1)) },
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 1},
+			{F: simple.Node(1), T: simple.Node(2), W: 1},
+			{F: simple.Node(2), T: simple.Node(3), W: 1},
+			{F: simple.Node(0), T: simple.Node(2), W: 3},
+			{F: simple.Node(1), T: simple.Node(3), W: 4},
+		},
+		query: simple.Edge{F: simple.Node(0), T: simple.Node(3)},
+		k:     1,
+		wantPaths: [][]int64{
+			{0, 1, 2, 3},
+		},
+	},
+	{
+		name:  "source is target",
+		graph: func() graph.WeightedEdgeAdder { return simple.NewWeightedUndirectedGraph(0, math.Inf(1)) },
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 1},
+		},
+		query: simple.Edge{F: simple.Node(0), T: simple.Node(0)},
+		k:     -1,
+		wantPaths: [][]int64{
+			{0},
+		},
+	},
+}
+
+func TestKNodeDisjointShortestPaths(t *testing.T) {
+	t.Parallel()
+	for _, test := range kNodeDisjointShortestPathsTests {
+		g := test.graph()
+		for _, e := range test.edges {
+			g.SetWeightedEdge(e)
+		}
+
+		got := KNodeDisjointShortestPaths(g.(graph.Graph), test.k, test.query.From(), test.query.To())
+		gotIDs := pathIDs(got)
+		if !reflect.DeepEqual(gotIDs, test.wantPaths) {
+			t.Errorf("unexpected result for %q:\ngot: %v\nwant:%v", test.name, gotIDs, test.wantPaths)
+		}
+	}
+}
+
+var suurballeTests = []struct {
+	name  string
+	graph func() graph.WeightedEdgeAdder
+	edges []simple.WeightedEdge
+
+	query        simple.Edge
+	nodeDisjoint bool
+	wantPaths    [][]int64
+	wantWeight   float64
+	wantOK       bool
+}{
+	{
+		// Removing the shortest path leaves no path,
+		// but two disjoint paths exist.
+		name:  "trap",
+		graph: func() graph.WeightedEdgeAdder { return simple.NewWeightedDirectedGraph(0, math.Inf(1)) },
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 1},
+			{F: simple.Node(1), T: simple.Node(2), W: 1},
+			{F: simple.Node(2), T: simple.Node(3), W: 1},
+			{F: simple.Node(0), T: simple.Node(2), W: 3},
+			{F: simple.Node(1), T: simple.Node(3), W: 4},
+		},
+		query:        simple.Edge{F: simple.Node(0), T: simple.Node(3)},
+		nodeDisjoint: true,
+		wantPaths:    [][]int64{{0, 2, 3}, {0, 1, 3}},
+		wantWeight:   9,
+		wantOK:       true,
+	},
+	{
+		name:  "trap undirected",
+		graph: func() graph.WeightedEdgeAdder { return simple.NewWeightedUndirectedGraph(0, math.Inf(1)) },
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 1},
+			{F: simple.Node(1), T: simple.Node(2), W: 1},
+			{F: simple.Node(2), T: simple.Node(3), W: 1},
+			{F: simple.Node(0), T: simple.Node(2), W: 3},
+			{F: simple.Node(1), T: simple.Node(3), W: 4},
+		},
+		query:        simple.Edge{F: simple.Node(0), T: simple.Node(3)},
+		nodeDisjoint: false,
+		wantPaths:    [][]int64{{0, 2, 3}, {0, 1, 3}},
+		wantWeight:   9,
+		wantOK:       true,
+	},
+	{
+		name:  "shared cut node",
+		graph: func() graph.WeightedEdgeAdder { return simple.NewWeightedDirectedGraph(0, math.Inf(1)) },
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 1},
+			{F: simple.Node(0), T: simple.Node(2), W: 2},
+			{F: simple.Node(1), T: simple.Node(3), W: 1},
+			{F: simple.Node(2), T: simple.Node(3), W: 2},
+			{F: simple.Node(3), T: simple.Node(4), W: 1},
+			{F: simple.Node(3), T: simple.Node(5), W: 2},
+			{F: simple.Node(4), T: simple.Node(6), W: 1},
+			{F: simple.Node(5), T: simple.Node(6), W: 2},
+		},
+		query:        simple.Edge{F: simple.Node(0), T: simple.Node(6)},
+		nodeDisjoint: true,
+		wantWeight:   math.Inf(1),
+		wantOK:       false,
+	},
+	{
+		name:  "shared cut node edge disjoint",
+		graph: func() graph.WeightedEdgeAdder { return simple.NewWeightedDirectedGraph(0, math.Inf(1)) },
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 1},
+			{F: simple.Node(0), T: simple.Node(2), W: 2},
+			{F: simple.Node(1), T: simple.Node(3), W: 1},
+			{F: simple.Node(2), T: simple.Node(3), W: 2},
+			{F: simple.Node(3), T: simple.Node(4), W: 1},
+			{F: simple.Node(3), T: simple.Node(5), W: 2},
+			{F: simple.Node(4), T: simple.Node(6), W: 1},
+			{F: simple.Node(5), T: simple.Node(6), W: 2},
+		},
+		query:        simple.Edge{F: simple.Node(0), T: simple.Node(6)},
+		nodeDisjoint: false,
+		wantWeight:   12,
+		wantOK:       true,
+	},
+	{
+		name:  "adjacent",
+		graph: func() graph.WeightedEdgeAdder { return simple.NewWeightedDirectedGraph(0, math.Inf(1)) },
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 5},
+			{F: simple.Node(0), T: simple.Node(2), W: 1},
+			{F: simple.Node(2), T: simple.Node(1), W: 1},
+		},
+		query:        simple.Edge{F: simple.Node(0), T: simple.Node(1)},
+		nodeDisjoint: true,
+		wantPaths:    [][]int64{{0, 2, 1}, {0, 1}},
+		wantWeight:   7,
+		wantOK:       true,
+	},
+	{
+		name:  "source is target",
+		graph: func() graph.WeightedEdgeAdder { return simple.NewWeightedDirectedGraph(0, math.Inf(1)) },
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 1},
+		},
+		query:        simple.Edge{F: simple.Node(0), T: simple.Node(0)},
+		nodeDisjoint: true,
+		wantWeight:   math.Inf(1),
+		wantOK:       false,
+	},
+}
+
+func TestSuurballe(t *testing.T) {
+	t.Parallel()
+	for _, test := range suurballeTests {
+		g := test.graph()
+		for _, e := range test.edges {
+			g.SetWeightedEdge(e)
+		}
+
+		var (
+			got    [][]graph.Node
+			weight float64
+			ok     bool
+		)
+		if test.nodeDisjoint {
+			got, weight, ok = Suurballe(g.(graph.Graph), test.query.From(), test.query.To())
+		} else {
+			got, weight, ok = SuurballeEdgeDisjoint(g.(graph.Graph), test.query.From(), test.query.To())
+		}
+		if ok != test.wantOK {
+			t.Errorf("unexpected ok for %q: got:%t want:%t", test.name, ok, test.wantOK)
+		}
+		if weight != test.wantWeight {
+			t.Errorf("unexpected weight for %q: got:%v want:%v", test.name, weight, test.wantWeight)
+		}
+		if test.wantPaths != nil {
+			gotIDs := pathIDs(got)
+			if !reflect.DeepEqual(gotIDs, test.wantPaths) {
+				t.Errorf("unexpected result for %q:\ngot: %v\nwant:%v", test.name, gotIDs, test.wantPaths)
+			}
+		}
+		if ok && len(got) != 2 {
+			t.Errorf("unexpected number of paths for %q: got:%d want:2", test.name, len(got))
+		}
+		var sum float64
+		seen := make(map[[2]int64]bool)
+		for _, p := range got {
+			sum += pathWeight(p, g.(graph.Weighted))
+			for i := 1; i < len(p); i++ {
+				e := [2]int64{p[i-1].ID(), p[i].ID()}
+				if seen[e] {
+					t.Errorf("shared edge %v in paths for %q", e, test.name)
+				}
+				seen[e] = true
+			}
+		}
+		if ok && sum != weight {
+			t.Errorf("mismatched weight for %q: got:%v want:%v", test.name, weight, sum)
+		}
+	}
+}
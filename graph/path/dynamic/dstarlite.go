@@ -17,6 +17,12 @@ import (
 // DStarLite implements the D* Lite dynamic re-planning path search algorithm.
 //
 //	doi:10.1109/tro.2004.838026 and ISBN:0-262-51129-0 pp476-483
+//
+// After edge weights change, D* Lite re-expands only the nodes whose path cost
+// to the goal is affected by the change. When few edges change between
+// replans, and the changes are near the current location, this is typically a
+// small fraction of the nodes expanded by a new A* search from the current
+// location; in the worst case a replan costs as much as a new search.
 type DStarLite struct {
 	s, t *dStarLiteNode
 	last *dStarLiteNode
@@ -313,31 +319,57 @@ func (d *DStarLite) UpdateWorld(changes []graph.Edge) {
 		to := e.To()
 		tid := to.ID()
 		c, _ := d.weight(fid, tid)
-		if c < 0 {
-			panic("D* Lite: negative edge weight")
+		d.updateEdge(d.worldNodeFor(from), d.worldNodeFor(to), c)
+	}
+	d.findShortestPath()
+}
+
+// UpdateEdge sets the weight of the edge from u to v in the world graph to w
+// and repairs the path to the goal. Unlike UpdateWorld, the weight is taken
+// from w rather than from the graph given to NewDStarLite, so an obstacle may
+// be recorded by setting w to +Inf. Both u and v must already be in the world
+// graph. When several edges change between moves, UpdateWorld should be used
+// to repair the path once for all the changes. UpdateEdge will panic if w is
+// negative or if u or v is not in the world graph.
+func (d *DStarLite) UpdateEdge(uid, vid int64, w float64) {
+	u, ok := d.model.Node(uid).(*dStarLiteNode)
+	if !ok {
+		panic("D* Lite: unknown node")
+	}
+	v, ok := d.model.Node(vid).(*dStarLiteNode)
+	if !ok {
+		panic("D* Lite: unknown node")
+	}
+	d.keyModifier += d.heuristic(d.last, d.s)
+	d.last = d.s
+	d.updateEdge(u, v, w)
+	d.findShortestPath()
+}
+
+// updateEdge sets the weight of the edge from u to v in the world model
+// to c and updates the rhs value of u.
+func (d *DStarLite) updateEdge(u, v *dStarLiteNode, c float64) {
+	if c < 0 {
+		panic("D* Lite: negative edge weight")
+	}
+	uid := u.ID()
+	cOld, _ := d.model.Weight(uid, v.ID())
+	d.model.SetWeightedEdge(simple.WeightedEdge{F: u, T: v, W: c})
+	if cOld > c {
+		if uid != d.t.ID() {
+			u.rhs = math.Min(u.rhs, c+v.g)
 		}
-		cOld, _ := d.model.Weight(fid, tid)
-		u := d.worldNodeFor(from)
-		v := d.worldNodeFor(to)
-		d.model.SetWeightedEdge(simple.WeightedEdge{F: u, T: v, W: c})
-		uid := u.ID()
-		if cOld > c {
-			if uid != d.t.ID() {
-				u.rhs = math.Min(u.rhs, c+v.g)
-			}
-		} else if u.rhs == cOld+v.g {
-			if uid != d.t.ID() {
-				u.rhs = math.Inf(1)
-				to := d.model.From(uid)
-				for to.Next() {
-					t := to.Node()
-					u.rhs = math.Min(u.rhs, edgeWeight(d.model.Weight, uid, t.ID())+t.(*dStarLiteNode).g)
-				}
+	} else if u.rhs == cOld+v.g {
+		if uid != d.t.ID() {
+			u.rhs = math.Inf(1)
+			to := d.model.From(uid)
+			for to.Next() {
+				t := to.Node()
+				u.rhs = math.Min(u.rhs, edgeWeight(d.model.Weight, uid, t.ID())+t.(*dStarLiteNode).g)
 			}
 		}
-		d.update(u)
 	}
-	d.findShortestPath()
+	d.update(u)
 }
 
 func (d *DStarLite) worldNodeFor(n graph.Node) *dStarLiteNode {
@@ -683,3 +683,53 @@ func simpleWeightedEdgesOf(g graph.Weighted, edges []graph.Edge) []simple.Weight
 	}
 	return w
 }
+
+func TestDStarLiteUpdateEdge(t *testing.T) {
+	t.Parallel()
+	g := simple.NewWeightedDirectedGraph(0, math.Inf(1))
+	for _, e := range []simple.WeightedEdge{
+		{F: simple.Node(0), T: simple.Node(1), W: 1},
+		{F: simple.Node(1), T: simple.Node(3), W: 1},
+		{F: simple.Node(0), T: simple.Node(2), W: 2},
+		{F: simple.Node(2), T: simple.Node(3), W: 2},
+	} {
+		g.SetWeightedEdge(e)
+	}
+	d := NewDStarLite(simple.Node(0), simple.Node(3), g, nil, simple.NewWeightedDirectedGraph(0, math.Inf(1)))
+
+	for _, test := range []struct {
+		name       string
+		update     simple.WeightedEdge
+		wantPath   []int64
+		wantWeight float64
+	}{
+		{name: "initial", wantPath: []int64{0, 1, 3}, wantWeight: 2},
+		{name: "blocked", update: simple.WeightedEdge{F: simple.Node(1), T: simple.Node(3), W: math.Inf(1)}, wantPath: []int64{0, 2, 3}, wantWeight: 4},
+		{name: "increased", update: simple.WeightedEdge{F: simple.Node(2), T: simple.Node(3), W: 5}, wantPath: []int64{0, 2, 3}, wantWeight: 7},
+		{name: "unblocked", update: simple.WeightedEdge{F: simple.Node(1), T: simple.Node(3), W: 0.5}, wantPath: []int64{0, 1, 3}, wantWeight: 1.5},
+	} {
+		if test.update.F != nil {
+			d.UpdateEdge(test.update.From().ID(), test.update.To().ID(), test.update.Weight())
+		}
+		p, weight := d.Path()
+		var got []int64
+		for _, n := range p {
+			got = append(got, n.ID())
+		}
+		if !reflect.DeepEqual(got, test.wantPath) {
+			t.Errorf("%s: unexpected path: got:%v want:%v", test.name, got, test.wantPath)
+		}
+		if weight != test.wantWeight {
+			t.Errorf("%s: unexpected weight: got:%v want:%v", test.name, weight, test.wantWeight)
+		}
+	}
+
+	panicked := func() (panicked bool) {
+		defer func() { panicked = recover() != nil }()
+		d.UpdateEdge(0, 4, 1)
+		return false
+	}()
+	if !panicked {
+		t.Error("expected panic for edge to unknown node")
+	}
+}
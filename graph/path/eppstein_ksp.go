@@ -0,0 +1,252 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package path
+
+import (
+	"container/heap"
+
+	"gonum.org/v1/gonum/graph"
+)
+
+// EppsteinKShortestPaths returns the k-shortest walks from s to t in g and
+// their weights. Unlike YenKShortestPaths, the returned walks may include
+// cycles, so nodes may be visited more than once. The walks are returned in
+// order of non-decreasing weight. If k is not positive, no walk is returned.
+// If the graph does not implement Weighted, UniformCost is used.
+// EppsteinKShortestPaths will panic if g contains a t-reachable negative edge
+// weight.
+//
+// The implementation represents each walk as a sequence of sidetrack edges
+// leaving the shortest-path tree rooted at t, held in persistent leftist
+// heaps. After the construction of the shortest-path tree, each walk is
+// found in O(log|V|) time, in addition to the time taken to construct it.
+// See https://doi.org/10.1137/S0097539795290477 for details.
+func EppsteinKShortestPaths(g graph.Graph, k int, s, t graph.Node) (paths [][]graph.Node, weights []float64) {
+	if k <= 0 || g.Node(s.ID()) == nil || g.Node(t.ID()) == nil {
+		return nil, nil
+	}
+
+	var weight Weighting
+	if wg, ok := g.(Weighted); ok {
+		weight = wg.Weight
+	} else {
+		weight = UniformCost(g)
+	}
+
+	// Construct the shortest-path tree to t.
+	to := g.From
+	if g, ok := g.(graph.Directed); ok {
+		to = g.To
+	}
+	tid := t.ID()
+	dist := map[int64]float64{tid: 0}
+	next := map[int64]graph.Node{}
+	nodes := map[int64]graph.Node{tid: g.Node(tid)}
+	var settled []int64
+	done := make(map[int64]bool)
+	Q := priorityQueue{{node: t, dist: 0}}
+	for Q.Len() != 0 {
+		mid := heap.Pop(&Q).(distanceNode)
+		vid := mid.node.ID()
+		if done[vid] {
+			continue
+		}
+		done[vid] = true
+		settled = append(settled, vid)
+		from := to(vid)
+		for from.Next() {
+			u := from.Node()
+			uid := u.ID()
+			w, ok := weight(uid, vid)
+			if !ok {
+				panic("eppstein: unexpected invalid weight")
+			}
+			if w < 0 {
+				panic("eppstein: negative edge weight")
+			}
+			joint := mid.dist + w
+			if d, ok := dist[uid]; !ok || joint < d {
+				dist[uid] = joint
+				next[uid] = mid.node
+				nodes[uid] = u
+				heap.Push(&Q, distanceNode{node: u, dist: joint})
+			}
+		}
+	}
+	sid := s.ID()
+	if _, ok := dist[sid]; !ok {
+		return nil, nil
+	}
+
+	// Construct the sidetrack heaps for each node in order
+	// of distance from t so that the heap for the next node
+	// in the tree is always available.
+	heaps := make(map[int64]*sidetrackHeap, len(settled))
+	for _, uid := range settled {
+		var h *sidetrackHeap
+		n, hasNext := next[uid]
+		isTree := true
+		out := g.From(uid)
+		for out.Next() {
+			v := out.Node()
+			vid := v.ID()
+			dv, ok := dist[vid]
+			if !ok {
+				continue
+			}
+			if hasNext && isTree && vid == n.ID() {
+				// Only exclude the tree edge once.
+				isTree = false
+				continue
+			}
+			w, ok := weight(uid, vid)
+			if !ok {
+				panic("eppstein: unexpected invalid weight")
+			}
+			if w < 0 {
+				panic("eppstein: negative edge weight")
+			}
+			h = h.insert(sidetrack{from: uid, to: v, delta: w + dv - dist[uid]})
+		}
+		if hasNext {
+			h = meld(h, heaps[n.ID()])
+		}
+		heaps[uid] = h
+	}
+
+	// Enumerate the walks in order of weight.
+	paths = append(paths, eppsteinWalk(nodes[sid], t, next, nil))
+	weights = append(weights, dist[sid])
+	var C eppsteinQueue
+	if h := heaps[sid]; h != nil {
+		heap.Push(&C, eppsteinCandidate{weight: dist[sid] + h.edge.delta, heap: h})
+	}
+	for len(paths) < k && C.Len() != 0 {
+		c := heap.Pop(&C).(eppsteinCandidate)
+		seq := &sidetrackSeq{edge: c.heap.edge, prev: c.prev}
+		paths = append(paths, eppsteinWalk(nodes[sid], t, next, seq))
+		weights = append(weights, c.weight)
+
+		// Replace the last sidetrack with the
+		// next best alternatives from its heap.
+		for _, h := range [...]*sidetrackHeap{c.heap.left, c.heap.right} {
+			if h != nil {
+				heap.Push(&C, eppsteinCandidate{weight: c.weight - c.heap.edge.delta + h.edge.delta, heap: h, prev: c.prev})
+			}
+		}
+		// Append a sidetrack from the walk
+		// continuing from the last sidetrack.
+		if h := heaps[c.heap.edge.to.ID()]; h != nil {
+			heap.Push(&C, eppsteinCandidate{weight: c.weight + h.edge.delta, heap: h, prev: seq})
+		}
+	}
+
+	return paths, weights
+}
+
+// eppsteinWalk returns the walk from s to t described by the sequence of
+// sidetracks in seq and the shortest-path tree, next.
+func eppsteinWalk(s, t graph.Node, next map[int64]graph.Node, seq *sidetrackSeq) []graph.Node {
+	var edges []sidetrack
+	for ; seq != nil; seq = seq.prev {
+		edges = append(edges, seq.edge)
+	}
+	walk := []graph.Node{s}
+	u := s
+	for i := len(edges) - 1; i >= 0; i-- {
+		e := edges[i]
+		for u.ID() != e.from {
+			u = next[u.ID()]
+			walk = append(walk, u)
+		}
+		u = e.to
+		walk = append(walk, u)
+	}
+	for tid := t.ID(); u.ID() != tid; {
+		u = next[u.ID()]
+		walk = append(walk, u)
+	}
+	return walk
+}
+
+// sidetrack is an edge not in the shortest-path tree.
+type sidetrack struct {
+	from int64
+	to   graph.Node
+
+	// delta is the additional cost of
+	// taking the sidetrack rather than
+	// following the shortest-path tree.
+	delta float64
+}
+
+// sidetrackSeq is a linked list of sidetracks
+// in reverse order of their position in a walk.
+type sidetrackSeq struct {
+	edge sidetrack
+	prev *sidetrackSeq
+}
+
+// sidetrackHeap is a persistent leftist min-heap of sidetracks.
+type sidetrackHeap struct {
+	edge        sidetrack
+	left, right *sidetrackHeap
+	rank        int
+}
+
+// insert returns the heap h with e added. The receiver is not altered.
+func (h *sidetrackHeap) insert(e sidetrack) *sidetrackHeap {
+	return meld(h, &sidetrackHeap{edge: e, rank: 1})
+}
+
+// meld returns the union of the heaps a and b. Neither a nor b is altered.
+func meld(a, b *sidetrackHeap) *sidetrackHeap {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	if b.edge.delta < a.edge.delta {
+		a, b = b, a
+	}
+	c := *a
+	c.right = meld(a.right, b)
+	if c.left.rankOf() < c.right.rankOf() {
+		c.left, c.right = c.right, c.left
+	}
+	c.rank = c.right.rankOf() + 1
+	return &c
+}
+
+// rankOf returns the rank of h, the length of its right spine.
+func (h *sidetrackHeap) rankOf() int {
+	if h == nil {
+		return 0
+	}
+	return h.rank
+}
+
+// eppsteinCandidate is a candidate walk formed by the sequence of
+// sidetracks in prev followed by the sidetrack at the root of heap.
+type eppsteinCandidate struct {
+	weight float64
+	heap   *sidetrackHeap
+	prev   *sidetrackSeq
+}
+
+// eppsteinQueue is a priority queue of candidate walks.
+type eppsteinQueue []eppsteinCandidate
+
+func (q eppsteinQueue) Len() int            { return len(q) }
+func (q eppsteinQueue) Less(i, j int) bool  { return q[i].weight < q[j].weight }
+func (q eppsteinQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *eppsteinQueue) Push(n interface{}) { *q = append(*q, n.(eppsteinCandidate)) }
+func (q *eppsteinQueue) Pop() interface{} {
+	t := *q
+	var n interface{}
+	n, *q = t[len(t)-1], t[:len(t)-1]
+	return n
+}
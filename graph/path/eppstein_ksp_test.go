@@ -0,0 +1,142 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package path
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+var eppsteinShortestPathTests = []struct {
+	name  string
+	graph func() graph.WeightedEdgeAdder
+	edges []simple.WeightedEdge
+
+	query       simple.Edge
+	k           int
+	wantPaths   [][]int64
+	wantWeights []float64
+}{
+	{
+		name:  "empty graph",
+		graph: func() graph.WeightedEdgeAdder { return simple.NewWeightedDirectedGraph(0, math.Inf(1)) },
+		query: simple.Edge{F: simple.Node(0), T: simple.Node(1)},
+		k:     2,
+	},
+	{
+		name:  "no path",
+		graph: func() graph.WeightedEdgeAdder { return simple.NewWeightedDirectedGraph(0, math.Inf(1)) },
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(1), T: simple.Node(0), W: 1},
+		},
+		query: simple.Edge{F: simple.Node(0), T: simple.Node(1)},
+		k:     2,
+	},
+	{
+		name:  "directed loop",
+		graph: func() graph.WeightedEdgeAdder { return simple.NewWeightedDirectedGraph(0, math.Inf(1)) },
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 1},
+			{F: simple.Node(1), T: simple.Node(2), W: 1},
+			{F: simple.Node(0), T: simple.Node(2), W: 3},
+			{F: simple.Node(1), T: simple.Node(0), W: 1},
+		},
+		query: simple.Edge{F: simple.Node(0), T: simple.Node(2)},
+		k:     5,
+		wantPaths: [][]int64{
+			{0, 1, 2},
+			{0, 2},
+			{0, 1, 0, 1, 2},
+			{0, 1, 0, 2},
+			{0, 1, 0, 1, 0, 1, 2},
+		},
+		wantWeights: []float64{2, 3, 4, 5, 6},
+	},
+	{
+		name:  "undirected edge",
+		graph: func() graph.WeightedEdgeAdder { return simple.NewWeightedUndirectedGraph(0, math.Inf(1)) },
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 1},
+		},
+		query: simple.Edge{F: simple.Node(0), T: simple.Node(1)},
+		k:     3,
+		wantPaths: [][]int64{
+			{0, 1},
+			{0, 1, 0, 1},
+			{0, 1, 0, 1, 0, 1},
+		},
+		wantWeights: []float64{1, 3, 5},
+	},
+	{
+		name:  "source is target",
+		graph: func() graph.WeightedEdgeAdder { return simple.NewWeightedDirectedGraph(0, math.Inf(1)) },
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 1},
+			{F: simple.Node(1), T: simple.Node(0), W: 2},
+		},
+		query: simple.Edge{F: simple.Node(0), T: simple.Node(0)},
+		k:     3,
+		wantPaths: [][]int64{
+			{0},
+			{0, 1, 0},
+			{0, 1, 0, 1, 0},
+		},
+		wantWeights: []float64{0, 3, 6},
+	},
+}
+
+func TestEppsteinKSP(t *testing.T) {
+	t.Parallel()
+	for _, test := range eppsteinShortestPathTests {
+		g := test.graph()
+		for _, e := range test.edges {
+			g.SetWeightedEdge(e)
+		}
+
+		got, weights := EppsteinKShortestPaths(g.(graph.Graph), test.k, test.query.From(), test.query.To())
+		gotIDs := pathIDs(got)
+		if !reflect.DeepEqual(gotIDs, test.wantPaths) {
+			t.Errorf("unexpected paths for %q:\ngot: %v\nwant:%v", test.name, gotIDs, test.wantPaths)
+		}
+		if !reflect.DeepEqual(weights, test.wantWeights) {
+			t.Errorf("unexpected weights for %q:\ngot: %v\nwant:%v", test.name, weights, test.wantWeights)
+		}
+		for i, p := range got {
+			if w := pathWeight(p, g.(graph.Weighted)); w != weights[i] {
+				t.Errorf("unexpected weight for path %d of %q: got:%v want:%v", i, test.name, weights[i], w)
+			}
+		}
+	}
+}
+
+func TestEppsteinKSPAcyclic(t *testing.T) {
+	t.Parallel()
+	for _, test := range yenShortestPathTests {
+		g := test.graph()
+		if _, ok := g.(graph.Directed); !ok || test.k <= 0 || !math.IsInf(test.cost, 1) {
+			// Walks in undirected graphs may include
+			// cycles, so only DAGs can be compared.
+			continue
+		}
+		for _, e := range test.edges {
+			g.SetWeightedEdge(e)
+		}
+
+		_, want := YenKShortestPathsWeighted(g.(graph.Graph), test.k, test.cost, test.query.From(), test.query.To())
+		got, weights := EppsteinKShortestPaths(g.(graph.Graph), test.k, test.query.From(), test.query.To())
+		if !reflect.DeepEqual(weights, want) {
+			t.Errorf("unexpected weights for %q:\ngot: %v\nwant:%v", test.name, weights, want)
+		}
+		for i, p := range got {
+			if w := pathWeight(p, g.(graph.Weighted)); w != weights[i] {
+				t.Errorf("unexpected weight for path %d of %q: got:%v want:%v", i, test.name, weights[i], w)
+			}
+		}
+	}
+}
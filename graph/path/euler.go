@@ -0,0 +1,308 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package path
+
+import (
+	"slices"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+// EulerianCircuit returns an Eulerian circuit of the undirected graph g, a
+// closed walk that traverses every edge of g exactly once, and whether such a
+// circuit exists. A circuit exists if every node has even degree and all the
+// nodes with non-zero degree are connected. Self edges contribute two to the
+// degree of their node. If g implements graph.UndirectedMultigraph, each line
+// between a pair of nodes is traversed, and the returned edges also implement
+// graph.Line, or graph.WeightedLine for weighted lines.
+//
+// The returned edges are oriented in the direction of traversal, so the To
+// node of each edge is the From node of the next, and the walk ends at the
+// node it starts from. The circuit is found using Hierholzer's algorithm
+// starting from the lowest ID node with an edge, and is deterministic for a
+// given graph. If g has no edges, EulerianCircuit returns nil and true.
+func EulerianCircuit(g graph.Undirected) ([]graph.Edge, bool) {
+	e, deg := newUndirectedEuler(g)
+	start := -1
+	for i, d := range deg {
+		if d%2 != 0 {
+			return nil, false
+		}
+		if start < 0 && d != 0 {
+			start = i
+		}
+	}
+	return e.walk(start)
+}
+
+// EulerianPath returns an Eulerian path of the undirected graph g, a walk that
+// traverses every edge of g exactly once, and whether such a path exists. A
+// path exists if no node or exactly two nodes have odd degree and all the nodes
+// with non-zero degree are connected. When two nodes have odd degree, the path
+// starts at the one with the lower ID and ends at the other, otherwise the path
+// is an Eulerian circuit as returned by EulerianCircuit. Self edges and lines
+// of a graph.UndirectedMultigraph are handled as described for
+// EulerianCircuit. If g has no edges, EulerianPath returns nil and true.
+func EulerianPath(g graph.Undirected) ([]graph.Edge, bool) {
+	e, deg := newUndirectedEuler(g)
+	var (
+		start = -1
+		odd   int
+	)
+	for i, d := range deg {
+		if d%2 != 0 {
+			if odd == 0 {
+				start = i
+			}
+			odd++
+		}
+		if start < 0 && d != 0 {
+			start = i
+		}
+	}
+	if odd != 0 && odd != 2 {
+		return nil, false
+	}
+	return e.walk(start)
+}
+
+// DirectedEulerianCircuit returns an Eulerian circuit of the directed graph g,
+// a closed walk that follows every edge of g exactly once in its direction, and
+// whether such a circuit exists. A circuit exists if the in-degree of every
+// node equals its out-degree and all the nodes with non-zero degree are
+// connected in the underlying undirected graph. If g implements
+// graph.DirectedMultigraph, each line from one node to another is followed,
+// and the returned edges implement graph.Line as for EulerianCircuit.
+//
+// The walk ends at the node it starts from. The circuit is found using
+// Hierholzer's algorithm starting from the lowest ID node with an edge, and is
+// deterministic for a given graph. If g has no edges, DirectedEulerianCircuit
+// returns nil and true.
+func DirectedEulerianCircuit(g graph.Directed) ([]graph.Edge, bool) {
+	e, in, out := newDirectedEuler(g)
+	start := -1
+	for i := range in {
+		if in[i] != out[i] {
+			return nil, false
+		}
+		if start < 0 && out[i] != 0 {
+			start = i
+		}
+	}
+	return e.walk(start)
+}
+
+// DirectedEulerianPath returns an Eulerian path of the directed graph g, a walk
+// that follows every edge of g exactly once in its direction, and whether such
+// a path exists. A path exists if all the nodes with non-zero degree are
+// connected in the underlying undirected graph and either every node has equal
+// in-degree and out-degree, or exactly one node has one more outgoing than
+// incoming edge, exactly one node has one more incoming than outgoing edge, and
+// all other nodes are balanced. In the first case the path is an Eulerian
+// circuit as returned by DirectedEulerianCircuit, otherwise it starts at the
+// node with the excess outgoing edge and ends at the node with the excess
+// incoming edge. If g has no edges, DirectedEulerianPath returns nil and true.
+func DirectedEulerianPath(g graph.Directed) ([]graph.Edge, bool) {
+	e, in, out := newDirectedEuler(g)
+	var (
+		start, first = -1, -1
+		sources      int
+		sinks        int
+	)
+	for i := range in {
+		switch out[i] - in[i] {
+		case 0:
+		case 1:
+			start = i
+			sources++
+		case -1:
+			sinks++
+		default:
+			return nil, false
+		}
+		if first < 0 && out[i] != 0 {
+			first = i
+		}
+	}
+	switch {
+	case sources == 0 && sinks == 0:
+		start = first
+	case sources != 1 || sinks != 1:
+		return nil, false
+	}
+	return e.walk(start)
+}
+
+// euler is the edge-indexed adjacency of a graph used
+// to construct Eulerian walks.
+type euler struct {
+	nodes []graph.Node
+	adj   [][]eulerArc
+	edges []graph.Edge
+}
+
+// eulerArc is a traversable end of an edge.
+type eulerArc struct {
+	to   int
+	edge int
+}
+
+// newUndirectedEuler returns the adjacency of the undirected graph g and the
+// degree of each node in the order of the nodes of the returned euler.
+func newUndirectedEuler(g graph.Graph) (e *euler, deg []int) {
+	mg, _ := g.(graph.UndirectedMultigraph)
+	e = newEuler(g)
+	deg = make([]int, len(e.nodes))
+	index := e.index()
+	for i, u := range e.nodes {
+		uid := u.ID()
+		for _, v := range successorsByID(g, uid) {
+			vid := v.ID()
+			if vid < uid {
+				continue
+			}
+			j := index[vid]
+			for _, l := range edgesFrom(g, mg, uid, vid) {
+				k := len(e.edges)
+				e.edges = append(e.edges, l)
+				e.adj[i] = append(e.adj[i], eulerArc{to: j, edge: k})
+				if i != j {
+					e.adj[j] = append(e.adj[j], eulerArc{to: i, edge: k})
+				}
+				deg[i]++
+				deg[j]++
+			}
+		}
+	}
+	return e, deg
+}
+
+// newDirectedEuler returns the adjacency of g and the in-degree and out-degree
+// of each node in the order of the nodes of the returned euler.
+func newDirectedEuler(g graph.Directed) (e *euler, in, out []int) {
+	mg, _ := g.(graph.DirectedMultigraph)
+	e = newEuler(g)
+	in = make([]int, len(e.nodes))
+	out = make([]int, len(e.nodes))
+	index := e.index()
+	for i, u := range e.nodes {
+		uid := u.ID()
+		for _, v := range successorsByID(g, uid) {
+			vid := v.ID()
+			j := index[vid]
+			for _, l := range edgesFrom(g, mg, uid, vid) {
+				e.adj[i] = append(e.adj[i], eulerArc{to: j, edge: len(e.edges)})
+				e.edges = append(e.edges, l)
+				out[i]++
+				in[j]++
+			}
+		}
+	}
+	return e, in, out
+}
+
+func newEuler(g graph.Graph) *euler {
+	nodes := graph.NodesOf(g.Nodes())
+	order.ByID(nodes)
+	return &euler{nodes: nodes, adj: make([][]eulerArc, len(nodes))}
+}
+
+func (e *euler) index() map[int64]int {
+	index := make(map[int64]int, len(e.nodes))
+	for i, n := range e.nodes {
+		index[n.ID()] = i
+	}
+	return index
+}
+
+// successorsByID returns the nodes reachable from uid in g sorted by ID.
+func successorsByID(g graph.Graph, uid int64) []graph.Node {
+	to := graph.NodesOf(g.From(uid))
+	order.ByID(to)
+	return to
+}
+
+// edgesFrom returns the edges from uid to vid in g. If mg is not nil, the
+// lines of mg are returned sorted by ID, otherwise the single edge of g.
+func edgesFrom(g graph.Graph, mg graph.Multigraph, uid, vid int64) []graph.Edge {
+	if mg == nil {
+		return []graph.Edge{g.Edge(uid, vid)}
+	}
+	lines := graph.LinesOf(mg.Lines(uid, vid))
+	order.LinesByIDs(lines)
+	edges := make([]graph.Edge, len(lines))
+	for i, l := range lines {
+		if wl, ok := l.(graph.WeightedLine); ok {
+			edges[i] = weightedLineEdge{wl}
+		} else {
+			edges[i] = lineEdge{l}
+		}
+	}
+	return edges
+}
+
+// lineEdge is a graph.Line that is also a graph.Edge.
+type lineEdge struct {
+	graph.Line
+}
+
+func (e lineEdge) ReversedEdge() graph.Edge { return lineEdge{e.ReversedLine()} }
+
+// weightedLineEdge is a graph.WeightedLine that is also a graph.WeightedEdge.
+type weightedLineEdge struct {
+	graph.WeightedLine
+}
+
+func (e weightedLineEdge) ReversedEdge() graph.Edge {
+	return weightedLineEdge{e.ReversedLine().(graph.WeightedLine)}
+}
+
+// walk returns the Eulerian walk starting from the node at index start using
+// Hierholzer's algorithm. If start is negative, e has no edges and walk returns
+// nil and true. If the walk does not traverse every edge, the edges are not
+// connected and walk returns nil and false.
+func (e *euler) walk(start int) ([]graph.Edge, bool) {
+	if start < 0 {
+		return nil, true
+	}
+
+	type step struct {
+		u    int
+		edge graph.Edge // The edge traversed to reach u.
+	}
+	used := make([]bool, len(e.edges))
+	next := make([]int, len(e.nodes))
+	stack := []step{{u: start}}
+	walk := make([]graph.Edge, 0, len(e.edges))
+	for len(stack) != 0 {
+		s := stack[len(stack)-1]
+		u := s.u
+		arcs := e.adj[u]
+		for next[u] < len(arcs) && used[arcs[next[u]].edge] {
+			next[u]++
+		}
+		if next[u] == len(arcs) {
+			stack = stack[:len(stack)-1]
+			if s.edge != nil {
+				walk = append(walk, s.edge)
+			}
+			continue
+		}
+		a := arcs[next[u]]
+		next[u]++
+		used[a.edge] = true
+		edge := e.edges[a.edge]
+		if edge.From().ID() != e.nodes[u].ID() {
+			edge = edge.ReversedEdge()
+		}
+		stack = append(stack, step{u: a.to, edge: edge})
+	}
+	if len(walk) != len(e.edges) {
+		return nil, false
+	}
+	slices.Reverse(walk)
+	return walk, true
+}
@@ -0,0 +1,225 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package path
+
+import (
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/multi"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+var eulerianTests = []struct {
+	name  string
+	edges [][2]int64
+	nodes []int64 // Isolated nodes.
+
+	wantCircuit, wantPath                 bool
+	wantDirectedCircuit, wantDirectedPath bool
+}{
+	{
+		name:        "empty",
+		wantCircuit: true, wantPath: true,
+		wantDirectedCircuit: true, wantDirectedPath: true,
+	},
+	{
+		name:        "isolated",
+		nodes:       []int64{0, 1},
+		wantCircuit: true, wantPath: true,
+		wantDirectedCircuit: true, wantDirectedPath: true,
+	},
+	{
+		name:        "triangle",
+		edges:       [][2]int64{{0, 1}, {1, 2}, {2, 0}},
+		wantCircuit: true, wantPath: true,
+		wantDirectedCircuit: true, wantDirectedPath: true,
+	},
+	{
+		name:        "path",
+		edges:       [][2]int64{{0, 1}, {1, 2}, {2, 3}},
+		wantCircuit: false, wantPath: true,
+		wantDirectedCircuit: false, wantDirectedPath: true,
+	},
+	{
+		name:        "reversed path",
+		edges:       [][2]int64{{3, 2}, {2, 1}, {1, 0}},
+		wantCircuit: false, wantPath: true,
+		wantDirectedCircuit: false, wantDirectedPath: true,
+	},
+	{
+		name:        "misdirected path",
+		edges:       [][2]int64{{0, 1}, {2, 1}, {2, 3}},
+		wantCircuit: false, wantPath: true,
+		wantDirectedCircuit: false, wantDirectedPath: false,
+	},
+	{
+		// The seven bridges of Königsberg.
+		name:        "königsberg",
+		edges:       [][2]int64{{0, 1}, {0, 1}, {0, 2}, {0, 2}, {0, 3}, {1, 3}, {2, 3}},
+		wantCircuit: false, wantPath: false,
+		wantDirectedCircuit: false, wantDirectedPath: false,
+	},
+	{
+		name:        "bowtie",
+		edges:       [][2]int64{{0, 1}, {1, 2}, {2, 0}, {2, 3}, {3, 4}, {4, 2}},
+		wantCircuit: true, wantPath: true,
+		wantDirectedCircuit: true, wantDirectedPath: true,
+	},
+	{
+		name:        "self edges",
+		edges:       [][2]int64{{0, 0}, {0, 1}, {1, 1}, {1, 1}, {1, 2}},
+		wantCircuit: false, wantPath: true,
+		wantDirectedCircuit: false, wantDirectedPath: true,
+	},
+	{
+		name:        "parallel",
+		edges:       [][2]int64{{0, 1}, {1, 0}, {0, 1}, {1, 0}},
+		wantCircuit: true, wantPath: true,
+		wantDirectedCircuit: true, wantDirectedPath: true,
+	},
+	{
+		name:        "disconnected",
+		edges:       [][2]int64{{0, 1}, {1, 2}, {2, 0}, {3, 4}, {4, 5}, {5, 3}},
+		wantCircuit: false, wantPath: false,
+		wantDirectedCircuit: false, wantDirectedPath: false,
+	},
+	{
+		name:        "star",
+		edges:       [][2]int64{{0, 1}, {0, 2}, {0, 3}, {0, 4}},
+		wantCircuit: false, wantPath: false,
+		wantDirectedCircuit: false, wantDirectedPath: false,
+	},
+	{
+		// The de Bruijn graph B(2, 3) has an Eulerian circuit
+		// corresponding to a de Bruijn sequence.
+		name: "de Bruijn",
+		edges: [][2]int64{
+			{0, 0}, {0, 1}, {1, 2}, {1, 3},
+			{2, 0}, {2, 1}, {3, 2}, {3, 3},
+		},
+		wantCircuit: true, wantPath: true,
+		wantDirectedCircuit: true, wantDirectedPath: true,
+	},
+}
+
+func TestEulerian(t *testing.T) {
+	t.Parallel()
+	for _, test := range eulerianTests {
+		ug := multi.NewUndirectedGraph()
+		dg := multi.NewDirectedGraph()
+		for _, n := range test.nodes {
+			ug.AddNode(multi.Node(n))
+			dg.AddNode(multi.Node(n))
+		}
+		for _, e := range test.edges {
+			ug.SetLine(ug.NewLine(multi.Node(e[0]), multi.Node(e[1])))
+			dg.SetLine(dg.NewLine(multi.Node(e[0]), multi.Node(e[1])))
+		}
+
+		for _, fn := range []struct {
+			name     string
+			g        graph.Graph
+			find     func() ([]graph.Edge, bool)
+			want     bool
+			closed   bool
+			directed bool
+		}{
+			{name: "EulerianCircuit", g: ug, find: func() ([]graph.Edge, bool) { return EulerianCircuit(ug) }, want: test.wantCircuit, closed: true},
+			{name: "EulerianPath", g: ug, find: func() ([]graph.Edge, bool) { return EulerianPath(ug) }, want: test.wantPath},
+			{name: "DirectedEulerianCircuit", g: dg, find: func() ([]graph.Edge, bool) { return DirectedEulerianCircuit(dg) }, want: test.wantDirectedCircuit, closed: true, directed: true},
+			{name: "DirectedEulerianPath", g: dg, find: func() ([]graph.Edge, bool) { return DirectedEulerianPath(dg) }, want: test.wantDirectedPath, directed: true},
+		} {
+			walk, ok := fn.find()
+			if ok != fn.want {
+				t.Errorf("unexpected result from %s for %q: got:%t want:%t", fn.name, test.name, ok, fn.want)
+				continue
+			}
+			if !ok {
+				if walk != nil {
+					t.Errorf("unexpected walk from %s for %q: %v", fn.name, test.name, walk)
+				}
+				continue
+			}
+			checkEulerianWalk(t, fn.name+" "+test.name, test.edges, walk, fn.closed, fn.directed)
+		}
+	}
+}
+
+func TestEulerianSimple(t *testing.T) {
+	t.Parallel()
+	// K5 has all even degrees.
+	ug := simple.NewUndirectedGraph()
+	var edges [][2]int64
+	for u := int64(0); u < 5; u++ {
+		for v := u + 1; v < 5; v++ {
+			ug.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+			edges = append(edges, [2]int64{u, v})
+		}
+	}
+	walk, ok := EulerianCircuit(ug)
+	if !ok {
+		t.Fatal("expected Eulerian circuit in K5")
+	}
+	checkEulerianWalk(t, "K5", edges, walk, true, false)
+	if walk[0].From().ID() != 0 {
+		t.Errorf("unexpected circuit start: got:%d want:0", walk[0].From().ID())
+	}
+
+	// A directed cycle with a chord has a path
+	// but not a circuit.
+	dg := simple.NewDirectedGraph()
+	edges = [][2]int64{{0, 1}, {1, 2}, {2, 3}, {3, 0}, {1, 3}}
+	for _, e := range edges {
+		dg.SetEdge(simple.Edge{F: simple.Node(e[0]), T: simple.Node(e[1])})
+	}
+	if _, ok := DirectedEulerianCircuit(dg); ok {
+		t.Error("unexpected Eulerian circuit in chorded cycle")
+	}
+	walk, ok = DirectedEulerianPath(dg)
+	if !ok {
+		t.Fatal("expected Eulerian path in chorded cycle")
+	}
+	checkEulerianWalk(t, "chorded cycle", edges, walk, false, true)
+	if walk[0].From().ID() != 1 || walk[len(walk)-1].To().ID() != 3 {
+		t.Errorf("unexpected path end points: got:%d-%d want:1-3", walk[0].From().ID(), walk[len(walk)-1].To().ID())
+	}
+}
+
+// checkEulerianWalk checks that walk is a contiguous walk that traverses
+// each of the edges exactly once.
+func checkEulerianWalk(t *testing.T, name string, edges [][2]int64, walk []graph.Edge, closed, directed bool) {
+	t.Helper()
+	if len(walk) != len(edges) {
+		t.Errorf("unexpected walk length for %s: got:%d want:%d", name, len(walk), len(edges))
+		return
+	}
+	if len(walk) == 0 {
+		return
+	}
+	key := func(u, v int64) [2]int64 {
+		if !directed && v < u {
+			u, v = v, u
+		}
+		return [2]int64{u, v}
+	}
+	remain := make(map[[2]int64]int)
+	for _, e := range edges {
+		remain[key(e[0], e[1])]++
+	}
+	for i, e := range walk {
+		if i > 0 && walk[i-1].To().ID() != e.From().ID() {
+			t.Errorf("walk for %s is not contiguous at %d: %v", name, i, walk)
+		}
+		k := key(e.From().ID(), e.To().ID())
+		if remain[k] == 0 {
+			t.Errorf("walk for %s traverses %d-%d too many times", name, e.From().ID(), e.To().ID())
+		}
+		remain[k]--
+	}
+	if closed && walk[0].From().ID() != walk[len(walk)-1].To().ID() {
+		t.Errorf("walk for %s is not closed: %v", name, walk)
+	}
+}
@@ -6,17 +6,225 @@ package path
 
 import (
 	"math"
+	"runtime"
+	"sync"
 
 	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/internal/order"
 )
 
 // FloydWarshall returns a shortest-path tree for the graph g or false indicating
 // that a negative cycle exists in the graph. If a negative cycle exists in the graph
 // the returned paths will be valid and edge weights on the negative cycle will be
 // set to -Inf. If the graph does not implement Weighted, UniformCost is used.
+// The returned paths do not depend on the iteration order of g.
 //
 // The time complexity of FloydWarshall is O(|V|^3).
 func FloydWarshall(g graph.Graph) (paths AllShortest, ok bool) {
+	paths = newFloydWarshall(g)
+	all := span{0, len(paths.nodes)}
+	paths.relax(all, all, all)
+	return paths, paths.markNegativeCycles()
+}
+
+// FloydWarshallParallel returns a shortest-path tree for the graph g or false
+// indicating that a negative cycle exists in the graph, as FloydWarshall does,
+// using the blocked formulation of the algorithm with up to
+// runtime.GOMAXPROCS(0) goroutines. The returned paths are identical to those
+// returned by FloydWarshall, including path weights and the order of
+// alternative paths used for path reconstruction.
+//
+// The distance matrix is partitioned into square blocks. For each block on
+// the diagonal in turn, the diagonal block is first updated using its own
+// nodes as intermediates, then the other blocks in its row and column are
+// updated concurrently using the diagonal block, and finally all remaining
+// blocks are updated concurrently using the row and column blocks. Each block
+// update works on data that fits in cache.
+//
+// When a path between two nodes is updated using an intermediate node k,
+// FloydWarshall uses the paths to and from k as they are before k is used
+// as an intermediate. These paths are retained when the row and column
+// blocks are updated, so every path is updated with the same operands and
+// in the same order as by FloydWarshall. This depends on the paths to and
+// from k not changing while k is used, which holds unless k is on a negative
+// cycle, so when a negative cycle is found the remaining updates are made
+// serially.
+//
+// The time complexity of FloydWarshallParallel is O(|V|^3).
+func FloydWarshallParallel(g graph.Graph) (paths AllShortest, ok bool) {
+	return floydWarshallBlocked(g, floydWarshallBlockSize, runtime.GOMAXPROCS(0))
+}
+
+// floydWarshallBlockSize is the number of rows and columns
+// in a block of the blocked Floyd-Warshall algorithm.
+const floydWarshallBlockSize = 64
+
+// floydWarshallBlocked performs the blocked Floyd-Warshall algorithm on g with
+// the specified block size using up to the specified number of concurrent
+// workers.
+func floydWarshallBlocked(g graph.Graph, size, workers int) (paths AllShortest, ok bool) {
+	paths = newFloydWarshall(g)
+	n := len(paths.nodes)
+	var blocks []span
+	for lo := 0; lo < n; lo += size {
+		blocks = append(blocks, span{lo, min(lo+size, n)})
+	}
+
+	fw := &blockedFloydWarshall{
+		AllShortest: paths,
+		size:        size,
+		colDist:     make([]float64, n*size),
+		colNext:     make([][]int, n*size),
+		rowDist:     make([]float64, size*n),
+	}
+	var (
+		rowCol [][2]span
+		rest   [][2]span
+	)
+	for k, kb := range blocks {
+		fw.k = kb
+
+		// Phase 1: update the diagonal block using
+		// its own nodes, stopping before any node on
+		// a negative cycle.
+		cut := kb.hi
+		for m := kb.lo; m < kb.hi; m++ {
+			if paths.dist.At(m, m) < 0 {
+				cut = m
+				break
+			}
+			fw.relax(span{m, m + 1}, kb, kb)
+		}
+		used := span{kb.lo, cut}
+
+		// Phase 2: update the blocks in the row
+		// and column of the diagonal block, which
+		// depend only on the diagonal block and
+		// themselves.
+		rowCol = rowCol[:0]
+		for b, ob := range blocks {
+			if b != k {
+				rowCol = append(rowCol, [2]span{kb, ob}, [2]span{ob, kb})
+			}
+		}
+		fw.relaxBlocks(used, rowCol, workers)
+
+		// Phase 3: update the remaining blocks,
+		// which depend only on the row and column
+		// blocks.
+		rest = rest[:0]
+		for i, ib := range blocks {
+			if i == k {
+				continue
+			}
+			for j, jb := range blocks {
+				if j != k {
+					rest = append(rest, [2]span{ib, jb})
+				}
+			}
+		}
+		fw.relaxBlocks(used, rest, workers)
+
+		if cut < kb.hi {
+			// The paths to and from nodes on a negative
+			// cycle may change while the node is used as
+			// an intermediate, so continue serially.
+			all := span{0, n}
+			paths.relax(span{cut, n}, all, all)
+			break
+		}
+	}
+
+	return paths, paths.markNegativeCycles()
+}
+
+// blockedFloydWarshall holds the state of a blocked Floyd-Warshall search.
+type blockedFloydWarshall struct {
+	AllShortest
+
+	// k is the current diagonal block.
+	k span
+
+	// size is the block size.
+	size int
+
+	// colDist and colNext hold the paths from
+	// each node to each node of k before the
+	// node of k is used as an intermediate,
+	// indexed by i*size+m-k.lo, and rowDist
+	// holds the weights of the paths from
+	// each node of k to each node, indexed
+	// by (m-k.lo)*n+j.
+	colDist []float64
+	colNext [][]int
+	rowDist []float64
+}
+
+// relaxBlocks updates each block of paths between the rows and columns of
+// blocks using the nodes indexed by k as intermediates, using up to the
+// specified number of concurrent workers. No block may hold paths that are
+// used to update another of the blocks.
+func (fw *blockedFloydWarshall) relaxBlocks(k span, blocks [][2]span, workers int) {
+	workers = min(workers, len(blocks))
+	if workers < 2 {
+		for _, b := range blocks {
+			fw.relax(k, b[0], b[1])
+		}
+		return
+	}
+	var wg sync.WaitGroup
+	for w := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for b := w; b < len(blocks); b += workers {
+				fw.relax(k, blocks[b][0], blocks[b][1])
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// relax updates the paths between the nodes indexed by rows and cols using
+// each of the nodes indexed by k as an intermediate in turn, using the
+// retained paths to and from the intermediate. The retained paths are
+// recorded when rows or cols is the current diagonal block.
+func (fw *blockedFloydWarshall) relax(k, rows, cols span) {
+	n := len(fw.nodes)
+	raw := fw.dist.RawMatrix()
+	d, stride := raw.Data, raw.Stride
+	for m := k.lo; m < k.hi; m++ {
+		c := m - fw.k.lo
+		if cols == fw.k {
+			for i := rows.lo; i < rows.hi; i++ {
+				fw.colDist[i*fw.size+c] = d[i*stride+m]
+				fw.colNext[i*fw.size+c] = append(fw.colNext[i*fw.size+c][:0], fw.at(i, m)...)
+			}
+		}
+		dm := fw.rowDist[c*n : (c+1)*n]
+		if rows == fw.k {
+			copy(dm[cols.lo:cols.hi], d[m*stride+cols.lo:m*stride+cols.hi])
+		}
+		for i := rows.lo; i < rows.hi; i++ {
+			di := d[i*stride : i*stride+n]
+			im := fw.colDist[i*fw.size+c]
+			next := fw.colNext[i*fw.size+c]
+			for j := cols.lo; j < cols.hi; j++ {
+				ij := di[j]
+				joint := im + dm[j]
+				if ij > joint {
+					fw.set(i, j, joint, next...)
+				} else if ij-joint == 0 {
+					fw.add(i, j, next...)
+				}
+			}
+		}
+	}
+}
+
+// newFloydWarshall returns the initial shortest paths for the Floyd-Warshall
+// algorithm on g, holding the edges of g.
+func newFloydWarshall(g graph.Graph) AllShortest {
 	var weight Weighting
 	if wg, ok := g.(Weighted); ok {
 		weight = wg.Weight
@@ -24,8 +232,13 @@ func FloydWarshall(g graph.Graph) (paths AllShortest, ok bool) {
 		weight = UniformCost(g)
 	}
 
+	// Order the nodes so that the order in which path
+	// weights are summed and alternative paths are
+	// recorded does not depend on the iteration order
+	// of g.
 	nodes := graph.NodesOf(g.Nodes())
-	paths = newAllShortest(nodes, true)
+	order.ByID(nodes)
+	paths := newAllShortest(nodes, true)
 	for i, u := range nodes {
 		paths.dist.Set(i, i, 0)
 		uid := u.ID()
@@ -40,24 +253,16 @@ func FloydWarshall(g graph.Graph) (paths AllShortest, ok bool) {
 			paths.set(i, j, w, j)
 		}
 	}
+	return paths
+}
 
-	for k := range nodes {
-		for i := range nodes {
-			for j := range nodes {
-				ij := paths.dist.At(i, j)
-				joint := paths.dist.At(i, k) + paths.dist.At(k, j)
-				if ij > joint {
-					paths.set(i, j, joint, paths.at(i, k)...)
-				} else if ij-joint == 0 {
-					paths.add(i, j, paths.at(i, k)...)
-				}
-			}
-		}
-	}
-
+// markNegativeCycles marks the paths through negative cycles after the
+// Floyd-Warshall algorithm has been run, returning false if a negative
+// cycle exists.
+func (p AllShortest) markNegativeCycles() (ok bool) {
 	ok = true
-	for i := range nodes {
-		if paths.dist.At(i, i) < 0 {
+	for i := range p.nodes {
+		if p.dist.At(i, i) < 0 {
 			ok = false
 			break
 		}
@@ -69,10 +274,10 @@ func FloydWarshall(g graph.Graph) (paths AllShortest, ok bool) {
 		// weight. These weights are internal, being
 		// returned as -Inf in user calls.
 
-		d := paths.dist
-		for i := range nodes {
-			for j := range nodes {
-				for k := range nodes {
+		d := p.dist
+		for i := range p.nodes {
+			for j := range p.nodes {
+				for k := range p.nodes {
 					if math.IsInf(d.At(i, k), 1) || math.IsInf(d.At(k, j), 1) {
 						continue
 					}
@@ -87,5 +292,33 @@ func FloydWarshall(g graph.Graph) (paths AllShortest, ok bool) {
 		}
 	}
 
-	return paths, ok
+	return ok
+}
+
+// span is the half-open range of node indexes [lo, hi).
+type span struct{ lo, hi int }
+
+// relax updates the paths between the nodes indexed by rows and cols using
+// each of the nodes indexed by k as an intermediate in turn.
+func (p AllShortest) relax(k, rows, cols span) {
+	if len(p.nodes) == 0 {
+		return
+	}
+	raw := p.dist.RawMatrix()
+	d, stride := raw.Data, raw.Stride
+	for m := k.lo; m < k.hi; m++ {
+		for i := rows.lo; i < rows.hi; i++ {
+			di := d[i*stride : i*stride+len(p.nodes)]
+			dm := d[m*stride : m*stride+len(p.nodes)]
+			for j := cols.lo; j < cols.hi; j++ {
+				ij := di[j]
+				joint := di[m] + dm[j]
+				if ij > joint {
+					p.set(i, j, joint, p.at(i, m)...)
+				} else if ij-joint == 0 {
+					p.add(i, j, p.at(i, m)...)
+				}
+			}
+		}
+	}
 }
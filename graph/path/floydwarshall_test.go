@@ -6,11 +6,13 @@ package path
 
 import (
 	"math"
+	"math/rand/v2"
 	"reflect"
 	"testing"
 
 	"gonum.org/v1/gonum/graph"
 	"gonum.org/v1/gonum/graph/path/internal/testgraphs"
+	"gonum.org/v1/gonum/graph/simple"
 	"gonum.org/v1/gonum/internal/order"
 )
 
@@ -119,3 +121,91 @@ func TestFloydWarshall(t *testing.T) {
 		}
 	}
 }
+
+func TestFloydWarshallParallel(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		n             int
+		p             float64
+		integer       bool
+		negativeCycle bool
+	}{
+		{n: 40, p: 0.1, integer: true},
+		{n: 100, p: 0.05, integer: true},
+		{n: 100, p: 0.2, integer: true},
+		{n: 200, p: 0.05, integer: true},
+		{n: 100, p: 0.05, integer: true, negativeCycle: true},
+		{n: 100, p: 0.05},
+		{n: 200, p: 0.05},
+		{n: 100, p: 0.2},
+		{n: 100, p: 0.05, negativeCycle: true},
+	} {
+		// Small integer weights make equal weight
+		// alternative paths common, and non-integer
+		// weights make path weights depend on the
+		// order in which they are summed.
+		g := simple.NewWeightedDirectedGraph(0, math.Inf(1))
+		for u := 0; u < test.n; u++ {
+			g.AddNode(simple.Node(u))
+		}
+		for u := 0; u < test.n; u++ {
+			for v := 0; v < test.n; v++ {
+				if u != v && rnd.Float64() < test.p {
+					w := float64(1 + rnd.IntN(4))
+					if !test.integer {
+						w = 0.1 + rnd.Float64()
+					}
+					g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(u), T: simple.Node(v), W: w})
+				}
+			}
+		}
+		if test.negativeCycle {
+			for _, e := range [][2]int64{{50, 51}, {51, 52}, {52, 50}} {
+				g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(e[0]), T: simple.Node(e[1]), W: -2})
+			}
+		}
+
+		want, wantOK := FloydWarshall(g)
+		for _, cfg := range []struct{ size, workers int }{
+			{size: 7, workers: 1},
+			{size: 16, workers: 2},
+			{size: 16, workers: 3},
+			{size: 64, workers: 8},
+			{size: 256, workers: 8},
+		} {
+			size, workers := cfg.size, cfg.workers
+			got, gotOK := floydWarshallBlocked(g, size, workers)
+			if gotOK != wantOK || gotOK == test.negativeCycle {
+				t.Errorf("unexpected negative cycle result for n=%d p=%v integer=%t size=%d workers=%d: got:%t want:%t",
+					test.n, test.p, test.integer, size, workers, gotOK, !test.negativeCycle)
+			}
+			if !reflect.DeepEqual(got.next, want.next) {
+				t.Errorf("unexpected path reconstruction for n=%d p=%v integer=%t size=%d workers=%d",
+					test.n, test.p, test.integer, size, workers)
+			}
+			for uid := int64(0); uid < int64(test.n); uid++ {
+				for vid := int64(0); vid < int64(test.n); vid++ {
+					gotW, wantW := got.Weight(uid, vid), want.Weight(uid, vid)
+					if math.Float64bits(gotW) != math.Float64bits(wantW) {
+						t.Errorf("unexpected weight %d→%d for n=%d p=%v integer=%t size=%d workers=%d: got:%v want:%v",
+							uid, vid, test.n, test.p, test.integer, size, workers, gotW, wantW)
+						continue
+					}
+					gotPath, _, gotUnique := got.Between(uid, vid)
+					wantPath, _, wantUnique := want.Between(uid, vid)
+					if gotUnique != wantUnique || (wantUnique && !reflect.DeepEqual(pathIDs([][]graph.Node{gotPath}), pathIDs([][]graph.Node{wantPath}))) {
+						t.Errorf("unexpected path %d→%d for n=%d p=%v integer=%t size=%d workers=%d",
+							uid, vid, test.n, test.p, test.integer, size, workers)
+					}
+					gotPaths, _ := got.AllBetween(uid, vid)
+					wantPaths, _ := want.AllBetween(uid, vid)
+					if !reflect.DeepEqual(pathIDs(gotPaths), pathIDs(wantPaths)) {
+						t.Errorf("unexpected paths %d→%d for n=%d p=%v integer=%t size=%d workers=%d",
+							uid, vid, test.n, test.p, test.integer, size, workers)
+					}
+				}
+			}
+		}
+	}
+}
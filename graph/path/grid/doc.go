@@ -0,0 +1,7 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package grid provides path finding functions specialized for
+// two-dimensional uniform-cost grids.
+package grid // import "gonum.org/v1/gonum/graph/path/grid"
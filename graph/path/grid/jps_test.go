@@ -0,0 +1,231 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package grid
+
+import (
+	"math"
+	"math/rand/v2"
+	"strings"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/path"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+// testGrid is a grid where open cells are marked by '.'.
+type testGrid struct {
+	cells [][]bool
+}
+
+func newTestGrid(rows ...string) testGrid {
+	g := testGrid{cells: make([][]bool, len(rows))}
+	for y, r := range rows {
+		g.cells[y] = make([]bool, len(r))
+		for x, c := range r {
+			g.cells[y][x] = c == '.'
+		}
+	}
+	return g
+}
+
+func (g testGrid) width() int { return len(g.cells[0]) }
+
+func (g testGrid) Walkable(x, y int) bool {
+	return 0 <= y && y < len(g.cells) && 0 <= x && x < len(g.cells[y]) && g.cells[y][x]
+}
+
+func (g testGrid) NodeAt(x, y int) graph.Node { return simple.Node(y*g.width() + x) }
+
+func (g testGrid) XY(n graph.Node) (x, y int) {
+	id := int(n.ID())
+	return id % g.width(), id / g.width()
+}
+
+// graphOf returns the 8-connected graph of the open cells of g
+// that do not cut corners.
+func graphOf(g testGrid) *simple.WeightedUndirectedGraph {
+	ug := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+	for y := range g.cells {
+		for x := range g.cells[y] {
+			if !g.Walkable(x, y) {
+				continue
+			}
+			if ug.Node(g.NodeAt(x, y).ID()) == nil {
+				ug.AddNode(g.NodeAt(x, y))
+			}
+			for _, d := range []point{{1, 0}, {0, 1}, {1, 1}, {-1, 1}} {
+				if !g.Walkable(x+d.x, y+d.y) {
+					continue
+				}
+				w := 1.0
+				if d.x != 0 && d.y != 0 {
+					if !g.Walkable(x+d.x, y) || !g.Walkable(x, y+d.y) {
+						continue
+					}
+					w = math.Sqrt2
+				}
+				ug.SetWeightedEdge(simple.WeightedEdge{F: g.NodeAt(x, y), T: g.NodeAt(x+d.x, y+d.y), W: w})
+			}
+		}
+	}
+	return ug
+}
+
+func checkJumpPointSearch(t *testing.T, name string, g testGrid, ug *simple.WeightedUndirectedGraph, s, dst graph.Node) {
+	t.Helper()
+	p, weight, _ := JumpPointSearch(s, dst, g)
+
+	var want float64
+	if ug.Node(s.ID()) == nil || ug.Node(dst.ID()) == nil {
+		want = math.Inf(1)
+	} else {
+		want = path.DijkstraFrom(s, ug).WeightTo(dst.ID())
+	}
+	if math.IsInf(want, 1) {
+		if p != nil || !math.IsInf(weight, 1) {
+			t.Errorf("%s: unexpected path for unreachable target: path=%v weight=%f", name, p, weight)
+		}
+		return
+	}
+	if math.Abs(weight-want) > 1e-9 {
+		t.Errorf("%s: unexpected weight from %d to %d: got:%f want:%f", name, s.ID(), dst.ID(), weight, want)
+	}
+	if len(p) == 0 || p[0].ID() != s.ID() || p[len(p)-1].ID() != dst.ID() {
+		t.Errorf("%s: unexpected path ends: %v", name, p)
+		return
+	}
+	var sum float64
+	for i := 1; i < len(p); i++ {
+		w, ok := ug.Weight(p[i-1].ID(), p[i].ID())
+		if !ok || p[i-1].ID() == p[i].ID() {
+			t.Errorf("%s: path step %d->%d is not an edge in the grid graph", name, p[i-1].ID(), p[i].ID())
+			return
+		}
+		sum += w
+	}
+	if sum != weight {
+		t.Errorf("%s: unexpected path weight: got:%f want:%f", name, sum, weight)
+	}
+}
+
+var jumpPointSearchTests = []struct {
+	name string
+	grid []string
+	s, t point
+}{
+	{
+		name: "open",
+		grid: []string{
+			".....",
+			".....",
+			".....",
+		},
+		s: point{0, 0}, t: point{4, 2},
+	},
+	{
+		name: "source is target",
+		grid: []string{
+			"...",
+		},
+		s: point{1, 0}, t: point{1, 0},
+	},
+	{
+		name: "wall",
+		grid: []string{
+			"..*..",
+			"..*..",
+			"..*..",
+			".....",
+		},
+		s: point{0, 0}, t: point{4, 0},
+	},
+	{
+		name: "no corner cutting",
+		grid: []string{
+			".*",
+			"*.",
+		},
+		s: point{0, 0}, t: point{1, 1},
+	},
+	{
+		name: "corridor",
+		grid: []string{
+			"*.*****",
+			"*.....*",
+			"*****.*",
+			".......",
+		},
+		s: point{1, 0}, t: point{0, 3},
+	},
+	{
+		name: "blocked target",
+		grid: []string{
+			"..*",
+		},
+		s: point{0, 0}, t: point{2, 0},
+	},
+}
+
+func TestJumpPointSearch(t *testing.T) {
+	t.Parallel()
+	for _, test := range jumpPointSearchTests {
+		g := newTestGrid(test.grid...)
+		checkJumpPointSearch(t, test.name, g, graphOf(g), g.NodeAt(test.s.x, test.s.y), g.NodeAt(test.t.x, test.t.y))
+	}
+}
+
+func TestJumpPointSearchRandom(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for n := 0; n < 50; n++ {
+		const size = 20
+		rows := make([]string, size)
+		for y := range rows {
+			var b strings.Builder
+			for x := 0; x < size; x++ {
+				if rnd.Float64() < 0.3 {
+					b.WriteByte('*')
+				} else {
+					b.WriteByte('.')
+				}
+			}
+			rows[y] = b.String()
+		}
+		g := newTestGrid(rows...)
+		ug := graphOf(g)
+		for i := 0; i < 10; i++ {
+			s := g.NodeAt(rnd.IntN(size), rnd.IntN(size))
+			dst := g.NodeAt(rnd.IntN(size), rnd.IntN(size))
+			checkJumpPointSearch(t, "random", g, ug, s, dst)
+		}
+	}
+}
+
+func TestJumpPointSearchExpansions(t *testing.T) {
+	t.Parallel()
+	const size = 100
+	rows := make([]string, size)
+	for y := range rows {
+		rows[y] = strings.Repeat(".", size)
+	}
+	// Add some obstacles so the path is not a straight line.
+	rows[50] = strings.Repeat("*", size-10) + strings.Repeat(".", 10)
+	g := newTestGrid(rows...)
+	ug := graphOf(g)
+
+	s, dst := g.NodeAt(0, 0), g.NodeAt(size-1, size-1)
+	_, _, expanded := JumpPointSearch(s, dst, g)
+	octileHeuristic := func(u, v graph.Node) float64 {
+		ux, uy := g.XY(u)
+		vx, vy := g.XY(v)
+		return octile(point{ux, uy}, point{vx, vy})
+	}
+	_, aStarExpanded := path.AStar(s, dst, ug, octileHeuristic)
+	if expanded*10 > aStarExpanded {
+		t.Errorf("jump point search did not substantially reduce expansions: got:%d A*:%d", expanded, aStarExpanded)
+	}
+	checkJumpPointSearch(t, "expansions", g, ug, s, dst)
+}
@@ -18,46 +18,150 @@ import (
 //
 // The time complexity of JohnsonAllPaths is O(|V|.|E|+|V|^2.log|V|).
 func JohnsonAllPaths(g graph.Graph) (paths AllShortest, ok bool) {
-	adjusted := johnsonWeightAdjuster{Graph: g}
+	j, ok := NewJohnson(g)
+	if !ok {
+		return j.paths, false
+	}
+	return j.allPaths(), true
+}
+
+// Johnson holds the edge reweighting computed by the first phase of Johnson's
+// algorithm for a graph, and answers shortest path queries on the graph using
+// Dijkstra's algorithm over the reweighted edges. Shortest paths from a node
+// are computed on the first query from that node and are retained for later
+// queries until the next call to Reweight.
+//
+// A Johnson is not safe for concurrent use.
+type Johnson struct {
+	adjusted johnsonWeightAdjuster
+
+	// q is the ID of the query node
+	// used for reweighting.
+	q int64
+
+	// paths holds the shortest paths
+	// from the nodes marked in done.
+	paths AllShortest
+	done  []bool
+
+	// ok indicates that the current
+	// reweighting is valid.
+	ok bool
+}
+
+// NewJohnson returns a Johnson for the graph g with the edge reweighting
+// computed from the current weights of g, or false indicating that a negative
+// cycle exists in g. If the graph does not implement Weighted, UniformCost is
+// used. The nodes and edges of g must not be changed during the lifetime of the
+// returned Johnson, although edge weights may be changed between calls to
+// Reweight.
+//
+// The time complexity of NewJohnson is O(|V|.|E|).
+func NewJohnson(g graph.Graph) (j *Johnson, ok bool) {
+	j = &Johnson{adjusted: johnsonWeightAdjuster{Graph: g}}
 	if wg, ok := g.(Weighted); ok {
-		adjusted.weight = wg.Weight
+		j.adjusted.weight = wg.Weight
 	} else {
-		adjusted.weight = UniformCost(g)
+		j.adjusted.weight = UniformCost(g)
 	}
 
-	paths = newAllShortest(graph.NodesOf(g.Nodes()), false)
+	j.paths = newAllShortest(graph.NodesOf(g.Nodes()), false)
 
-	var q int64
 	sign := int64(-1)
 	for {
 		// Choose a random node ID until we find
 		// one that is not in g.
-		q = sign * rand.Int64()
-		if _, exists := paths.indexOf[q]; !exists {
+		j.q = sign * rand.Int64()
+		if _, exists := j.paths.indexOf[j.q]; !exists {
 			break
 		}
 		sign *= -1
 	}
 
-	adjusted.adjustBy, ok = BellmanFordFrom(johnsonGraphNode(q), johnsonReWeight{adjusted, q})
-	if !ok {
-		return paths, false
-	}
+	j.reweight()
+	return j, j.ok
+}
 
-	dijkstraAllPaths(adjusted, paths)
+// Reweight recomputes the edge reweighting from the current edge weights of
+// the graph and discards all previously computed shortest paths. Reweight
+// returns false if a negative cycle now exists in the graph, in which case the
+// Johnson may not be queried until the weights are changed and Reweight
+// returns true. Reweight must be called after any change to the edge weights
+// of the graph before further queries are made.
+//
+// Reweighting avoids a full recomputation only when the nodes and edges of
+// the graph are unchanged. If nodes or edges are added to or removed from the
+// graph, a new Johnson must be constructed with NewJohnson.
+//
+// The time complexity of Reweight is O(|V|.|E|).
+func (j *Johnson) Reweight() (ok bool) {
+	j.paths = newAllShortest(j.paths.nodes, false)
+	j.reweight()
+	return j.ok
+}
+
+func (j *Johnson) reweight() {
+	j.adjusted.adjustBy, j.ok = BellmanFordFrom(johnsonGraphNode(j.q), johnsonReWeight{j.adjusted, j.q})
+	j.done = make([]bool, len(j.paths.nodes))
+}
+
+// Weight returns the weight of the minimum path between u and v.
+// Weight will panic if the last reweighting found a negative cycle.
+func (j *Johnson) Weight(uid, vid int64) float64 {
+	j.from(uid)
+	return j.paths.Weight(uid, vid)
+}
+
+// Between returns a shortest path from u to v and the weight of the path.
+// If more than one shortest path exists between u and v, a randomly chosen
+// path will be returned and unique is returned false. Between will panic if
+// the last reweighting found a negative cycle.
+//
+// The time complexity of the first query from u after construction or
+// reweighting is O(|E|+|V|.log|V|).
+func (j *Johnson) Between(uid, vid int64) (path []graph.Node, weight float64, unique bool) {
+	j.from(uid)
+	return j.paths.Between(uid, vid)
+}
 
-	for i, u := range paths.nodes {
-		hu := adjusted.adjustBy.WeightTo(u.ID())
-		for j, v := range paths.nodes {
-			if i == j {
-				continue
-			}
-			hv := adjusted.adjustBy.WeightTo(v.ID())
-			paths.dist.Set(i, j, paths.dist.At(i, j)-hu+hv)
+// from ensures that the shortest paths from u have been computed.
+func (j *Johnson) from(uid int64) {
+	if !j.ok {
+		panic("path: johnson query with negative cycle")
+	}
+	i, ok := j.paths.indexOf[uid]
+	if !ok || j.done[i] {
+		return
+	}
+	var Q priorityQueue
+	j.row(i, &Q)
+}
+
+// row computes the shortest paths from the node indexed by i
+// over the reweighted edges and restores their original weights.
+func (j *Johnson) row(i int, Q *priorityQueue) {
+	dijkstraAllPathsFrom(i, j.adjusted, j.adjusted.Weight, j.paths, Q)
+	hu := j.adjusted.adjustBy.WeightTo(j.paths.nodes[i].ID())
+	for k, v := range j.paths.nodes {
+		if k == i {
+			continue
 		}
+		hv := j.adjusted.adjustBy.WeightTo(v.ID())
+		j.paths.dist.Set(i, k, j.paths.dist.At(i, k)-hu+hv)
 	}
+	j.done[i] = true
+}
 
-	return paths, ok
+// allPaths returns the shortest paths between all nodes.
+func (j *Johnson) allPaths() AllShortest {
+	var Q priorityQueue
+	for i, done := range j.done {
+		if done {
+			continue
+		}
+		j.row(i, &Q)
+	}
+	return j.paths
 }
 
 // johnsonWeightAdjuster is an edge re-weighted graph constructed
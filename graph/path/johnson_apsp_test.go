@@ -11,6 +11,7 @@ import (
 
 	"gonum.org/v1/gonum/graph"
 	"gonum.org/v1/gonum/graph/path/internal/testgraphs"
+	"gonum.org/v1/gonum/graph/simple"
 	"gonum.org/v1/gonum/internal/order"
 )
 
@@ -119,3 +120,85 @@ func TestJohnsonAllPaths(t *testing.T) {
 		}
 	}
 }
+
+func TestJohnsonReweight(t *testing.T) {
+	t.Parallel()
+	g := simple.NewWeightedDirectedGraph(0, math.Inf(1))
+	for _, e := range []simple.WeightedEdge{
+		{F: simple.Node(0), T: simple.Node(1), W: 4},
+		{F: simple.Node(0), T: simple.Node(2), W: 2},
+		{F: simple.Node(1), T: simple.Node(3), W: -1},
+		{F: simple.Node(2), T: simple.Node(1), W: 1},
+		{F: simple.Node(2), T: simple.Node(3), W: 5},
+		{F: simple.Node(3), T: simple.Node(0), W: 3},
+	} {
+		g.SetWeightedEdge(e)
+	}
+
+	j, ok := NewJohnson(g)
+	if !ok {
+		t.Fatal("unexpected negative cycle")
+	}
+	checkJohnson(t, "initial", j, g)
+
+	// Change weights without changing topology.
+	g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(2), T: simple.Node(1), W: -1})
+	g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(0), T: simple.Node(1), W: 0})
+	if !j.Reweight() {
+		t.Fatal("unexpected negative cycle after reweighting")
+	}
+	checkJohnson(t, "reweighted", j, g)
+
+	// Introduce a negative cycle 0-2-1-3-0.
+	g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(3), T: simple.Node(0), W: -1})
+	if j.Reweight() {
+		t.Fatal("expected negative cycle after reweighting")
+	}
+	panicked := func() (panicked bool) {
+		defer func() { panicked = recover() != nil }()
+		j.Between(0, 3)
+		return false
+	}()
+	if !panicked {
+		t.Error("expected panic for query with negative cycle")
+	}
+
+	// Remove the negative cycle.
+	g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(3), T: simple.Node(0), W: 2})
+	if !j.Reweight() {
+		t.Fatal("unexpected negative cycle after reweighting")
+	}
+	checkJohnson(t, "restored", j, g)
+}
+
+func checkJohnson(t *testing.T, name string, j *Johnson, g graph.Graph) {
+	t.Helper()
+	want, ok := FloydWarshall(g)
+	if !ok {
+		t.Fatalf("%s: unexpected negative cycle in reference", name)
+	}
+	for _, u := range graph.NodesOf(g.Nodes()) {
+		for _, v := range graph.NodesOf(g.Nodes()) {
+			uid, vid := u.ID(), v.ID()
+			_, wantWeight, wantUnique := want.Between(uid, vid)
+			p, weight, unique := j.Between(uid, vid)
+			if weight != wantWeight {
+				t.Errorf("%s: unexpected weight from %d to %d: got:%f want:%f", name, uid, vid, weight, wantWeight)
+			}
+			if unique != wantUnique {
+				t.Errorf("%s: unexpected uniqueness from %d to %d: got:%t want:%t", name, uid, vid, unique, wantUnique)
+			}
+			if w := j.Weight(uid, vid); w != wantWeight {
+				t.Errorf("%s: unexpected weight from Weight from %d to %d: got:%f want:%f", name, uid, vid, w, wantWeight)
+			}
+			var sum float64
+			for k := 1; k < len(p); k++ {
+				w, _ := g.(graph.Weighted).Weight(p[k-1].ID(), p[k].ID())
+				sum += w
+			}
+			if len(p) != 0 && sum != wantWeight {
+				t.Errorf("%s: unexpected path weight from %d to %d: got:%f want:%f", name, uid, vid, sum, wantWeight)
+			}
+		}
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package path
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/internal/set"
+	"gonum.org/v1/gonum/graph/traverse"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+// NewLandmarkHeuristic returns an ALT (A*, landmarks and triangle inequality)
+// heuristic for the graph g using the provided landmarks. The shortest path
+// distances from and to each landmark are computed when NewLandmarkHeuristic
+// is called, so the returned heuristic is only valid for g as it was at that
+// time. The heuristic is admissible and consistent and may be used directly
+// with AStar. If the graph does not implement Weighted, UniformCost is used.
+// NewLandmarkHeuristic will panic if g has a negative edge weight reachable
+// from, or able to reach, a landmark.
+//
+// The heuristic cost between x and y is the largest lower bound on the
+// distance from x to y given by the triangle inequality over the distances
+// between x, y and each landmark. The returned heuristic will return zero for
+// nodes that were not in g.
+//
+// The memory required for the heuristic is O(landmarks × nodes).
+func NewLandmarkHeuristic(g graph.Graph, landmarks []graph.Node) Heuristic {
+	nodes := graph.NodesOf(g.Nodes())
+	indexOf := make(map[int64]int, len(nodes))
+	for i, n := range nodes {
+		indexOf[n.ID()] = i
+	}
+
+	// from[l][i] is the distance from landmark l to node i
+	// and to[l][i] is the distance from node i to landmark l.
+	from := make([][]float64, len(landmarks))
+	to := make([][]float64, len(landmarks))
+	for l, u := range landmarks {
+		from[l] = distancesFrom(u, g, nodes)
+		to[l] = from[l]
+		if dg, ok := g.(graph.Directed); ok {
+			to[l] = distancesFrom(u, reverse(dg), nodes)
+		}
+	}
+
+	return func(x, y graph.Node) float64 {
+		i, ok := indexOf[x.ID()]
+		if !ok {
+			return 0
+		}
+		j, ok := indexOf[y.ID()]
+		if !ok {
+			return 0
+		}
+		var h float64
+		for l := range landmarks {
+			// Differences of infinite distances are NaN and
+			// so are never selected. An infinite difference
+			// is only possible where y is unreachable from x.
+			if d := from[l][j] - from[l][i]; d > h {
+				h = d
+			}
+			if d := to[l][i] - to[l][j]; d > h {
+				h = d
+			}
+		}
+		return h
+	}
+}
+
+// SelectLandmarks returns up to n landmarks from g for use with
+// NewLandmarkHeuristic, chosen using the farthest-point heuristic. The first
+// landmark is the node farthest from the node in g with the lowest ID, and
+// each subsequent landmark is the node with the greatest distance to its
+// nearest already selected landmark. For directed graphs, the distance
+// between a node and a landmark is the shorter of the distances to and from
+// the landmark. Nodes unreachable from the selected landmarks are preferred,
+// so that each connected component is covered. Ties are broken by choosing
+// the node with the lowest ID. If the graph does not implement Weighted,
+// UniformCost is used. SelectLandmarks will panic if g has a negative edge
+// weight.
+func SelectLandmarks(g graph.Graph, n int) []graph.Node {
+	nodes := graph.NodesOf(g.Nodes())
+	if n <= 0 || len(nodes) == 0 {
+		return nil
+	}
+	order.ByID(nodes)
+	n = min(n, len(nodes))
+
+	dg, isDirected := g.(graph.Directed)
+	distance := func(u graph.Node) []float64 {
+		d := distancesFrom(u, g, nodes)
+		if isDirected {
+			for i, w := range distancesFrom(u, reverse(dg), nodes) {
+				d[i] = math.Min(d[i], w)
+			}
+		}
+		return d
+	}
+	farthest := func(dist []float64, selected set.Ints[int64]) graph.Node {
+		var (
+			best graph.Node
+			max  = math.Inf(-1)
+		)
+		for i, u := range nodes {
+			if selected.Has(u.ID()) {
+				continue
+			}
+			if dist[i] > max {
+				best = u
+				max = dist[i]
+			}
+		}
+		return best
+	}
+
+	selected := make(set.Ints[int64])
+	minDist := distance(nodes[0])
+	landmarks := make([]graph.Node, 0, n)
+	for len(landmarks) < n {
+		u := farthest(minDist, selected)
+		if len(landmarks) == 0 {
+			// The first landmark's distances replace
+			// the distances from the starting node.
+			for i := range minDist {
+				minDist[i] = math.Inf(1)
+			}
+		}
+		landmarks = append(landmarks, u)
+		selected.Add(u.ID())
+		for i, d := range distance(u) {
+			minDist[i] = math.Min(minDist[i], d)
+		}
+	}
+	return landmarks
+}
+
+// distancesFrom returns the shortest path distances from u to each of nodes
+// in g.
+func distancesFrom(u graph.Node, g traverse.Graph, nodes []graph.Node) []float64 {
+	pt := DijkstraFrom(u, g)
+	d := make([]float64, len(nodes))
+	for i, v := range nodes {
+		d[i] = pt.WeightTo(v.ID())
+	}
+	return d
+}
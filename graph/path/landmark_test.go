@@ -0,0 +1,162 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package path
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/path/internal/testgraphs"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+func TestLandmarkHeuristic(t *testing.T) {
+	t.Parallel()
+	for _, test := range testgraphs.ShortestPathTests {
+		if test.HasNegativeWeight {
+			continue
+		}
+		g := test.Graph()
+		for _, e := range test.Edges {
+			g.SetWeightedEdge(e)
+		}
+		gg := g.(graph.Graph)
+
+		for _, n := range []int{1, 2, 4} {
+			h := NewLandmarkHeuristic(gg, SelectLandmarks(gg, n))
+
+			pt, _ := AStar(test.Query.From(), test.Query.To(), gg, h)
+			if weight := pt.WeightTo(test.Query.To().ID()); weight != test.Weight {
+				t.Errorf("%q %d landmarks: unexpected weight: got:%f want:%f", test.Name, n, weight, test.Weight)
+			}
+
+			paths := DijkstraAllPaths(gg)
+			nodes := graph.NodesOf(gg.Nodes())
+			for _, x := range nodes {
+				for _, y := range nodes {
+					est := h(x, y)
+					if est > paths.Weight(x.ID(), y.ID()) {
+						t.Errorf("%q %d landmarks: inadmissible heuristic from %d to %d: got:%f want<=%f",
+							test.Name, n, x.ID(), y.ID(), est, paths.Weight(x.ID(), y.ID()))
+					}
+					if math.IsNaN(est) || est < 0 {
+						t.Errorf("%q %d landmarks: invalid heuristic from %d to %d: %f",
+							test.Name, n, x.ID(), y.ID(), est)
+					}
+					to := gg.From(x.ID())
+					for to.Next() {
+						z := to.Node()
+						w, _ := g.(Weighted).Weight(x.ID(), z.ID())
+						if est > w+h(z, y)+1e-12 {
+							t.Errorf("%q %d landmarks: inconsistent heuristic from %d to %d via %d: %f > %f",
+								test.Name, n, x.ID(), y.ID(), z.ID(), est, w+h(z, y))
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestLandmarkHeuristicExpanded(t *testing.T) {
+	t.Parallel()
+	g := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+	const n = 20
+	for i := 1; i < n; i++ {
+		g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(i - 1), T: simple.Node(i), W: 1})
+		g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(0), T: simple.Node(n + i), W: 1})
+	}
+
+	_, nullExpanded := AStar(simple.Node(0), simple.Node(n-1), g, nil)
+	h := NewLandmarkHeuristic(g, []graph.Node{simple.Node(n - 1)})
+	pt, expanded := AStar(simple.Node(0), simple.Node(n-1), g, h)
+	if weight := pt.WeightTo(n - 1); weight != n-1 {
+		t.Errorf("unexpected weight: got:%f want:%d", weight, n-1)
+	}
+	if expanded != n {
+		t.Errorf("unexpected number of expanded nodes: got:%d want:%d", expanded, n)
+	}
+	if expanded >= nullExpanded {
+		t.Errorf("landmark heuristic did not reduce expanded nodes: got:%d null heuristic:%d", expanded, nullExpanded)
+	}
+}
+
+var selectLandmarksTests = []struct {
+	name  string
+	graph func() graph.WeightedEdgeAdder
+	edges []simple.WeightedEdge
+	n     int
+	want  []int64
+}{
+	{
+		name:  "empty",
+		graph: func() graph.WeightedEdgeAdder { return simple.NewWeightedUndirectedGraph(0, math.Inf(1)) },
+		n:     2,
+		want:  nil,
+	},
+	{
+		name:  "path",
+		graph: func() graph.WeightedEdgeAdder { return simple.NewWeightedUndirectedGraph(0, math.Inf(1)) },
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 1},
+			{F: simple.Node(1), T: simple.Node(2), W: 1},
+			{F: simple.Node(2), T: simple.Node(3), W: 1},
+			{F: simple.Node(3), T: simple.Node(4), W: 1},
+		},
+		n:    3,
+		want: []int64{4, 0, 2},
+	},
+	{
+		name:  "too many",
+		graph: func() graph.WeightedEdgeAdder { return simple.NewWeightedUndirectedGraph(0, math.Inf(1)) },
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 1},
+		},
+		n:    3,
+		want: []int64{1, 0},
+	},
+	{
+		name:  "disconnected",
+		graph: func() graph.WeightedEdgeAdder { return simple.NewWeightedUndirectedGraph(0, math.Inf(1)) },
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 1},
+			{F: simple.Node(1), T: simple.Node(2), W: 5},
+			{F: simple.Node(3), T: simple.Node(4), W: 1},
+		},
+		n:    2,
+		want: []int64{3, 0},
+	},
+	{
+		name:  "directed",
+		graph: func() graph.WeightedEdgeAdder { return simple.NewWeightedDirectedGraph(0, math.Inf(1)) },
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 1},
+			{F: simple.Node(1), T: simple.Node(2), W: 1},
+			{F: simple.Node(2), T: simple.Node(3), W: 1},
+		},
+		n:    2,
+		want: []int64{3, 0},
+	},
+}
+
+func TestSelectLandmarks(t *testing.T) {
+	t.Parallel()
+	for _, test := range selectLandmarksTests {
+		g := test.graph()
+		for _, e := range test.edges {
+			g.SetWeightedEdge(e)
+		}
+
+		var got []int64
+		for _, n := range SelectLandmarks(g.(graph.Graph), test.n) {
+			got = append(got, n.ID())
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("unexpected landmarks for %q: got:%v want:%v", test.name, got, test.want)
+		}
+	}
+}
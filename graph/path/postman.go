@@ -0,0 +1,133 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package path
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/matching"
+)
+
+// RoutePostman returns a minimum weight closed walk in the undirected graph g
+// that traverses every edge at least once, and the total weight of the walk,
+// solving the Chinese postman or route inspection problem. The nodes of g with
+// odd degree are paired by a minimum weight perfect matching of their shortest
+// path distances, the edges of each shortest path between paired nodes are
+// duplicated, and the walk is an Eulerian circuit of the resulting graph. Self
+// edges and lines of a graph.UndirectedMultigraph are handled as described for
+// EulerianCircuit, and the edge returned by g.WeightedEdge is used for each
+// duplicated edge. If g is a graph.WeightedMultigraph, the shortest paths are
+// found using the lowest weight line between each pair of nodes, and that line
+// is used for each duplicated edge, rather than the edge returned by
+// g.WeightedEdge, which may combine the weights of parallel lines.
+//
+// The returned edges are oriented in the direction of traversal and the walk
+// starts and ends at the lowest ID node with an edge. If the edges of g are
+// not all connected, RoutePostman returns nil and +Inf. If g has no edges,
+// RoutePostman returns nil and zero. RoutePostman will panic if g has a
+// negative edge weight.
+//
+// The time complexity of RoutePostman is O(|V|^3 + |V||E|.log|V|).
+func RoutePostman(g graph.WeightedUndirected) ([]graph.Edge, float64) {
+	e, deg := newUndirectedEuler(g)
+	start := -1
+	var odd []int
+	for i, d := range deg {
+		if d%2 != 0 {
+			odd = append(odd, i)
+		}
+		if start < 0 && d != 0 {
+			start = i
+		}
+	}
+
+	if len(odd) != 0 {
+		var sg graph.WeightedUndirected = g
+		if mg, ok := g.(graph.WeightedMultigraph); ok {
+			sg = lightestLines{WeightedUndirected: g, mg: mg}
+		}
+		paths := make([]Shortest, len(odd))
+		for i, u := range odd {
+			paths[i] = DijkstraFrom(e.nodes[u], sg)
+		}
+		mate, _ := matching.MinWeightPerfectMatching(func(i, j int) float64 {
+			return paths[i].WeightTo(e.nodes[odd[j]].ID())
+		}, len(odd))
+		if mate == nil {
+			return nil, math.Inf(1)
+		}
+
+		// Duplicate the edges of the shortest
+		// path between each matched pair.
+		index := e.index()
+		for i, j := range mate {
+			if j < i {
+				continue
+			}
+			p, _ := paths[i].To(e.nodes[odd[j]].ID())
+			for k := 1; k < len(p); k++ {
+				e.addEdge(index[p[k-1].ID()], index[p[k].ID()], sg.WeightedEdge(p[k-1].ID(), p[k].ID()))
+			}
+		}
+	}
+
+	walk, ok := e.walk(start)
+	if !ok {
+		return nil, math.Inf(1)
+	}
+	var weight float64
+	for _, edge := range walk {
+		if we, ok := edge.(graph.WeightedEdge); ok {
+			weight += we.Weight()
+		} else {
+			w, _ := g.Weight(edge.From().ID(), edge.To().ID())
+			weight += w
+		}
+	}
+	return walk, weight
+}
+
+// lightestLines is a weighted undirected multigraph where the weight of
+// the edge between a pair of nodes is the weight of the lowest weight line
+// between them.
+type lightestLines struct {
+	graph.WeightedUndirected
+	mg graph.WeightedMultigraph
+}
+
+// lightest returns the lowest weight line from u to v, or nil if there
+// is no such line. Lines with equal weight are ordered by ID.
+func (g lightestLines) lightest(uid, vid int64) graph.WeightedLine {
+	var best graph.WeightedLine
+	lines := g.mg.WeightedLines(uid, vid)
+	for lines.Next() {
+		l := lines.WeightedLine()
+		if best == nil || l.Weight() < best.Weight() || (l.Weight() == best.Weight() && l.ID() < best.ID()) {
+			best = l
+		}
+	}
+	return best
+}
+
+// WeightedEdge returns the lowest weight line from u to v as a
+// graph.WeightedEdge if such a line exists and nil otherwise.
+func (g lightestLines) WeightedEdge(uid, vid int64) graph.WeightedEdge {
+	l := g.lightest(uid, vid)
+	if l == nil {
+		return nil
+	}
+	return weightedLineEdge{l}
+}
+
+// Weight returns the weight of the lowest weight line between x and y,
+// or the weight returned by the multigraph if there is no such line.
+func (g lightestLines) Weight(xid, yid int64) (w float64, ok bool) {
+	l := g.lightest(xid, yid)
+	if l == nil {
+		return g.WeightedUndirected.Weight(xid, yid)
+	}
+	return l.Weight(), true
+}
@@ -0,0 +1,265 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package path
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/multi"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+var routePostmanTests = []struct {
+	name  string
+	edges []simple.WeightedEdge
+
+	wantWeight float64
+	wantLen    int
+}{
+	{
+		name:       "empty",
+		wantWeight: 0,
+		wantLen:    0,
+	},
+	{
+		name: "cycle",
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 1},
+			{F: simple.Node(1), T: simple.Node(2), W: 2},
+			{F: simple.Node(2), T: simple.Node(3), W: 3},
+			{F: simple.Node(3), T: simple.Node(0), W: 4},
+		},
+		wantWeight: 10,
+		wantLen:    4,
+	},
+	{
+		name: "path",
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 1},
+			{F: simple.Node(1), T: simple.Node(2), W: 2},
+		},
+		wantWeight: 6,
+		wantLen:    4,
+	},
+	{
+		// The odd nodes 1 and 3 are joined by a heavy
+		// edge, so the path through 2 is duplicated.
+		name: "detour",
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 1},
+			{F: simple.Node(1), T: simple.Node(2), W: 1},
+			{F: simple.Node(2), T: simple.Node(3), W: 1},
+			{F: simple.Node(3), T: simple.Node(0), W: 1},
+			{F: simple.Node(1), T: simple.Node(3), W: 5},
+		},
+		wantWeight: 11,
+		wantLen:    7,
+	},
+	{
+		// K4 has four odd nodes.
+		name: "K4",
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 1},
+			{F: simple.Node(0), T: simple.Node(2), W: 2},
+			{F: simple.Node(0), T: simple.Node(3), W: 3},
+			{F: simple.Node(1), T: simple.Node(2), W: 3},
+			{F: simple.Node(1), T: simple.Node(3), W: 2},
+			{F: simple.Node(2), T: simple.Node(3), W: 1},
+		},
+		wantWeight: 14,
+		wantLen:    8,
+	},
+	{
+		name: "disconnected",
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 1},
+			{F: simple.Node(2), T: simple.Node(3), W: 1},
+		},
+		wantWeight: math.Inf(1),
+	},
+}
+
+func TestRoutePostman(t *testing.T) {
+	t.Parallel()
+	for _, test := range routePostmanTests {
+		g := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+		for _, e := range test.edges {
+			g.SetWeightedEdge(e)
+		}
+		walk, weight := RoutePostman(g)
+		if weight != test.wantWeight {
+			t.Errorf("unexpected weight for %q: got:%v want:%v", test.name, weight, test.wantWeight)
+		}
+		if len(walk) != test.wantLen {
+			t.Errorf("unexpected walk length for %q: got:%d want:%d", test.name, len(walk), test.wantLen)
+		}
+		if walk != nil {
+			checkPostmanWalk(t, test.name, g, walk, weight)
+		}
+	}
+}
+
+func TestRoutePostmanRandom(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for trial := 0; trial < 20; trial++ {
+		const n = 10
+		g := randomConnectedGraph(n, rnd)
+		var sum float64
+		for _, e := range graph.WeightedEdgesOf(g.WeightedEdges()) {
+			sum += e.Weight()
+		}
+
+		paths := DijkstraAllPaths(g)
+		var odd []int64
+		for _, u := range graph.NodesOf(g.Nodes()) {
+			if g.From(u.ID()).Len()%2 != 0 {
+				odd = append(odd, u.ID())
+			}
+		}
+		want := sum + bruteOddPairing(odd, func(u, v int64) float64 { return paths.Weight(u, v) })
+
+		walk, weight := RoutePostman(g)
+		if weight != want {
+			t.Errorf("unexpected weight for graph %d: got:%v want:%v", trial, weight, want)
+		}
+		checkPostmanWalk(t, "random", g, walk, weight)
+	}
+}
+
+func TestRoutePostmanMultigraph(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name  string
+		lines [][3]float64
+
+		wantWeight float64
+		wantLen    int
+	}{
+		{
+			// Each line is traversed once and the
+			// lightest line is duplicated.
+			name:       "parallel lines",
+			lines:      [][3]float64{{0, 1, 1}, {0, 1, 10}, {0, 1, 100}},
+			wantWeight: 112,
+			wantLen:    4,
+		},
+		{
+			// The odd nodes 0 and 2 are closer through 1
+			// using the light lines than by the heavy
+			// line joining them directly.
+			name: "light detour",
+			lines: [][3]float64{
+				{0, 1, 1}, {0, 1, 20},
+				{1, 2, 2}, {1, 2, 30},
+				{0, 2, 50},
+			},
+			wantWeight: 106,
+			wantLen:    7,
+		},
+	} {
+		g := multi.NewWeightedUndirectedGraph()
+		for _, l := range test.lines {
+			g.SetWeightedLine(g.NewWeightedLine(multi.Node(l[0]), multi.Node(l[1]), l[2]))
+		}
+		walk, weight := RoutePostman(g)
+		if weight != test.wantWeight {
+			t.Errorf("unexpected weight for %q: got:%v want:%v", test.name, weight, test.wantWeight)
+		}
+		if len(walk) != test.wantLen {
+			t.Errorf("unexpected walk length for %q: got:%d want:%d", test.name, len(walk), test.wantLen)
+		}
+
+		var got float64
+		seen := make(map[int64]bool)
+		for i, e := range walk {
+			if i > 0 && walk[i-1].To().ID() != e.From().ID() {
+				t.Errorf("walk for %q is not contiguous at %d", test.name, i)
+			}
+			got += e.(graph.WeightedEdge).Weight()
+			l, ok := e.(graph.Line)
+			if !ok {
+				t.Errorf("walk for %q holds edge that is not a line: %T", test.name, e)
+				continue
+			}
+			seen[l.ID()] = true
+		}
+		if walk[0].From().ID() != walk[len(walk)-1].To().ID() {
+			t.Errorf("walk for %q is not closed", test.name)
+		}
+		if got != weight {
+			t.Errorf("weight does not match walk for %q: got:%v want:%v", test.name, weight, got)
+		}
+		lines := g.WeightedLines
+		for _, u := range graph.NodesOf(g.Nodes()) {
+			for _, v := range graph.NodesOf(g.From(u.ID())) {
+				it := lines(u.ID(), v.ID())
+				for it.Next() {
+					if !seen[it.WeightedLine().ID()] {
+						t.Errorf("walk for %q does not traverse line %d", test.name, it.WeightedLine().ID())
+					}
+				}
+			}
+		}
+	}
+}
+
+// checkPostmanWalk checks that walk is a closed walk in g that traverses
+// every edge of g at least once and has the given weight.
+func checkPostmanWalk(t *testing.T, name string, g graph.WeightedUndirected, walk []graph.Edge, weight float64) {
+	t.Helper()
+	seen := make(map[[2]int64]bool)
+	var got float64
+	for i, e := range walk {
+		uid, vid := e.From().ID(), e.To().ID()
+		if i > 0 && walk[i-1].To().ID() != uid {
+			t.Errorf("walk for %q is not contiguous at %d", name, i)
+		}
+		w, ok := g.Weight(uid, vid)
+		if !ok {
+			t.Errorf("walk for %q follows non-edge %d-%d", name, uid, vid)
+		}
+		got += w
+		if vid < uid {
+			uid, vid = vid, uid
+		}
+		seen[[2]int64{uid, vid}] = true
+	}
+	if walk[0].From().ID() != walk[len(walk)-1].To().ID() {
+		t.Errorf("walk for %q is not closed", name)
+	}
+	if got != weight {
+		t.Errorf("weight does not match walk for %q: got:%v want:%v", name, weight, got)
+	}
+	edges := g.(interface{ Edges() graph.Edges }).Edges()
+	for edges.Next() {
+		uid, vid := edges.Edge().From().ID(), edges.Edge().To().ID()
+		if vid < uid {
+			uid, vid = vid, uid
+		}
+		if !seen[[2]int64{uid, vid}] {
+			t.Errorf("walk for %q does not traverse %d-%d", name, uid, vid)
+		}
+	}
+}
+
+// bruteOddPairing returns the minimum total distance of a pairing of the
+// nodes by exhaustive search.
+func bruteOddPairing(nodes []int64, dist func(u, v int64) float64) float64 {
+	if len(nodes) == 0 {
+		return 0
+	}
+	best := math.Inf(1)
+	for j := 1; j < len(nodes); j++ {
+		rest := make([]int64, 0, len(nodes)-2)
+		rest = append(rest, nodes[1:j]...)
+		rest = append(rest, nodes[j+1:]...)
+		best = math.Min(best, dist(nodes[0], nodes[j])+bruteOddPairing(rest, dist))
+	}
+	return best
+}
@@ -0,0 +1,110 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package path
+
+import (
+	"math/rand/v2"
+	"sort"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+// RandomWalk returns a random walk in g of at most length steps from start.
+// Each step moves to a node chosen uniformly from the nodes reachable from the
+// current node by an edge of g, so for an undirected graph the walk may step
+// back along the edge it arrived by. The walk ends early at a node with no
+// neighbours. The returned walk begins with start, so it holds at most length+1
+// nodes. If start is not in g, RandomWalk returns nil.
+//
+// The neighbours of each node are considered in order of ID, so the walk is
+// deterministic for a given g and random source. If src is not nil it is used
+// as the random source, otherwise rand.IntN is used. RandomWalk will panic if
+// length is negative.
+func RandomWalk(g graph.Graph, start graph.Node, length int, src rand.Source) []graph.Node {
+	if length < 0 {
+		panic("path: negative walk length")
+	}
+	if g.Node(start.ID()) == nil {
+		return nil
+	}
+	rndN := rand.IntN
+	if src != nil {
+		rndN = rand.New(src).IntN
+	}
+
+	walk := make([]graph.Node, 1, length+1)
+	walk[0] = start
+	u := start
+	for len(walk) <= length {
+		to := graph.NodesOf(g.From(u.ID()))
+		if len(to) == 0 {
+			break
+		}
+		order.ByID(to)
+		u = to[rndN(len(to))]
+		walk = append(walk, u)
+	}
+	return walk
+}
+
+// WeightedRandomWalk returns a random walk in g of at most length steps from
+// start, with each step moving to a node chosen from the nodes reachable from
+// the current node with probability proportional to the weight of the edge to
+// it. The walk ends early at a node with no neighbours or only zero weight
+// edges to its neighbours. The returned walk is otherwise as described for
+// RandomWalk, and is deterministic for a given g and random source. If src is
+// not nil it is used as the random source, otherwise rand.Float64 is used.
+//
+// WeightedRandomWalk will panic if length is negative or if the walk reaches
+// a negative edge weight.
+func WeightedRandomWalk(g graph.Weighted, start graph.Node, length int, src rand.Source) []graph.Node {
+	if length < 0 {
+		panic("path: negative walk length")
+	}
+	if g.Node(start.ID()) == nil {
+		return nil
+	}
+	rnd := rand.Float64
+	if src != nil {
+		rnd = rand.New(src).Float64
+	}
+
+	walk := make([]graph.Node, 1, length+1)
+	walk[0] = start
+	u := start
+	var cum []float64
+	for len(walk) <= length {
+		uid := u.ID()
+		to := graph.NodesOf(g.From(uid))
+		order.ByID(to)
+		cum = cum[:0]
+		var total float64
+		for _, v := range to {
+			w, _ := g.Weight(uid, v.ID())
+			if w < 0 {
+				panic("path: negative edge weight in random walk")
+			}
+			total += w
+			cum = append(cum, total)
+		}
+		if total == 0 {
+			break
+		}
+		// Find the first node with a cumulative
+		// weight exceeding the sample, so zero
+		// weight edges are never followed.
+		r := rnd() * total
+		i := sort.Search(len(cum), func(i int) bool { return cum[i] > r })
+		if i == len(cum) {
+			// Guard against rounding by taking the
+			// last node with a non-zero weight edge.
+			i = sort.SearchFloat64s(cum, total)
+		}
+		u = to[i]
+		walk = append(walk, u)
+	}
+	return walk
+}
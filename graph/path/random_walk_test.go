@@ -0,0 +1,126 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package path
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+func TestRandomWalk(t *testing.T) {
+	t.Parallel()
+	g := simple.NewDirectedGraph()
+	for _, e := range [][2]int64{{0, 1}, {0, 2}, {0, 3}, {1, 0}, {2, 0}, {3, 4}} {
+		g.SetEdge(simple.Edge{F: simple.Node(e[0]), T: simple.Node(e[1])})
+	}
+
+	if walk := RandomWalk(g, simple.Node(10), 5, nil); walk != nil {
+		t.Errorf("unexpected walk from missing node: %v", walk)
+	}
+	if walk := RandomWalk(g, simple.Node(4), 5, nil); len(walk) != 1 || walk[0].ID() != 4 {
+		t.Errorf("unexpected walk from dead end: %v", walk)
+	}
+	if walk := RandomWalk(g, simple.Node(0), 0, nil); len(walk) != 1 || walk[0].ID() != 0 {
+		t.Errorf("unexpected zero length walk: %v", walk)
+	}
+
+	counts := make(map[int64]int)
+	const samples = 3000
+	src := rand.NewPCG(1, 1)
+	for i := 0; i < samples; i++ {
+		walk := RandomWalk(g, simple.Node(0), 10, src)
+		checkWalk(t, g, walk, 10)
+		if len(walk) < 11 && walk[len(walk)-1].ID() != 4 {
+			t.Errorf("walk ended early away from dead end: %v", walk)
+		}
+		counts[walk[1].ID()]++
+	}
+	for id := int64(1); id <= 3; id++ {
+		if math.Abs(float64(counts[id])-samples/3) > 150 {
+			t.Errorf("unexpected frequency of first step to %d: got:%d want:%d±150", id, counts[id], samples/3)
+		}
+	}
+
+	a := RandomWalk(g, simple.Node(0), 20, rand.NewPCG(2, 2))
+	b := RandomWalk(g, simple.Node(0), 20, rand.NewPCG(2, 2))
+	if !sameIDs(a, b) {
+		t.Errorf("walk not deterministic for fixed source: %v != %v", a, b)
+	}
+}
+
+func TestWeightedRandomWalk(t *testing.T) {
+	t.Parallel()
+	g := simple.NewWeightedDirectedGraph(0, 0)
+	for _, e := range []simple.WeightedEdge{
+		{F: simple.Node(0), T: simple.Node(1), W: 1},
+		{F: simple.Node(0), T: simple.Node(2), W: 3},
+		{F: simple.Node(0), T: simple.Node(3), W: 0},
+		{F: simple.Node(1), T: simple.Node(0), W: 1},
+		{F: simple.Node(2), T: simple.Node(0), W: 1},
+		{F: simple.Node(3), T: simple.Node(0), W: 1},
+		{F: simple.Node(4), T: simple.Node(0), W: 0},
+	} {
+		g.SetWeightedEdge(e)
+	}
+
+	if walk := WeightedRandomWalk(g, simple.Node(4), 5, nil); len(walk) != 1 {
+		t.Errorf("unexpected walk from zero weight node: %v", walk)
+	}
+
+	counts := make(map[int64]int)
+	const samples = 4000
+	src := rand.NewPCG(1, 1)
+	for i := 0; i < samples; i++ {
+		walk := WeightedRandomWalk(g, simple.Node(0), 4, src)
+		checkWalk(t, g, walk, 4)
+		if len(walk) != 5 {
+			t.Errorf("unexpected walk length: got:%d want:5", len(walk))
+		}
+		for j := 1; j < len(walk); j += 2 {
+			counts[walk[j].ID()]++
+		}
+	}
+	if counts[3] != 0 {
+		t.Errorf("zero weight edge followed %d times", counts[3])
+	}
+	if ratio := float64(counts[2]) / float64(counts[1]); math.Abs(ratio-3) > 0.3 {
+		t.Errorf("unexpected step frequency ratio: got:%v want:3", ratio)
+	}
+
+	a := WeightedRandomWalk(g, simple.Node(0), 20, rand.NewPCG(2, 2))
+	b := WeightedRandomWalk(g, simple.Node(0), 20, rand.NewPCG(2, 2))
+	if !sameIDs(a, b) {
+		t.Errorf("walk not deterministic for fixed source: %v != %v", a, b)
+	}
+}
+
+// checkWalk checks that walk is a walk in g with at most length steps.
+func checkWalk(t *testing.T, g graph.Graph, walk []graph.Node, length int) {
+	t.Helper()
+	if len(walk) > length+1 {
+		t.Errorf("walk too long: got:%d want:<=%d", len(walk), length+1)
+	}
+	for i := 1; i < len(walk); i++ {
+		if g.Edge(walk[i-1].ID(), walk[i].ID()) == nil {
+			t.Errorf("walk follows non-edge %d->%d", walk[i-1].ID(), walk[i].ID())
+		}
+	}
+}
+
+func sameIDs(a, b []graph.Node) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].ID() != b[i].ID() {
+			return false
+		}
+	}
+	return true
+}
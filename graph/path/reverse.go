@@ -0,0 +1,79 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package path
+
+import "gonum.org/v1/gonum/graph"
+
+// reversedGraph is a directed graph with the direction of its edges
+// reversed, presenting the To method of the underlying graph as From.
+type reversedGraph struct {
+	g      graph.Directed
+	weight Weighting
+}
+
+var (
+	_ graph.Directed = reversedGraph{}
+	_ Weighted       = reversedGraph{}
+)
+
+// reverse returns a view of g with the direction of all its edges reversed,
+// so that searches over the returned graph follow edges of g backwards.
+// Weights are taken from g, or from UniformCost if g does not implement
+// Weighted. The returned graph reflects later changes to g. reverse panics
+// if g is undirected.
+func reverse(g graph.Directed) graph.Graph {
+	if _, ok := g.(graph.Undirected); ok {
+		panic("path: reverse of undirected graph")
+	}
+	r := reversedGraph{g: g}
+	if wg, ok := g.(Weighted); ok {
+		r.weight = wg.Weight
+	} else {
+		r.weight = UniformCost(g)
+	}
+	return r
+}
+
+// Node returns the node with the given ID if it exists in the graph,
+// and nil otherwise.
+func (g reversedGraph) Node(id int64) graph.Node { return g.g.Node(id) }
+
+// Nodes returns all the nodes in the graph.
+func (g reversedGraph) Nodes() graph.Nodes { return g.g.Nodes() }
+
+// From returns all nodes that can be reached directly from the node
+// with the given ID, which are the nodes with edges to it in the
+// underlying graph.
+func (g reversedGraph) From(id int64) graph.Nodes { return g.g.To(id) }
+
+// To returns all nodes that can reach directly to the node with the
+// given ID, which are the nodes it has edges to in the underlying graph.
+func (g reversedGraph) To(id int64) graph.Nodes { return g.g.From(id) }
+
+// HasEdgeBetween returns whether an edge exists between nodes with
+// IDs xid and yid without considering direction.
+func (g reversedGraph) HasEdgeBetween(xid, yid int64) bool { return g.g.HasEdgeBetween(xid, yid) }
+
+// HasEdgeFromTo returns whether an edge exists in the graph from u to v
+// with IDs uid and vid.
+func (g reversedGraph) HasEdgeFromTo(uid, vid int64) bool { return g.g.HasEdgeFromTo(vid, uid) }
+
+// Edge returns the edge from u to v with IDs uid and vid if such an edge
+// exists and nil otherwise. The returned edge is the reversal of the edge
+// from v to u in the underlying graph.
+func (g reversedGraph) Edge(uid, vid int64) graph.Edge {
+	e := g.g.Edge(vid, uid)
+	if e == nil {
+		return nil
+	}
+	return e.ReversedEdge()
+}
+
+// Weight returns the weight for the edge between x and y with IDs xid
+// and yid, which is the weight of the edge from y to x in the underlying
+// graph.
+func (g reversedGraph) Weight(xid, yid int64) (w float64, ok bool) {
+	return g.weight(yid, xid)
+}
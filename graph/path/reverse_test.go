@@ -0,0 +1,75 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package path
+
+import (
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/path/internal/testgraphs"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+func TestReverse(t *testing.T) {
+	t.Parallel()
+	for _, test := range testgraphs.ShortestPathTests {
+		if test.HasNegativeWeight {
+			continue
+		}
+		g := test.Graph()
+		for _, e := range test.Edges {
+			g.SetWeightedEdge(e)
+		}
+		dg, ok := g.(graph.Directed)
+		if !ok {
+			continue
+		}
+		r := reverse(dg)
+
+		for _, u := range graph.NodesOf(dg.Nodes()) {
+			for _, v := range graph.NodesOf(dg.From(u.ID())) {
+				e := r.Edge(v.ID(), u.ID())
+				if e == nil {
+					t.Errorf("%q: missing reversed edge %d->%d", test.Name, v.ID(), u.ID())
+					continue
+				}
+				if e.From().ID() != v.ID() || e.To().ID() != u.ID() {
+					t.Errorf("%q: unexpected reversed edge for %d->%d: got:%d->%d", test.Name, u.ID(), v.ID(), e.From().ID(), e.To().ID())
+				}
+				if !r.(graph.Directed).HasEdgeFromTo(v.ID(), u.ID()) {
+					t.Errorf("%q: missing reversed edge %d->%d from HasEdgeFromTo", test.Name, v.ID(), u.ID())
+				}
+			}
+		}
+
+		s, tid := test.Query.From(), test.Query.To().ID()
+		want := DijkstraFrom(s, dg).WeightTo(tid)
+		got := DijkstraFrom(test.Query.To(), r).WeightTo(s.ID())
+		if got != want {
+			t.Errorf("%q: unexpected reverse path weight: got:%f want:%f", test.Name, got, want)
+		}
+	}
+}
+
+// undirectedWithTo is an undirected graph that
+// also satisfies graph.Directed.
+type undirectedWithTo struct {
+	*simple.UndirectedGraph
+}
+
+func (g undirectedWithTo) HasEdgeFromTo(uid, vid int64) bool { return g.HasEdgeBetween(uid, vid) }
+func (g undirectedWithTo) To(id int64) graph.Nodes           { return g.From(id) }
+
+func TestReverseUndirected(t *testing.T) {
+	t.Parallel()
+	panicked := func() (panicked bool) {
+		defer func() { panicked = recover() != nil }()
+		reverse(undirectedWithTo{simple.NewUndirectedGraph()})
+		return false
+	}()
+	if !panicked {
+		t.Error("expected panic for reverse of undirected graph")
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"math"
 	"math/rand/v2"
 	"slices"
+	"sync"
 
 	"gonum.org/v1/gonum/floats/scalar"
 	"gonum.org/v1/gonum/graph"
@@ -61,6 +62,23 @@ type Shortest struct {
 	// routines that can handle negative
 	// edge weights.
 	negCosts map[negEdge]float64
+
+	// beyond holds the IDs of nodes
+	// found to be reachable at a distance
+	// greater than the search radius by
+	// DijkstraFromWithin.
+	beyond set.Ints[int64]
+
+	// children holds the lazily
+	// constructed inversion of next.
+	children *shortestChildren
+}
+
+// shortestChildren is the inversion of a
+// shortest-path tree's predecessors.
+type shortestChildren struct {
+	once  sync.Once
+	nodes [][]int
 }
 
 // newShortestFrom returns a shortest path tree for paths from u
@@ -84,6 +102,8 @@ func newShortestFrom(u graph.Node, nodes []graph.Node) Shortest {
 
 		dist: make([]float64, len(nodes)),
 		next: make([]int, len(nodes)),
+
+		children: &shortestChildren{},
 	}
 	for i := range nodes {
 		p.dist[i] = math.Inf(1)
@@ -145,6 +165,14 @@ func (p Shortest) WeightTo(vid int64) float64 {
 	return p.dist[to]
 }
 
+// Beyond returns whether the node v was found by DijkstraFromWithin to be
+// reachable from the source only by paths with a weight greater than the
+// search radius. Beyond always returns false for a Shortest returned by
+// other functions.
+func (p Shortest) Beyond(vid int64) bool {
+	return p.beyond.Has(vid)
+}
+
 // To returns a shortest path to v and the weight of the path. If the path
 // to v includes a negative cycle, one pass through the cycle will be included
 // in path, but any path leading into the negative cycle will be lost, and
@@ -187,6 +215,39 @@ func (p Shortest) To(vid int64) (path []graph.Node, weight float64) {
 	return path, math.Min(weight, p.dist[p.indexOf[vid]])
 }
 
+// Children returns the children of u in the shortest-path tree, the nodes
+// whose shortest path from the starting node ends with an edge from u. The
+// children are returned in the order the nodes are held by the Shortest.
+// Together with To, Children allows the shortest-path tree to be walked in
+// both directions. If the Shortest includes a negative cycle, the returned
+// nodes reflect the predecessors recorded by the search, which may include
+// the cycle.
+//
+// The inversion of the shortest-path tree is constructed on the first call
+// to Children, taking O(|V|) time.
+func (p Shortest) Children(uid int64) []graph.Node {
+	u, ok := p.indexOf[uid]
+	if !ok {
+		return nil
+	}
+	p.children.once.Do(func() {
+		p.children.nodes = make([][]int, len(p.nodes))
+		for v, prev := range p.next {
+			if prev >= 0 {
+				p.children.nodes[prev] = append(p.children.nodes[prev], v)
+			}
+		}
+	})
+	if len(p.children.nodes[u]) == 0 {
+		return nil
+	}
+	children := make([]graph.Node, len(p.children.nodes[u]))
+	for i, v := range p.children.nodes[u] {
+		children[i] = p.nodes[v]
+	}
+	return children
+}
+
 // ShortestAlts is a shortest-path tree created by the BellmanFordAllFrom or DijkstraAllFrom
 // single-source shortest path functions.
 type ShortestAlts struct {
@@ -749,6 +810,206 @@ func (p AllShortest) AllBetweenFunc(uid, vid int64, fn func(path []graph.Node))
 	p.allBetween(from, to, seen, []graph.Node{n}, fn)
 }
 
+// PathIter returns an iterator over the shortest paths from u to v held by p.
+// The iterator holds reusable buffers, so iterating over paths and their nodes
+// does not allocate after the first path, and the iterator may be reused for
+// another pair of nodes by calling Reset.
+func (p AllShortest) PathIter(uid, vid int64) *PathIter {
+	it := &PathIter{p: p}
+	it.Reset(uid, vid)
+	return it
+}
+
+// PathIter is an iterator over the shortest paths between a pair of nodes
+// held by an AllShortest. Paths containing zero-weight cycles are not
+// considered, matching AllBetween. If a negative cycle exists between the
+// nodes, no path is considered.
+//
+// A PathIter is used by calling NextPath to advance to each shortest path
+// and then calling Next and Node to step through the nodes of that path:
+//
+//	it := paths.PathIter(uid, vid)
+//	for it.NextPath() {
+//		for it.Next() {
+//			n := it.Node()
+//			...
+//		}
+//	}
+type PathIter struct {
+	p AllShortest
+
+	// from and to are the indices of the
+	// end points of the paths, and start
+	// and target are the indices of the
+	// nodes at the start and end of the
+	// reconstruction.
+	from, to      int
+	start, target int
+
+	// single holds the only path when the
+	// path does not require reconstruction.
+	single graph.Node
+
+	weight float64
+
+	stack []pathIterFrame
+	path  []graph.Node
+	seen  []bool
+
+	// state is the state of the iterator;
+	// one of pathIterStart, pathIterPath
+	// or pathIterDone.
+	state int
+	pos   int
+}
+
+// pathIterFrame is a frame of the depth-first reconstruction of paths.
+type pathIterFrame struct {
+	// node is the index of the node
+	// and choice is the index of the
+	// next candidate to consider in
+	// the node's list of intermediates.
+	node, choice int
+}
+
+const (
+	pathIterStart = iota
+	pathIterPath
+	pathIterDone
+)
+
+// Reset resets the iterator to iterate over the shortest paths from u to v,
+// retaining its buffers.
+func (it *PathIter) Reset(uid, vid int64) {
+	p := it.p
+	it.stack = it.stack[:0]
+	it.path = it.path[:0]
+	it.single = nil
+	it.state = pathIterStart
+	it.pos = -1
+	it.weight = math.Inf(1)
+
+	from, fromOK := p.indexOf[uid]
+	to, toOK := p.indexOf[vid]
+	if !fromOK || !toOK || len(p.at(from, to)) == 0 {
+		if uid == vid {
+			it.weight = 0
+			if !fromOK {
+				it.single = node(uid)
+			} else {
+				it.single = p.nodes[from]
+			}
+			return
+		}
+		it.state = pathIterDone
+		return
+	}
+	it.weight = p.dist.At(from, to)
+	if math.Float64bits(it.weight) == defacedBits {
+		it.weight = math.Inf(-1)
+		it.state = pathIterDone
+		return
+	}
+
+	it.from, it.to = from, to
+	if p.forward {
+		it.start, it.target = from, to
+	} else {
+		it.start, it.target = to, from
+	}
+	if cap(it.seen) < len(p.nodes) {
+		it.seen = make([]bool, len(p.nodes))
+	} else {
+		it.seen = it.seen[:len(p.nodes)]
+		clear(it.seen)
+	}
+	it.seen[it.start] = true
+	it.stack = append(it.stack, pathIterFrame{node: it.start})
+	it.path = append(it.path, p.nodes[it.start])
+}
+
+// Weight returns the weight of the paths considered by the iterator.
+func (it *PathIter) Weight() float64 { return it.weight }
+
+// NextPath advances the iterator to the next shortest path and returns
+// whether a path was found.
+func (it *PathIter) NextPath() bool {
+	it.pos = -1
+	if it.state == pathIterDone {
+		return false
+	}
+	if it.single != nil {
+		if it.state == pathIterPath {
+			it.state = pathIterDone
+			return false
+		}
+		it.path = append(it.path[:0], it.single)
+		it.state = pathIterPath
+		return true
+	}
+	if it.state == pathIterPath {
+		// Backtrack from the last path.
+		it.pop()
+	}
+	it.state = pathIterPath
+
+	p := it.p
+	for len(it.stack) != 0 {
+		top := &it.stack[len(it.stack)-1]
+		if top.node == it.target {
+			return true
+		}
+		var mid []int
+		if p.forward {
+			mid = p.at(top.node, it.to)
+		} else {
+			mid = p.at(it.from, top.node)
+		}
+		for top.choice < len(mid) && it.seen[mid[top.choice]] {
+			top.choice++
+		}
+		if top.choice == len(mid) {
+			it.pop()
+			continue
+		}
+		n := mid[top.choice]
+		top.choice++
+		it.seen[n] = true
+		it.stack = append(it.stack, pathIterFrame{node: n})
+		it.path = append(it.path, p.nodes[n])
+	}
+	it.state = pathIterDone
+	return false
+}
+
+// pop removes the last node from the working path.
+func (it *PathIter) pop() {
+	it.seen[it.stack[len(it.stack)-1].node] = false
+	it.stack = it.stack[:len(it.stack)-1]
+	it.path = it.path[:len(it.path)-1]
+}
+
+// Next advances the iterator to the next node of the current path and
+// returns whether there is a node.
+func (it *PathIter) Next() bool {
+	if it.state != pathIterPath || it.pos >= len(it.path)-1 {
+		return false
+	}
+	it.pos++
+	return true
+}
+
+// Node returns the current node of the current path.
+func (it *PathIter) Node() graph.Node {
+	if it.pos < 0 || it.pos >= len(it.path) {
+		return nil
+	}
+	if it.p.forward || it.single != nil {
+		return it.path[it.pos]
+	}
+	return it.path[len(it.path)-1-it.pos]
+}
+
 // allBetween recursively constructs a set of paths extending from the node
 // indexed into p.nodes by from to the node indexed by to. len(seen) must match
 // the number of nodes held by the receiver. The path parameter is the current
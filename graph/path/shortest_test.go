@@ -124,6 +124,70 @@ func TestAllShortest(t *testing.T) {
 	}
 }
 
+func TestAllShortestPathIter(t *testing.T) {
+	for _, test := range shortestTests[:2] {
+		g := simple.NewDirectedGraph()
+		gen.SmallWorldsBB(g, test.n, test.d, test.p, rand.New(rand.NewPCG(test.seed, test.seed)))
+
+		fw, _ := FloydWarshall(g)
+		for _, p := range []struct {
+			name  string
+			paths AllShortest
+		}{
+			{name: "Dijkstra", paths: DijkstraAllPaths(g)},
+			{name: "FloydWarshall", paths: fw},
+		} {
+			t.Run(fmt.Sprintf("%s_%d×%d|%v", p.name, test.n, test.d, test.p), func(t *testing.T) {
+				it := p.paths.PathIter(0, 0)
+				for uid := int64(0); uid < int64(test.n); uid++ {
+					for vid := int64(0); vid < int64(test.n); vid++ {
+						want, wantW := p.paths.AllBetween(uid, vid)
+						it.Reset(uid, vid)
+						if it.Weight() != wantW {
+							t.Errorf("mismatched weight %d --> %d: got:%f want:%f", uid, vid, it.Weight(), wantW)
+						}
+
+						var gotPaths [][]int64
+						for it.NextPath() {
+							var path []int64
+							for it.Next() {
+								path = append(path, it.Node().ID())
+							}
+							gotPaths = append(gotPaths, path)
+						}
+						if it.NextPath() {
+							t.Errorf("unexpected path after exhaustion %d --> %d", uid, vid)
+						}
+						order.BySliceValues(gotPaths)
+						wantPaths := pathIDs(want)
+						order.BySliceValues(wantPaths)
+						if !reflect.DeepEqual(gotPaths, wantPaths) {
+							t.Errorf("unexpected shortest paths %d --> %d:\ngot: %v\nwant:%v",
+								uid, vid, gotPaths, wantPaths)
+						}
+					}
+				}
+
+				allocs := testing.AllocsPerRun(10, func() {
+					for uid := int64(0); uid < 10; uid++ {
+						for vid := int64(0); vid < 10; vid++ {
+							it.Reset(uid, vid)
+							for it.NextPath() {
+								for it.Next() {
+									_ = it.Node()
+								}
+							}
+						}
+					}
+				})
+				if allocs != 0 {
+					t.Errorf("unexpected allocations: got:%v want:0", allocs)
+				}
+			})
+		}
+	}
+}
+
 // allShortest implements an allocation-naive AllBetween.
 type allShortest AllShortest
 
@@ -329,3 +393,64 @@ func BenchmarkAllShortest(b *testing.B) {
 		}
 	}
 }
+
+func TestShortestChildren(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for n := 0; n < 10; n++ {
+		g := simple.NewWeightedDirectedGraph(0, math.Inf(1))
+		const size = 50
+		for i := 0; i < size; i++ {
+			g.AddNode(simple.Node(i))
+		}
+		for i := 0; i < size; i++ {
+			for j := 0; j < size; j++ {
+				if i != j && rnd.Float64() < 0.08 {
+					g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(i), T: simple.Node(j), W: float64(1 + rnd.IntN(10))})
+				}
+			}
+		}
+		pt := DijkstraFrom(simple.Node(0), g)
+
+		// Count the nodes in the subtree rooted at each
+		// node using the paths to each reachable node.
+		want := make(map[int64]int)
+		for _, v := range graph.NodesOf(g.Nodes()) {
+			p, _ := pt.To(v.ID())
+			for _, u := range p {
+				want[u.ID()]++
+			}
+			if len(p) < 2 {
+				continue
+			}
+			parent := p[len(p)-2]
+			if !slices.ContainsFunc(pt.Children(parent.ID()), func(c graph.Node) bool { return c.ID() == v.ID() }) {
+				t.Errorf("graph %d: node %d missing from children of %d", n, v.ID(), parent.ID())
+			}
+		}
+
+		var subtree func(uid int64) int
+		subtree = func(uid int64) int {
+			size := 1
+			for _, c := range pt.Children(uid) {
+				size += subtree(c.ID())
+			}
+			return size
+		}
+		for _, v := range graph.NodesOf(g.Nodes()) {
+			if want[v.ID()] == 0 {
+				if c := pt.Children(v.ID()); c != nil {
+					t.Errorf("graph %d: unexpected children for unreachable node %d: %v", n, v.ID(), c)
+				}
+				continue
+			}
+			if got := subtree(v.ID()); got != want[v.ID()] {
+				t.Errorf("graph %d: unexpected subtree size for %d: got:%d want:%d", n, v.ID(), got, want[v.ID()])
+			}
+		}
+	}
+
+	if c := (Shortest{from: simple.Node(0)}).Children(0); c != nil {
+		t.Errorf("unexpected children for empty Shortest: %v", c)
+	}
+}
@@ -8,10 +8,13 @@ import (
 	"cmp"
 	"container/heap"
 	"math"
+	"runtime"
 	"slices"
+	"sync"
 
 	"gonum.org/v1/gonum/graph"
 	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/internal/order"
 )
 
 // WeightedBuilder is a type that can add nodes and weighted edges.
@@ -167,7 +170,81 @@ func Kruskal(dst WeightedBuilder, g UndirectedWeightLister) float64 {
 	slices.SortFunc(edges, func(a, b graph.WeightedEdge) int {
 		return cmp.Compare(a.Weight(), b.Weight())
 	})
+	w, _ := kruskal(dst, g, edges)
+	return w
+}
+
+// MaximumSpanningTree generates a maximum spanning tree of g by greedy tree
+// coalescence using Kruskal's algorithm with the edges of g considered in order of
+// decreasing weight, placing the result in the destination, dst. If the edge weights
+// of g are distinct it will be the unique maximum spanning tree of g. The destination
+// is not cleared first. The weight of the maximum spanning tree is returned. If g is
+// not connected, a maximum spanning forest will be constructed in dst and the sum of
+// maximum spanning tree weights will be returned.
+//
+// Edges with a weight of +Inf are preferred over all other edges and edges with
+// a weight of -Inf are used only when no other edge can connect their end nodes.
+// If the tree holds edges of both infinite weights, the returned weight is NaN.
+// Edges with a NaN weight are ignored.
+//
+// Nodes and Edges from g are used to construct dst, so if the Node and Edge
+// types used in g are pointer or reference-like, then the values will be shared
+// between the graphs.
+//
+// If dst has nodes that exist in g, MaximumSpanningTree will panic.
+func MaximumSpanningTree(dst WeightedBuilder, g UndirectedWeightLister) float64 {
+	var edges []graph.WeightedEdge
+	for _, e := range graph.WeightedEdgesOf(g.WeightedEdges()) {
+		if !math.IsNaN(e.Weight()) {
+			edges = append(edges, e)
+		}
+	}
+	slices.SortFunc(edges, func(a, b graph.WeightedEdge) int {
+		return cmp.Compare(b.Weight(), a.Weight())
+	})
+	w, _ := kruskal(dst, g, edges)
+	return w
+}
+
+// MinimumSpanningForest generates a minimum spanning forest of g using Kruskal's
+// algorithm, placing the result in the destination, dst, as Kruskal does. Each
+// tree of the forest spans one connected component of g and is identified by a
+// root node, the node of the component with the lowest ID. The roots of the trees
+// are returned sorted by ID along with the total weight of the forest, so the
+// number of connected components of g is the number of returned roots.
+//
+// Nodes and Edges from g are used to construct dst, so if the Node and Edge
+// types used in g are pointer or reference-like, then the values will be shared
+// between the graphs.
+//
+// If dst has nodes that exist in g, MinimumSpanningForest will panic.
+func MinimumSpanningForest(dst WeightedBuilder, g UndirectedWeightLister) (roots []graph.Node, weight float64) {
+	edges := graph.WeightedEdgesOf(g.WeightedEdges())
+	slices.SortFunc(edges, func(a, b graph.WeightedEdge) int {
+		return cmp.Compare(a.Weight(), b.Weight())
+	})
+	weight, ds := kruskal(dst, g, edges)
+	rootOf := make(map[*dsNode]graph.Node)
+	it := g.Nodes()
+	for it.Next() {
+		n := it.Node()
+		r := ds.find(n.ID())
+		if m, ok := rootOf[r]; !ok || n.ID() < m.ID() {
+			rootOf[r] = n
+		}
+	}
+	roots = make([]graph.Node, 0, len(rootOf))
+	for _, n := range rootOf {
+		roots = append(roots, n)
+	}
+	order.ByID(roots)
+	return roots, weight
+}
 
+// kruskal performs Kruskal's algorithm on g with the edges considered in the
+// order they appear in edges, placing the spanning forest in dst and returning
+// its weight and the disjoint set describing the connected components of g.
+func kruskal(dst WeightedBuilder, g UndirectedWeightLister, edges []graph.WeightedEdge) (float64, djSet) {
 	ds := make(djSet)
 	it := g.Nodes()
 	for it.Next() {
@@ -184,5 +261,161 @@ func Kruskal(dst WeightedBuilder, g UndirectedWeightLister) float64 {
 			w += e.Weight()
 		}
 	}
+	return w, ds
+}
+
+// Boruvka generates a minimum spanning tree of g by Borůvka's algorithm, placing
+// the result in the destination, dst. In each phase of the algorithm the least
+// weight edge leaving each connected component of the growing forest is added
+// to the forest, so there are at most log2(|V|) phases. The search for the edges
+// to add in each phase is performed in parallel using up to runtime.GOMAXPROCS(0)
+// goroutines. The destination is not cleared first. The weight of the minimum
+// spanning tree is returned. If g is not connected, a minimum spanning forest will
+// be constructed in dst and the sum of minimum spanning tree weights will be
+// returned.
+//
+// Edges of equal weight are ordered by the lower and then the higher of the IDs
+// of their end nodes. This gives a total order of the edges, which ensures that
+// no cycle is formed when components select edges of equal weight, and the
+// result is the unique minimum spanning tree of g under that order. Spanning
+// trees from Kruskal and Prim may differ from the result of Boruvka when g has
+// edges of equal weight, but will have the same total weight. Edges with a NaN
+// weight are ignored.
+//
+// Nodes and Edges from g are used to construct dst, so if the Node and Edge
+// types used in g are pointer or reference-like, then the values will be shared
+// between the graphs.
+//
+// If dst has nodes that exist in g, Boruvka will panic.
+func Boruvka(dst WeightedBuilder, g UndirectedWeightLister) float64 {
+	nodes := graph.NodesOf(g.Nodes())
+	indexOf := make(map[int64]int, len(nodes))
+	ds := make(djSet)
+	for i, n := range nodes {
+		dst.AddNode(n)
+		ds.add(n.ID())
+		indexOf[n.ID()] = i
+	}
+
+	// boruvkaEdge is an edge of g with the indexes of
+	// its end nodes and its end node IDs in order.
+	type boruvkaEdge struct {
+		e      graph.WeightedEdge
+		u, v   int
+		lo, hi int64
+	}
+	var edges []boruvkaEdge
+	for _, e := range graph.WeightedEdgesOf(g.WeightedEdges()) {
+		uid, vid := e.From().ID(), e.To().ID()
+		if math.IsNaN(e.Weight()) || uid == vid {
+			continue
+		}
+		lo, hi := minmax(uid, vid)
+		edges = append(edges, boruvkaEdge{e: e, u: indexOf[uid], v: indexOf[vid], lo: lo, hi: hi})
+	}
+	less := func(i, j int) bool {
+		a, b := &edges[i], &edges[j]
+		if a.e.Weight() != b.e.Weight() {
+			return a.e.Weight() < b.e.Weight()
+		}
+		if a.lo != b.lo {
+			return a.lo < b.lo
+		}
+		return a.hi < b.hi
+	}
+
+	// Use chunks of at least minEdges edges to
+	// amortise the cost of synchronisation.
+	const minEdges = 1024
+	workers := max(1, min(runtime.GOMAXPROCS(0), len(edges)/minEdges))
+	chunk := (len(edges) + workers - 1) / workers
+	cheapest := make([][]int, workers)
+
+	var w float64
+	compOf := make([]int, len(nodes))
+	for {
+		// Label each node with its component so
+		// that the parallel edge search does not
+		// need to query the disjoint set.
+		roots := make(map[*dsNode]int)
+		for i, n := range nodes {
+			r := ds.find(n.ID())
+			c, ok := roots[r]
+			if !ok {
+				c = len(roots)
+				roots[r] = c
+			}
+			compOf[i] = c
+		}
+		if len(roots) <= 1 {
+			break
+		}
+
+		var wg sync.WaitGroup
+		for i := range cheapest {
+			lo := i * chunk
+			hi := min(lo+chunk, len(edges))
+			cheapest[i] = resize(cheapest[i], len(roots))
+			wg.Add(1)
+			go func(best []int, lo, hi int) {
+				defer wg.Done()
+				for c := range best {
+					best[c] = -1
+				}
+				for j := lo; j < hi; j++ {
+					cu, cv := compOf[edges[j].u], compOf[edges[j].v]
+					if cu == cv {
+						continue
+					}
+					if best[cu] < 0 || less(j, best[cu]) {
+						best[cu] = j
+					}
+					if best[cv] < 0 || less(j, best[cv]) {
+						best[cv] = j
+					}
+				}
+			}(cheapest[i], lo, hi)
+		}
+		wg.Wait()
+
+		added := false
+		for c := range len(roots) {
+			best := -1
+			for _, b := range cheapest {
+				if b[c] >= 0 && (best < 0 || less(b[c], best)) {
+					best = b[c]
+				}
+			}
+			if best < 0 {
+				continue
+			}
+			e := edges[best].e
+			if s1, s2 := ds.find(e.From().ID()), ds.find(e.To().ID()); s1 != s2 {
+				ds.union(s1, s2)
+				dst.SetWeightedEdge(g.WeightedEdge(e.From().ID(), e.To().ID()))
+				w += e.Weight()
+				added = true
+			}
+		}
+		if !added {
+			break
+		}
+	}
 	return w
 }
+
+// minmax returns a and b in ascending order.
+func minmax(a, b int64) (lo, hi int64) {
+	if a > b {
+		return b, a
+	}
+	return a, b
+}
+
+// resize returns s with length n, reallocating if s has insufficient capacity.
+func resize(s []int, n int) []int {
+	if cap(s) < n {
+		return make([]int, n)
+	}
+	return s[:n]
+}
@@ -6,6 +6,8 @@ package path
 
 import (
 	"math"
+	"math/rand/v2"
+	"reflect"
 	"testing"
 
 	"gonum.org/v1/gonum/graph"
@@ -294,3 +296,178 @@ func TestPrim(t *testing.T) {
 		return Prim(dst, g)
 	}, t)
 }
+
+func TestBoruvka(t *testing.T) {
+	t.Parallel()
+	testMinimumSpanning(func(dst WeightedBuilder, g spanningGraph) float64 {
+		return Boruvka(dst, g)
+	}, t)
+}
+
+func TestBoruvkaRandom(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for trial := 0; trial < 20; trial++ {
+		g := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+		for i := 0; i < 100; i++ {
+			g.AddNode(simple.Node(i))
+		}
+		for i := 0; i < 100; i++ {
+			for j := 0; j < i; j++ {
+				if rnd.Float64() < 0.3 {
+					// Use few distinct weights to exercise tie breaking.
+					g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(i), T: simple.Node(j), W: float64(rnd.IntN(5))})
+				}
+			}
+		}
+		// Add a disconnected component.
+		g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(200), T: simple.Node(201), W: 1})
+
+		kruskal := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+		want := Kruskal(kruskal, g)
+		boruvka := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+		got := Boruvka(boruvka, g)
+		if got != want {
+			t.Errorf("unexpected minimum spanning tree weight: got:%v want:%v", got, want)
+		}
+		if n, want := boruvka.Edges().Len(), kruskal.Edges().Len(); n != want {
+			t.Errorf("unexpected number of spanning tree edges: got:%d want:%d", n, want)
+		}
+	}
+}
+
+func TestMinimumSpanningForest(t *testing.T) {
+	t.Parallel()
+	testMinimumSpanning(func(dst WeightedBuilder, g spanningGraph) float64 {
+		_, w := MinimumSpanningForest(dst, g)
+		return w
+	}, t)
+
+	g := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+	for _, e := range []simple.WeightedEdge{
+		{F: simple.Node(3), T: simple.Node(1), W: 2},
+		{F: simple.Node(1), T: simple.Node(5), W: 1},
+		{F: simple.Node(5), T: simple.Node(3), W: 4},
+		{F: simple.Node(7), T: simple.Node(4), W: 3},
+	} {
+		g.SetWeightedEdge(e)
+	}
+	g.AddNode(simple.Node(2))
+
+	dst := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+	roots, w := MinimumSpanningForest(dst, g)
+	var got []int64
+	for _, n := range roots {
+		got = append(got, n.ID())
+	}
+	want := []int64{1, 2, 4}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected roots: got:%v want:%v", got, want)
+	}
+	if w != 6 {
+		t.Errorf("unexpected forest weight: got:%v want:6", w)
+	}
+	for _, r := range roots {
+		if dst.Node(r.ID()) == nil {
+			t.Errorf("root %d not in forest", r.ID())
+		}
+	}
+}
+
+var maximumSpanningTreeTests = []struct {
+	name  string
+	edges []simple.WeightedEdge
+
+	want      float64
+	treeEdges [][2]int64
+}{
+	{
+		name: "square",
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 1},
+			{F: simple.Node(1), T: simple.Node(2), W: 4},
+			{F: simple.Node(2), T: simple.Node(3), W: 2},
+			{F: simple.Node(3), T: simple.Node(0), W: 3},
+			{F: simple.Node(0), T: simple.Node(2), W: 5},
+		},
+		want:      12,
+		treeEdges: [][2]int64{{0, 2}, {1, 2}, {0, 3}},
+	},
+	{
+		name: "infinite",
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: math.Inf(1)},
+			{F: simple.Node(1), T: simple.Node(2), W: 4},
+			{F: simple.Node(0), T: simple.Node(2), W: 5},
+		},
+		want:      math.Inf(1),
+		treeEdges: [][2]int64{{0, 1}, {0, 2}},
+	},
+	{
+		name: "negative infinite",
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: math.Inf(-1)},
+			{F: simple.Node(1), T: simple.Node(2), W: 4},
+			{F: simple.Node(0), T: simple.Node(2), W: 5},
+			{F: simple.Node(2), T: simple.Node(3), W: math.Inf(-1)},
+		},
+		want:      math.Inf(-1),
+		treeEdges: [][2]int64{{1, 2}, {0, 2}, {2, 3}},
+	},
+	{
+		name: "NaN",
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: math.NaN()},
+			{F: simple.Node(1), T: simple.Node(2), W: 4},
+			{F: simple.Node(0), T: simple.Node(2), W: 5},
+		},
+		want:      9,
+		treeEdges: [][2]int64{{1, 2}, {0, 2}},
+	},
+}
+
+func TestMaximumSpanningTree(t *testing.T) {
+	t.Parallel()
+	for _, test := range maximumSpanningTreeTests {
+		g := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+		for _, e := range test.edges {
+			g.SetWeightedEdge(e)
+		}
+		dst := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+		w := MaximumSpanningTree(dst, g)
+		if w != test.want {
+			t.Errorf("unexpected maximum spanning tree weight for %q: got:%v want:%v", test.name, w, test.want)
+		}
+		if n := dst.Edges().Len(); n != len(test.treeEdges) {
+			t.Errorf("unexpected number of spanning tree edges for %q: got:%d want:%d", test.name, n, len(test.treeEdges))
+		}
+		for _, e := range test.treeEdges {
+			if !dst.HasEdgeBetween(e[0], e[1]) {
+				t.Errorf("spanning tree edge not found in graph for %q: %v", test.name, e)
+			}
+		}
+	}
+
+	// The maximum spanning tree of g has the negated
+	// weight of the minimum spanning tree of the graph
+	// with negated weights.
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for trial := 0; trial < 20; trial++ {
+		g := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+		neg := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+		for i := 0; i < 30; i++ {
+			for j := 0; j < i; j++ {
+				if rnd.Float64() < 0.3 {
+					w := float64(rnd.IntN(10))
+					g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(i), T: simple.Node(j), W: w})
+					neg.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(i), T: simple.Node(j), W: -w})
+				}
+			}
+		}
+		got := MaximumSpanningTree(simple.NewWeightedUndirectedGraph(0, math.Inf(1)), g)
+		want := -Kruskal(simple.NewWeightedUndirectedGraph(0, math.Inf(1)), neg)
+		if got != want {
+			t.Errorf("unexpected maximum spanning tree weight: got:%v want:%v", got, want)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package path
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+// SteinerTree generates an approximate minimum Steiner tree of g connecting the
+// terminal nodes, placing the result in the destination, dst, and returns the
+// weight of the tree. The tree may include non-terminal Steiner nodes of g. The
+// approximation is that of Kou, Markowsky and Berman: a minimum spanning tree
+// of the metric closure of g over the terminals is found, each of its edges is
+// expanded to a shortest path in g found with Dijkstra, a minimum spanning tree
+// of the union of the paths is found with Prim, and non-terminal leaves are
+// removed. The weight of the tree is at most 2(1-1/l) times the weight of a
+// minimum Steiner tree, where l is the number of leaves in the minimum tree.
+//
+// If terminals holds a single node, only that node is added to dst and the
+// weight is zero. Repeated terminals are ignored. If the terminals are not all
+// connected in g, dst is not modified and SteinerTree returns +Inf. The
+// destination is not cleared first.
+//
+// Nodes and Edges from g are used to construct dst, so if the Node and Edge
+// types used in g are pointer or reference-like, then the values will be shared
+// between the graphs.
+//
+// SteinerTree will panic if a terminal is not in g or if g has a negative edge
+// weight.
+func SteinerTree(dst WeightedBuilder, g graph.WeightedUndirected, terminals []graph.Node) float64 {
+	isTerminal := make(map[int64]bool, len(terminals))
+	var terms []graph.Node
+	for _, u := range terminals {
+		if g.Node(u.ID()) == nil {
+			panic("path: steiner terminal not in graph")
+		}
+		if !isTerminal[u.ID()] {
+			isTerminal[u.ID()] = true
+			terms = append(terms, g.Node(u.ID()))
+		}
+	}
+	order.ByID(terms)
+	switch len(terms) {
+	case 0:
+		return 0
+	case 1:
+		dst.AddNode(terms[0])
+		return 0
+	}
+
+	// Find the minimum spanning tree of the
+	// metric closure over the terminals.
+	paths := make([]Shortest, len(terms))
+	closure := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+	for i, u := range terms {
+		paths[i] = DijkstraFrom(u, g)
+		closure.AddNode(simple.Node(i))
+		for j := 0; j < i; j++ {
+			w := paths[j].WeightTo(u.ID())
+			if math.IsInf(w, 1) {
+				return math.Inf(1)
+			}
+			closure.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(j), T: simple.Node(i), W: w})
+		}
+	}
+	closureTree := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+	Prim(closureTree, closure)
+
+	// Expand the closure edges to their paths in g
+	// and find the minimum spanning tree of the union.
+	union := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+	edges := closureTree.WeightedEdges()
+	for edges.Next() {
+		e := edges.WeightedEdge()
+		p, _ := paths[e.From().ID()].To(terms[e.To().ID()].ID())
+		for k := 1; k < len(p); k++ {
+			union.SetWeightedEdge(g.WeightedEdge(p[k-1].ID(), p[k].ID()))
+		}
+	}
+	tree := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+	Prim(tree, union)
+
+	// Remove non-terminal leaves until none remain.
+	var leaves []int64
+	nodes := tree.Nodes()
+	for nodes.Next() {
+		id := nodes.Node().ID()
+		if !isTerminal[id] && tree.From(id).Len() <= 1 {
+			leaves = append(leaves, id)
+		}
+	}
+	for len(leaves) != 0 {
+		id := leaves[len(leaves)-1]
+		leaves = leaves[:len(leaves)-1]
+		to := graph.NodesOf(tree.From(id))
+		tree.RemoveNode(id)
+		for _, v := range to {
+			vid := v.ID()
+			if !isTerminal[vid] && tree.From(vid).Len() == 1 {
+				leaves = append(leaves, vid)
+			}
+		}
+	}
+
+	var weight float64
+	nodes = tree.Nodes()
+	for nodes.Next() {
+		dst.AddNode(nodes.Node())
+	}
+	edges = tree.WeightedEdges()
+	for edges.Next() {
+		e := edges.WeightedEdge()
+		dst.SetWeightedEdge(e)
+		weight += e.Weight()
+	}
+	return weight
+}
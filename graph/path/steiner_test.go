@@ -0,0 +1,209 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package path
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/graph/topo"
+)
+
+var steinerTreeTests = []struct {
+	name      string
+	edges     []simple.WeightedEdge
+	terminals []int64
+
+	wantWeight float64
+	wantNodes  int
+}{
+	{
+		name: "none",
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 1},
+		},
+		wantWeight: 0,
+		wantNodes:  0,
+	},
+	{
+		name: "single",
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 1},
+		},
+		terminals:  []int64{1, 1},
+		wantWeight: 0,
+		wantNodes:  1,
+	},
+	{
+		// The three terminals are best joined
+		// through the Steiner node 3.
+		name: "star",
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 3},
+			{F: simple.Node(1), T: simple.Node(2), W: 3},
+			{F: simple.Node(2), T: simple.Node(0), W: 3},
+			{F: simple.Node(0), T: simple.Node(3), W: 1},
+			{F: simple.Node(1), T: simple.Node(3), W: 1},
+			{F: simple.Node(2), T: simple.Node(3), W: 1},
+		},
+		terminals:  []int64{0, 1, 2},
+		wantWeight: 3,
+		wantNodes:  4,
+	},
+	{
+		// The non-terminal branch to 4 is pruned.
+		name: "path",
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 1},
+			{F: simple.Node(1), T: simple.Node(2), W: 1},
+			{F: simple.Node(2), T: simple.Node(3), W: 1},
+			{F: simple.Node(1), T: simple.Node(4), W: 1},
+		},
+		terminals:  []int64{0, 3},
+		wantWeight: 3,
+		wantNodes:  4,
+	},
+	{
+		name: "disconnected",
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 1},
+			{F: simple.Node(2), T: simple.Node(3), W: 1},
+		},
+		terminals:  []int64{0, 3},
+		wantWeight: math.Inf(1),
+		wantNodes:  0,
+	},
+}
+
+func TestSteinerTree(t *testing.T) {
+	t.Parallel()
+	for _, test := range steinerTreeTests {
+		g := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+		for _, e := range test.edges {
+			g.SetWeightedEdge(e)
+		}
+		var terminals []graph.Node
+		for _, id := range test.terminals {
+			terminals = append(terminals, simple.Node(id))
+		}
+		dst := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+		weight := SteinerTree(dst, g, terminals)
+		if weight != test.wantWeight {
+			t.Errorf("unexpected weight for %q: got:%v want:%v", test.name, weight, test.wantWeight)
+		}
+		if n := dst.Nodes().Len(); n != test.wantNodes {
+			t.Errorf("unexpected number of nodes for %q: got:%d want:%d", test.name, n, test.wantNodes)
+		}
+		if !math.IsInf(weight, 1) {
+			checkSteinerTree(t, test.name, dst, terminals, weight)
+		}
+	}
+}
+
+func TestSteinerTreeRandom(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for trial := 0; trial < 20; trial++ {
+		const n = 9
+		g := randomConnectedGraph(n, rnd)
+		var terminals []graph.Node
+		for _, id := range rnd.Perm(n)[:2+trial%4] {
+			terminals = append(terminals, simple.Node(id))
+		}
+
+		dst := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+		weight := SteinerTree(dst, g, terminals)
+		checkSteinerTree(t, "random", dst, terminals, weight)
+		for _, e := range graph.WeightedEdgesOf(dst.WeightedEdges()) {
+			if w, ok := g.Weight(e.From().ID(), e.To().ID()); !ok || w != e.Weight() {
+				t.Errorf("tree edge %d-%d is not in graph %d", e.From().ID(), e.To().ID(), trial)
+			}
+		}
+		opt := bruteSteiner(g, terminals)
+		if weight < opt || weight > 2*opt {
+			t.Errorf("unexpected weight for graph %d: got:%v optimal:%v", trial, weight, opt)
+		}
+	}
+}
+
+// checkSteinerTree checks that tree is a tree holding the terminals with
+// only terminal leaves and the given weight.
+func checkSteinerTree(t *testing.T, name string, tree *simple.WeightedUndirectedGraph, terminals []graph.Node, weight float64) {
+	t.Helper()
+	for _, u := range terminals {
+		if tree.Node(u.ID()) == nil {
+			t.Errorf("tree for %q is missing terminal %d", name, u.ID())
+		}
+	}
+	n := tree.Nodes().Len()
+	if n == 0 {
+		return
+	}
+	if len(topo.ConnectedComponents(tree)) != 1 || tree.Edges().Len() != n-1 {
+		t.Errorf("result for %q is not a tree", name)
+	}
+	isTerminal := make(map[int64]bool)
+	for _, u := range terminals {
+		isTerminal[u.ID()] = true
+	}
+	for _, u := range graph.NodesOf(tree.Nodes()) {
+		if !isTerminal[u.ID()] && tree.From(u.ID()).Len() < 2 {
+			t.Errorf("tree for %q has non-terminal leaf %d", name, u.ID())
+		}
+	}
+	var got float64
+	for _, e := range graph.WeightedEdgesOf(tree.WeightedEdges()) {
+		got += e.Weight()
+	}
+	if got != weight {
+		t.Errorf("weight does not match tree for %q: got:%v want:%v", name, weight, got)
+	}
+}
+
+// bruteSteiner returns the weight of a minimum Steiner tree by finding the
+// minimum spanning tree induced by the terminals and each subset of the
+// other nodes.
+func bruteSteiner(g *simple.WeightedUndirectedGraph, terminals []graph.Node) float64 {
+	isTerminal := make(map[int64]bool)
+	for _, u := range terminals {
+		isTerminal[u.ID()] = true
+	}
+	var others []int64
+	for _, u := range graph.NodesOf(g.Nodes()) {
+		if !isTerminal[u.ID()] {
+			others = append(others, u.ID())
+		}
+	}
+	best := math.Inf(1)
+	for set := 0; set < 1<<len(others); set++ {
+		in := make(map[int64]bool)
+		for id := range isTerminal {
+			in[id] = true
+		}
+		for i, id := range others {
+			if set&(1<<i) != 0 {
+				in[id] = true
+			}
+		}
+		sub := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+		for id := range in {
+			sub.AddNode(simple.Node(id))
+		}
+		for _, e := range graph.WeightedEdgesOf(g.WeightedEdges()) {
+			if in[e.From().ID()] && in[e.To().ID()] {
+				sub.SetWeightedEdge(e)
+			}
+		}
+		mst := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+		w := Prim(mst, sub)
+		if mst.Edges().Len() == len(in)-1 {
+			best = math.Min(best, w)
+		}
+	}
+	return best
+}
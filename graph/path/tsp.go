@@ -0,0 +1,228 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package path
+
+import (
+	"math"
+	"slices"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/matching"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+// TSPChristofides returns a travelling salesman tour of the complete undirected
+// graph g and its weight, using the Christofides heuristic. A minimum spanning
+// tree of g is found with Prim, the odd degree nodes of the tree are paired by
+// a minimum weight perfect matching of their edges in g, and the tour visits
+// the nodes in the order of their first appearance in an Eulerian circuit of
+// the union of the tree and the matching.
+//
+// The returned tour is closed, starting and ending at the lowest ID node of g,
+// and visits every other node of g exactly once. If the edge weights of g
+// satisfy the triangle inequality, the weight of the tour is at most 3/2 times
+// the weight of an optimal tour. If the weights are not metric, a tour is still
+// returned but there is no bound on its weight. If g is not complete and an
+// edge required by the tour is missing, TSPChristofides returns nil and +Inf.
+// If g has fewer than two nodes, the tour holds the nodes of g and has zero
+// weight.
+// If the minimum spanning tree of g is not unique, the tour may differ between
+// calls.
+//
+// The time complexity of TSPChristofides is O(|V|^3).
+func TSPChristofides(g graph.WeightedUndirected) ([]graph.Node, float64) {
+	mst, ok := tspSpanningTree(g)
+	if !ok {
+		return nil, math.Inf(1)
+	}
+	e, deg := newUndirectedEuler(mst)
+	if len(e.nodes) < 2 {
+		return e.nodes, 0
+	}
+	var odd []int
+	for i, d := range deg {
+		if d%2 != 0 {
+			odd = append(odd, i)
+		}
+	}
+	mate, _ := matching.MinWeightPerfectMatching(func(i, j int) float64 {
+		w, ok := g.Weight(e.nodes[odd[i]].ID(), e.nodes[odd[j]].ID())
+		if !ok {
+			return math.Inf(1)
+		}
+		return w
+	}, len(odd))
+	if mate == nil {
+		return nil, math.Inf(1)
+	}
+	for i, j := range mate {
+		if i < j {
+			e.addEdge(odd[i], odd[j], simple.Edge{F: e.nodes[odd[i]], T: e.nodes[odd[j]]})
+		}
+	}
+	return e.tour(g)
+}
+
+// TSPDoubleTree returns a travelling salesman tour of the complete undirected
+// graph g and its weight, using the double tree heuristic. A minimum spanning
+// tree of g is found with Prim, and the tour visits the nodes in the order of
+// their first appearance in an Eulerian circuit of the tree with each of its
+// edges doubled.
+//
+// The returned tour is closed as described for TSPChristofides. If the edge
+// weights of g satisfy the triangle inequality, the weight of the tour is at
+// most twice the weight of an optimal tour. If the weights are not metric, a
+// tour is still returned but there is no bound on its weight. Missing edges,
+// graphs with fewer than two nodes and non-unique minimum spanning trees are
+// handled as described for TSPChristofides.
+//
+// The time complexity of TSPDoubleTree is O(|E|.log|V|).
+func TSPDoubleTree(g graph.WeightedUndirected) ([]graph.Node, float64) {
+	mst, ok := tspSpanningTree(g)
+	if !ok {
+		return nil, math.Inf(1)
+	}
+	e, _ := newUndirectedEuler(mst)
+	if len(e.nodes) < 2 {
+		return e.nodes, 0
+	}
+	index := e.index()
+	// The range expression is evaluated once, so
+	// each tree edge is doubled exactly once.
+	for _, edge := range e.edges {
+		e.addEdge(index[edge.From().ID()], index[edge.To().ID()], edge)
+	}
+	return e.tour(g)
+}
+
+// tspSpanningTree returns a minimum spanning tree of g and whether g
+// is connected.
+func tspSpanningTree(g graph.WeightedUndirected) (*simple.WeightedUndirectedGraph, bool) {
+	mst := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+	Prim(mst, g)
+	n := mst.Nodes().Len()
+	return mst, n == 0 || mst.Edges().Len() == n-1
+}
+
+// addEdge adds the edge joining the nodes at index u and v to e.
+func (e *euler) addEdge(u, v int, edge graph.Edge) {
+	k := len(e.edges)
+	e.edges = append(e.edges, edge)
+	e.adj[u] = append(e.adj[u], eulerArc{to: v, edge: k})
+	if u != v {
+		e.adj[v] = append(e.adj[v], eulerArc{to: u, edge: k})
+	}
+}
+
+// tour returns the closed tour obtained by shortcutting an Eulerian circuit
+// of e, and the weight of the tour in g.
+func (e *euler) tour(g graph.Weighted) ([]graph.Node, float64) {
+	walk, ok := e.walk(0)
+	if !ok {
+		return nil, math.Inf(1)
+	}
+	seen := make(map[int64]bool, len(e.nodes))
+	tour := make([]graph.Node, 0, len(e.nodes)+1)
+	tour = append(tour, e.nodes[0])
+	seen[e.nodes[0].ID()] = true
+	for _, edge := range walk {
+		v := edge.To()
+		if !seen[v.ID()] {
+			seen[v.ID()] = true
+			tour = append(tour, v)
+		}
+	}
+	tour = append(tour, e.nodes[0])
+	weight, ok := tourWeight(g, tour)
+	if !ok {
+		return nil, math.Inf(1)
+	}
+	return tour, weight
+}
+
+// tourWeight returns the weight of the closed tour in g and whether
+// every step of the tour is an edge of g.
+func tourWeight(g graph.Weighted, tour []graph.Node) (float64, bool) {
+	var weight float64
+	for i := 1; i < len(tour); i++ {
+		w, ok := g.Weight(tour[i-1].ID(), tour[i].ID())
+		if !ok {
+			return math.Inf(1), false
+		}
+		weight += w
+	}
+	return weight, true
+}
+
+// TwoOpt returns the tour obtained by improving the travelling salesman tour
+// in the undirected graph g with 2-opt local search, and the weight of the
+// returned tour. A 2-opt move replaces two edges of the tour with the two
+// edges that reconnect it after reversing the segment between them. Moves are
+// made while any move reduces the weight of the tour, so the returned tour is
+// locally optimal. Each pass scans the pairs of tour edges in order of their
+// position in the tour and makes the first improving move found, so the result
+// is deterministic for a given graph and initial tour. The first node of the
+// tour is not moved.
+//
+// The initial tour must visit each node at most once. If the first and last
+// nodes of tour are the same, the tour is closed, otherwise it is closed by
+// returning to its first node. The returned tour is closed unless it holds a
+// single node, as for TSPChristofides, and tour is not modified. Moves are
+// only made using edges in g, but if the initial tour includes a step that is
+// not an edge of g, TwoOpt returns nil and +Inf. A move must reduce the weight
+// by more than a small relative tolerance to be made, to ensure termination
+// in the face of rounding error.
+func TwoOpt(g graph.WeightedUndirected, tour []graph.Node) ([]graph.Node, float64) {
+	if len(tour) == 0 {
+		return nil, 0
+	}
+	t := make([]graph.Node, len(tour), len(tour)+1)
+	copy(t, tour)
+	if len(t) > 1 && t[0].ID() == t[len(t)-1].ID() {
+		t = t[:len(t)-1]
+	}
+	n := len(t)
+	if n < 2 {
+		return t, 0
+	}
+	if _, ok := tourWeight(g, append(t, t[0])); !ok {
+		return nil, math.Inf(1)
+	}
+
+	weight := func(u, v graph.Node) float64 {
+		w, ok := g.Weight(u.ID(), v.ID())
+		if !ok {
+			return math.Inf(1)
+		}
+		return w
+	}
+	const tol = 1e-12
+	for improved := true; improved; {
+		improved = false
+	scan:
+		for i := 0; i < n-2; i++ {
+			a, b := t[i], t[i+1]
+			ab := weight(a, b)
+			for j := i + 2; j < n; j++ {
+				if i == 0 && j == n-1 {
+					// The edges share the node t[0].
+					continue
+				}
+				c, d := t[j], t[(j+1)%n]
+				cd := weight(c, d)
+				delta := weight(a, c) + weight(b, d) - ab - cd
+				if delta < -tol*(ab+cd) {
+					slices.Reverse(t[i+1 : j+1])
+					improved = true
+					break scan
+				}
+			}
+		}
+	}
+
+	t = append(t, t[0])
+	w, _ := tourWeight(g, t)
+	return t, w
+}
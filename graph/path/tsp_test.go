@@ -0,0 +1,226 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package path
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+var tspHeuristics = []struct {
+	name  string
+	fn    func(graph.WeightedUndirected) ([]graph.Node, float64)
+	bound float64
+}{
+	{name: "TSPChristofides", fn: TSPChristofides, bound: 1.5},
+	{name: "TSPDoubleTree", fn: TSPDoubleTree, bound: 2},
+}
+
+func TestTSPEuclidean(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for trial := 0; trial < 20; trial++ {
+		n := 3 + trial%6
+		g := euclideanGraph(rnd, n)
+		opt := bruteTSP(g, n)
+		for _, h := range tspHeuristics {
+			tour, weight := h.fn(g)
+			checkTour(t, h.name, g, tour, weight)
+			if weight > h.bound*opt+1e-9 {
+				t.Errorf("%s tour for graph %d exceeds bound: got:%v optimal:%v", h.name, trial, weight, opt)
+			}
+		}
+	}
+}
+
+func TestTSPSmall(t *testing.T) {
+	t.Parallel()
+	for _, h := range tspHeuristics {
+		g := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+		tour, weight := h.fn(g)
+		if len(tour) != 0 || weight != 0 {
+			t.Errorf("unexpected %s tour for empty graph: got:%v weight:%v", h.name, tour, weight)
+		}
+
+		g.AddNode(simple.Node(3))
+		tour, weight = h.fn(g)
+		if len(tour) != 1 || tour[0].ID() != 3 || weight != 0 {
+			t.Errorf("unexpected %s tour for single node: got:%v weight:%v", h.name, tour, weight)
+		}
+
+		// A path graph is not complete, so the
+		// tour must use a missing edge.
+		g = simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+		for i := 1; i < 4; i++ {
+			g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(i - 1), T: simple.Node(i), W: 1})
+		}
+		tour, weight = h.fn(g)
+		if tour != nil || !math.IsInf(weight, 1) {
+			t.Errorf("unexpected %s tour for incomplete graph: got:%v weight:%v", h.name, tour, weight)
+		}
+
+		// A disconnected graph has no tour.
+		g.AddNode(simple.Node(10))
+		tour, weight = h.fn(g)
+		if tour != nil || !math.IsInf(weight, 1) {
+			t.Errorf("unexpected %s tour for disconnected graph: got:%v weight:%v", h.name, tour, weight)
+		}
+	}
+}
+
+// euclideanGraph returns a complete graph of n random points in the
+// unit square weighted by the distances between the points.
+func euclideanGraph(rnd *rand.Rand, n int) *simple.WeightedUndirectedGraph {
+	g := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+	x := make([]float64, n)
+	y := make([]float64, n)
+	for i := range x {
+		x[i], y[i] = rnd.Float64(), rnd.Float64()
+	}
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			w := math.Hypot(x[i]-x[j], y[i]-y[j])
+			g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(i), T: simple.Node(j), W: w})
+		}
+	}
+	return g
+}
+
+// checkTour checks that tour is a closed tour visiting every node
+// of g once and that its weight is correct.
+func checkTour(t *testing.T, name string, g graph.WeightedUndirected, tour []graph.Node, weight float64) {
+	t.Helper()
+	n := g.Nodes().Len()
+	if len(tour) != n+1 {
+		t.Errorf("unexpected %s tour length: got:%d want:%d", name, len(tour), n+1)
+		return
+	}
+	if tour[0].ID() != tour[n].ID() {
+		t.Errorf("%s tour is not closed: %v", name, tour)
+	}
+	seen := make(map[int64]bool)
+	for _, u := range tour[:n] {
+		if seen[u.ID()] {
+			t.Errorf("%s tour visits %d more than once: %v", name, u.ID(), tour)
+		}
+		seen[u.ID()] = true
+	}
+	got, ok := tourWeight(g, tour)
+	if !ok || math.Abs(got-weight) > 1e-12 {
+		t.Errorf("weight does not match %s tour: got:%v want:%v", name, weight, got)
+	}
+}
+
+// bruteTSP returns the weight of an optimal tour of the complete
+// graph g on the nodes 0 to n-1 by exhaustive search.
+func bruteTSP(g graph.Weighted, n int) float64 {
+	perm := make([]graph.Node, n+1)
+	for i := range n {
+		perm[i] = simple.Node(i)
+	}
+	perm[n] = perm[0]
+	best := math.Inf(1)
+	var permute func(k int)
+	permute = func(k int) {
+		if k == n {
+			w, _ := tourWeight(g, perm)
+			best = math.Min(best, w)
+			return
+		}
+		for i := k; i < n; i++ {
+			perm[k], perm[i] = perm[i], perm[k]
+			permute(k + 1)
+			perm[k], perm[i] = perm[i], perm[k]
+		}
+	}
+	permute(1)
+	return best
+}
+
+func TestTwoOpt(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for trial := 0; trial < 20; trial++ {
+		n := 4 + trial%10
+		g := euclideanGraph(rnd, n)
+
+		// Start from a random open tour.
+		initial := make([]graph.Node, n)
+		for i, p := range rnd.Perm(n) {
+			initial[i] = simple.Node(p)
+		}
+		orig := append([]graph.Node(nil), initial...)
+		before, _ := tourWeight(g, append(orig, orig[0]))
+
+		tour, weight := TwoOpt(g, initial)
+		checkTour(t, "TwoOpt", g, tour, weight)
+		if tour[0].ID() != orig[0].ID() {
+			t.Errorf("first node moved for graph %d: got:%d want:%d", trial, tour[0].ID(), orig[0].ID())
+		}
+		if weight > before {
+			t.Errorf("tour weight increased for graph %d: got:%v initial:%v", trial, weight, before)
+		}
+		for i := range initial {
+			if initial[i] != orig[i] {
+				t.Fatalf("initial tour modified for graph %d", trial)
+			}
+		}
+
+		// The tour is 2-optimal.
+		for i := 0; i < n-2; i++ {
+			for j := i + 2; j < n; j++ {
+				if i == 0 && j == n-1 {
+					continue
+				}
+				ab, _ := g.Weight(tour[i].ID(), tour[i+1].ID())
+				cd, _ := g.Weight(tour[j].ID(), tour[j+1].ID())
+				ac, _ := g.Weight(tour[i].ID(), tour[j].ID())
+				bd, _ := g.Weight(tour[i+1].ID(), tour[j+1].ID())
+				if ac+bd < ab+cd-1e-9 {
+					t.Errorf("improving move %d-%d remains for graph %d", i, j, trial)
+				}
+			}
+		}
+
+		// Polishing is deterministic and idempotent.
+		again, againWeight := TwoOpt(g, tour)
+		if againWeight != weight || len(again) != len(tour) {
+			t.Errorf("unexpected change when polishing optimal tour for graph %d", trial)
+		}
+		for i := range again {
+			if again[i].ID() != tour[i].ID() {
+				t.Errorf("unexpected change when polishing optimal tour for graph %d: got:%v want:%v", trial, again, tour)
+				break
+			}
+		}
+
+		ct, cw := TSPChristofides(g)
+		if tour, weight := TwoOpt(g, ct); weight > cw {
+			t.Errorf("polished Christofides tour weight increased for graph %d: got:%v initial:%v", trial, weight, cw)
+		} else {
+			checkTour(t, "TwoOpt", g, tour, weight)
+		}
+	}
+}
+
+func TestTwoOptInvalid(t *testing.T) {
+	t.Parallel()
+	g := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+	for i := 1; i < 4; i++ {
+		g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(i - 1), T: simple.Node(i), W: 1})
+	}
+	tour, weight := TwoOpt(g, []graph.Node{simple.Node(0), simple.Node(1), simple.Node(2), simple.Node(3)})
+	if tour != nil || !math.IsInf(weight, 1) {
+		t.Errorf("unexpected tour with missing edge: got:%v weight:%v", tour, weight)
+	}
+	tour, weight = TwoOpt(g, []graph.Node{simple.Node(2)})
+	if len(tour) != 1 || weight != 0 {
+		t.Errorf("unexpected single node tour: got:%v weight:%v", tour, weight)
+	}
+}
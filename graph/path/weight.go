@@ -5,6 +5,7 @@
 package path
 
 import (
+	"fmt"
 	"math"
 
 	"gonum.org/v1/gonum/graph"
@@ -51,3 +52,38 @@ type Heuristic func(x, y graph.Node) float64
 type HeuristicCoster interface {
 	HeuristicCost(x, y graph.Node) float64
 }
+
+// NegativeWeightError is an error reporting a negative edge weight in a
+// graph passed to a routine that requires non-negative edge weights.
+type NegativeWeightError struct {
+	// From and To are the IDs of the end
+	// points of the offending edge.
+	From, To int64
+
+	// Weight is the weight of the edge.
+	Weight float64
+}
+
+// Error satisfies the error interface.
+func (e NegativeWeightError) Error() string {
+	return fmt.Sprintf("path: negative edge weight %v between nodes %d and %d", e.Weight, e.From, e.To)
+}
+
+// checkNonNegative returns a NegativeWeightError for the first negative
+// edge weight found in g using the weight function, or nil if no edge
+// of g has a negative weight.
+func checkNonNegative(g graph.Graph, weight Weighting) error {
+	nodes := g.Nodes()
+	for nodes.Next() {
+		uid := nodes.Node().ID()
+		to := g.From(uid)
+		for to.Next() {
+			vid := to.Node().ID()
+			w, ok := weight(uid, vid)
+			if ok && w < 0 {
+				return NegativeWeightError{From: uid, To: vid, Weight: w}
+			}
+		}
+	}
+	return nil
+}
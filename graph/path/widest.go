@@ -0,0 +1,219 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package path
+
+import (
+	"container/heap"
+	"math"
+	"slices"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/traverse"
+)
+
+// Widest is a widest-path tree created by the WidestPathTree single-source
+// widest path function. The width of a path is the minimum edge weight along
+// the path.
+type Widest struct {
+	// from holds the source node given to
+	// WidestPathTree.
+	from graph.Node
+
+	// nodes hold the nodes of the analysed
+	// graph.
+	nodes []graph.Node
+	// indexOf contains a mapping between
+	// the id-dense representation of the
+	// graph and the potentially id-sparse
+	// nodes held in nodes.
+	indexOf map[int64]int
+
+	// width contains the widths of the
+	// widest paths from the from node
+	// to each node in the graph.
+	width []float64
+	// next contains the widest-path
+	// tree of the graph.
+	next []int
+}
+
+func newWidestFrom(u graph.Node, nodes []graph.Node) Widest {
+	indexOf := make(map[int64]int, len(nodes))
+	uid := u.ID()
+	for i, n := range nodes {
+		indexOf[n.ID()] = i
+		if n.ID() == uid {
+			u = n
+		}
+	}
+
+	p := Widest{
+		from: u,
+
+		nodes:   nodes,
+		indexOf: indexOf,
+
+		width: make([]float64, len(nodes)),
+		next:  make([]int, len(nodes)),
+	}
+	for i := range nodes {
+		p.width[i] = math.Inf(-1)
+		p.next[i] = -1
+	}
+	p.width[indexOf[uid]] = math.Inf(1)
+
+	return p
+}
+
+func (p *Widest) add(u graph.Node) int {
+	uid := u.ID()
+	if _, exists := p.indexOf[uid]; exists {
+		panic("widest: adding existing node")
+	}
+	idx := len(p.nodes)
+	p.indexOf[uid] = idx
+	p.nodes = append(p.nodes, u)
+	p.width = append(p.width, math.Inf(-1))
+	p.next = append(p.next, -1)
+	return idx
+}
+
+// From returns the starting node of the paths held by the Widest.
+func (p Widest) From() graph.Node { return p.from }
+
+// WidthTo returns the width of the widest path to v. The width of the path
+// to the starting node is +Inf and the width to an unreachable node is -Inf.
+func (p Widest) WidthTo(vid int64) float64 {
+	to, toOK := p.indexOf[vid]
+	if !toOK {
+		return math.Inf(-1)
+	}
+	return p.width[to]
+}
+
+// To returns a widest path to v and the width of the path. If v is not
+// reachable from the starting node, To returns a nil path and a width
+// of -Inf.
+func (p Widest) To(vid int64) (path []graph.Node, width float64) {
+	to, toOK := p.indexOf[vid]
+	if !toOK || math.IsInf(p.width[to], -1) {
+		return nil, math.Inf(-1)
+	}
+	from := p.indexOf[p.from.ID()]
+	path = []graph.Node{p.nodes[to]}
+	for to != from {
+		to = p.next[to]
+		path = append(path, p.nodes[to])
+	}
+	slices.Reverse(path)
+	return path, p.width[p.indexOf[vid]]
+}
+
+// WidestPath returns a widest path from s to t in the graph g and its width.
+// The width of a path is the minimum edge weight along the path, so a widest
+// path is a path that maximizes the bottleneck capacity between s and t when
+// edge weights are treated as capacities. The search terminates as soon as
+// the widest path to t is known. If t is not reachable from s, the returned
+// path is nil and the width is -Inf. If s and t are the same node, the path
+// holds only s and the width is +Inf. If the graph does not implement
+// Weighted, UniformCost is used.
+//
+// When more than one widest path exists, the path returned is the first found
+// by the search and depends on the iteration order of g. In particular, it is
+// not guaranteed to have the fewest edges of the widest paths.
+//
+// The time complexity of WidestPath is O(|E|.log|V|).
+func WidestPath(g graph.Graph, s, t graph.Node) (path []graph.Node, width float64) {
+	if g.Node(s.ID()) == nil || g.Node(t.ID()) == nil {
+		return nil, math.Inf(-1)
+	}
+	return widestFrom(s, t, g).To(t.ID())
+}
+
+// WidestPathTree returns a widest-path tree for the widest paths from s to
+// all nodes in the graph g. The width of a path is the minimum edge weight
+// along the path. If the graph does not implement Weighted, UniformCost is
+// used. The tie-breaking behavior when more than one widest path exists is
+// the same as for WidestPath.
+//
+// If g is a graph.Graph, all nodes of the graph will be stored in the
+// widest-path tree, otherwise only nodes reachable from s will be stored.
+//
+// The time complexity of WidestPathTree is O(|E|.log|V|).
+func WidestPathTree(s graph.Node, g traverse.Graph) Widest {
+	return widestFrom(s, nil, g)
+}
+
+func widestFrom(u, t graph.Node, g traverse.Graph) Widest {
+	var path Widest
+	if h, ok := g.(graph.Graph); t == nil && ok {
+		if h.Node(u.ID()) == nil {
+			return Widest{from: u}
+		}
+		path = newWidestFrom(u, graph.NodesOf(h.Nodes()))
+	} else {
+		path = newWidestFrom(u, []graph.Node{u})
+	}
+
+	var weight Weighting
+	if wg, ok := g.(Weighted); ok {
+		weight = wg.Weight
+	} else {
+		weight = UniformCost(g)
+	}
+
+	Q := widestQueue{{node: u, width: math.Inf(1)}}
+	for Q.Len() != 0 {
+		mid := heap.Pop(&Q).(widthNode)
+		k := path.indexOf[mid.node.ID()]
+		if mid.width < path.width[k] {
+			continue
+		}
+		mnid := mid.node.ID()
+		if t != nil && mnid == t.ID() {
+			break
+		}
+		to := g.From(mnid)
+		for to.Next() {
+			v := to.Node()
+			vid := v.ID()
+			j, ok := path.indexOf[vid]
+			if !ok {
+				j = path.add(v)
+			}
+			w, ok := weight(mnid, vid)
+			if !ok {
+				panic("widest: unexpected invalid weight")
+			}
+			joint := math.Min(path.width[k], w)
+			if joint > path.width[j] {
+				heap.Push(&Q, widthNode{node: v, width: joint})
+				path.width[j] = joint
+				path.next[j] = k
+			}
+		}
+	}
+
+	return path
+}
+
+type widthNode struct {
+	node  graph.Node
+	width float64
+}
+
+// widestQueue implements a max-priority queue on path width.
+type widestQueue []widthNode
+
+func (q widestQueue) Len() int            { return len(q) }
+func (q widestQueue) Less(i, j int) bool  { return q[i].width > q[j].width }
+func (q widestQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *widestQueue) Push(n interface{}) { *q = append(*q, n.(widthNode)) }
+func (q *widestQueue) Pop() interface{} {
+	t := *q
+	var n interface{}
+	n, *q = t[len(t)-1], t[:len(t)-1]
+	return n
+}
@@ -0,0 +1,200 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package path
+
+import (
+	"math"
+	"math/rand/v2"
+	"reflect"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/graph/traverse"
+)
+
+var widestPathTests = []struct {
+	name  string
+	graph func() graph.WeightedEdgeAdder
+	edges []simple.WeightedEdge
+
+	query     simple.Edge
+	width     float64
+	wantPaths [][]int64
+}{
+	{
+		name:      "empty directed",
+		graph:     func() graph.WeightedEdgeAdder { return simple.NewWeightedDirectedGraph(0, math.Inf(1)) },
+		query:     simple.Edge{F: simple.Node(0), T: simple.Node(1)},
+		width:     math.Inf(-1),
+		wantPaths: nil,
+	},
+	{
+		name:  "source is target",
+		graph: func() graph.WeightedEdgeAdder { return simple.NewWeightedDirectedGraph(0, math.Inf(1)) },
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 1},
+		},
+		query:     simple.Edge{F: simple.Node(0), T: simple.Node(0)},
+		width:     math.Inf(1),
+		wantPaths: [][]int64{{0}},
+	},
+	{
+		name:  "bottleneck",
+		graph: func() graph.WeightedEdgeAdder { return simple.NewWeightedDirectedGraph(0, math.Inf(1)) },
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 10},
+			{F: simple.Node(1), T: simple.Node(3), W: 1},
+			{F: simple.Node(0), T: simple.Node(2), W: 3},
+			{F: simple.Node(2), T: simple.Node(3), W: 4},
+			{F: simple.Node(1), T: simple.Node(2), W: 8},
+		},
+		query:     simple.Edge{F: simple.Node(0), T: simple.Node(3)},
+		width:     4,
+		wantPaths: [][]int64{{0, 1, 2, 3}},
+	},
+	{
+		name:  "ties",
+		graph: func() graph.WeightedEdgeAdder { return simple.NewWeightedDirectedGraph(0, math.Inf(1)) },
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 5},
+			{F: simple.Node(1), T: simple.Node(3), W: 5},
+			{F: simple.Node(0), T: simple.Node(2), W: 5},
+			{F: simple.Node(2), T: simple.Node(3), W: 7},
+		},
+		query:     simple.Edge{F: simple.Node(0), T: simple.Node(3)},
+		width:     5,
+		wantPaths: [][]int64{{0, 1, 3}, {0, 2, 3}},
+	},
+	{
+		name:  "directed unreachable",
+		graph: func() graph.WeightedEdgeAdder { return simple.NewWeightedDirectedGraph(0, math.Inf(1)) },
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(1), T: simple.Node(0), W: 5},
+		},
+		query:     simple.Edge{F: simple.Node(0), T: simple.Node(1)},
+		width:     math.Inf(-1),
+		wantPaths: nil,
+	},
+	{
+		name:  "undirected",
+		graph: func() graph.WeightedEdgeAdder { return simple.NewWeightedUndirectedGraph(0, math.Inf(1)) },
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(1), T: simple.Node(0), W: 5},
+			{F: simple.Node(2), T: simple.Node(1), W: 6},
+			{F: simple.Node(2), T: simple.Node(0), W: 2},
+		},
+		query:     simple.Edge{F: simple.Node(0), T: simple.Node(2)},
+		width:     5,
+		wantPaths: [][]int64{{0, 1, 2}},
+	},
+}
+
+func TestWidestPath(t *testing.T) {
+	t.Parallel()
+	for _, test := range widestPathTests {
+		g := test.graph()
+		for _, e := range test.edges {
+			g.SetWeightedEdge(e)
+		}
+
+		for _, tg := range []struct {
+			typ string
+			fn  func() ([]graph.Node, float64)
+		}{
+			{"single-destination", func() ([]graph.Node, float64) {
+				return WidestPath(g.(graph.Graph), test.query.From(), test.query.To())
+			}},
+			{"complete", func() ([]graph.Node, float64) {
+				return WidestPathTree(test.query.From(), g.(graph.Graph)).To(test.query.To().ID())
+			}},
+			{"incremental", func() ([]graph.Node, float64) {
+				return WidestPathTree(test.query.From(), incremental{g.(graph.Weighted)}).To(test.query.To().ID())
+			}},
+		} {
+			p, width := tg.fn()
+			if width != test.width {
+				t.Errorf("%q %s: unexpected width: got:%f want:%f", test.name, tg.typ, width, test.width)
+			}
+
+			got := pathIDs([][]graph.Node{p})[0]
+			ok := len(got) == 0 && len(test.wantPaths) == 0
+			for _, sp := range test.wantPaths {
+				if reflect.DeepEqual(got, sp) {
+					ok = true
+					break
+				}
+			}
+			if !ok {
+				t.Errorf("%q %s: unexpected widest path:\ngot: %v\nwant from:%v", test.name, tg.typ, got, test.wantPaths)
+			}
+		}
+	}
+}
+
+func TestWidestPathTreeRandom(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for n := 0; n < 20; n++ {
+		g := simple.NewWeightedDirectedGraph(0, math.Inf(1))
+		const size = 15
+		for i := 0; i < size; i++ {
+			g.AddNode(simple.Node(i))
+		}
+		for i := 0; i < size; i++ {
+			for j := 0; j < size; j++ {
+				if i != j && rnd.Float64() < 0.2 {
+					g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(i), T: simple.Node(j), W: float64(rnd.IntN(10))})
+				}
+			}
+		}
+
+		pt := WidestPathTree(simple.Node(0), g)
+		for _, v := range graph.NodesOf(g.Nodes()) {
+			want := bruteWidth(g, simple.Node(0), v)
+			if got := pt.WidthTo(v.ID()); got != want {
+				t.Errorf("unexpected width to %d in graph %d: got:%f want:%f", v.ID(), n, got, want)
+			}
+			p, width := pt.To(v.ID())
+			if width != want {
+				t.Errorf("unexpected width of path to %d in graph %d: got:%f want:%f", v.ID(), n, width, want)
+			}
+			bottleneck := math.Inf(1)
+			for i := 1; i < len(p); i++ {
+				w, _ := g.Weight(p[i-1].ID(), p[i].ID())
+				bottleneck = math.Min(bottleneck, w)
+			}
+			if p != nil && bottleneck != want {
+				t.Errorf("unexpected bottleneck of path to %d in graph %d: got:%f want:%f", v.ID(), n, bottleneck, want)
+			}
+		}
+	}
+}
+
+// bruteWidth returns the width of the widest path from s to t in g by finding
+// the largest edge weight for which t is reachable from s using only edges at
+// least as heavy.
+func bruteWidth(g *simple.WeightedDirectedGraph, s, t graph.Node) float64 {
+	if s.ID() == t.ID() {
+		return math.Inf(1)
+	}
+	width := math.Inf(-1)
+	edges := g.WeightedEdges()
+	for edges.Next() {
+		c := edges.WeightedEdge().Weight()
+		if c <= width {
+			continue
+		}
+		bf := traverse.BreadthFirst{
+			Traverse: func(e graph.Edge) bool {
+				return e.(graph.WeightedEdge).Weight() >= c
+			},
+		}
+		if bf.Walk(g, s, func(n graph.Node, _ int) bool { return n.ID() == t.ID() }) != nil {
+			width = c
+		}
+	}
+	return width
+}
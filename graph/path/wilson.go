@@ -0,0 +1,78 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package path
+
+import (
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/topo"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+// RandomSpanningTree generates a spanning tree of the undirected graph g sampled
+// uniformly from all spanning trees of g using Wilson's algorithm, placing the
+// result in the destination, dst. The tree is built by loop-erased random walks
+// from each node not yet in the tree until the walk reaches the tree, which
+// initially holds only the lowest ID node. If g is not connected, a spanning
+// forest is constructed with a uniformly sampled tree for each connected
+// component, each grown from the lowest ID node of its component. Self edges
+// are ignored, and parallel lines of a multigraph are treated as a single edge.
+// The destination is not cleared first.
+//
+// The walks visit the nodes and their neighbours in order of ID, so the tree is
+// deterministic for a given g and random source. If src is not nil it is used
+// as the random source, otherwise rand.IntN is used.
+//
+// Nodes and Edges from g are used to construct dst, so if the Node and Edge
+// types used in g are pointer or reference-like, then the values will be shared
+// between the graphs.
+//
+// The expected time complexity of RandomSpanningTree is the mean hitting time
+// of the random walk on g, which is O(|V|^3) in the worst case.
+func RandomSpanningTree(dst graph.Builder, g graph.Undirected, src rand.Source) {
+	rndN := rand.IntN
+	if src != nil {
+		rndN = rand.New(src).IntN
+	}
+
+	components := topo.ConnectedComponents(g)
+	for _, c := range components {
+		order.ByID(c)
+	}
+	order.BySliceIDs(components)
+
+	inTree := make(map[int64]bool)
+	next := make(map[int64]int64)
+	neighbours := make(map[int64][]graph.Node)
+	for _, c := range components {
+		for _, u := range c {
+			dst.AddNode(u)
+		}
+		inTree[c[0].ID()] = true
+		for _, u := range c[1:] {
+			// Walk randomly until the tree is reached,
+			// recording only the last exit from each
+			// node, which erases the loops of the walk.
+			for uid := u.ID(); !inTree[uid]; uid = next[uid] {
+				to, ok := neighbours[uid]
+				if !ok {
+					for _, v := range graph.NodesOf(g.From(uid)) {
+						if v.ID() != uid {
+							to = append(to, v)
+						}
+					}
+					order.ByID(to)
+					neighbours[uid] = to
+				}
+				next[uid] = to[rndN(len(to))].ID()
+			}
+			for uid := u.ID(); !inTree[uid]; uid = next[uid] {
+				inTree[uid] = true
+				dst.SetEdge(g.Edge(uid, next[uid]))
+			}
+		}
+	}
+}
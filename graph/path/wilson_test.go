@@ -0,0 +1,121 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package path
+
+import (
+	"cmp"
+	"fmt"
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/graph/topo"
+)
+
+func TestRandomSpanningTreeUniform(t *testing.T) {
+	t.Parallel()
+	// K4 has 4^2 = 16 spanning trees by Cayley's formula.
+	g := simple.NewUndirectedGraph()
+	for u := 0; u < 4; u++ {
+		for v := u + 1; v < 4; v++ {
+			g.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+		}
+	}
+	const (
+		trees   = 16
+		samples = 16000
+	)
+	src := rand.NewPCG(1, 1)
+	counts := make(map[string]int)
+	for i := 0; i < samples; i++ {
+		dst := simple.NewUndirectedGraph()
+		RandomSpanningTree(dst, g, src)
+		checkSpanningForest(t, g, dst)
+		counts[treeKey(dst)]++
+	}
+	if len(counts) != trees {
+		t.Errorf("unexpected number of distinct trees: got:%d want:%d", len(counts), trees)
+	}
+	const want = samples / trees
+	for k, n := range counts {
+		// The standard deviation of each count is about 30.
+		if n < want-150 || n > want+150 {
+			t.Errorf("tree %s sampled with unexpected frequency: got:%d want:%d±150", k, n, want)
+		}
+	}
+}
+
+func TestRandomSpanningTreeForest(t *testing.T) {
+	t.Parallel()
+	g := simple.NewUndirectedGraph()
+	for _, e := range [][2]int64{{0, 1}, {1, 2}, {2, 0}, {3, 4}, {4, 5}, {5, 6}, {6, 3}, {3, 5}} {
+		g.SetEdge(simple.Edge{F: simple.Node(e[0]), T: simple.Node(e[1])})
+	}
+	g.AddNode(simple.Node(7))
+
+	var first string
+	for i := 0; i < 3; i++ {
+		dst := simple.NewUndirectedGraph()
+		RandomSpanningTree(dst, g, rand.NewPCG(2, 2))
+		checkSpanningForest(t, g, dst)
+		if got := len(topo.ConnectedComponents(dst)); got != 3 {
+			t.Errorf("unexpected number of trees: got:%d want:3", got)
+		}
+		key := treeKey(dst)
+		if i == 0 {
+			first = key
+		} else if key != first {
+			t.Errorf("tree not deterministic for fixed source: got:%s want:%s", key, first)
+		}
+	}
+}
+
+// checkSpanningForest checks that forest is a spanning forest of g.
+func checkSpanningForest(t *testing.T, g, forest *simple.UndirectedGraph) {
+	t.Helper()
+	if !reflect.DeepEqual(nodeIDs(forest), nodeIDs(g)) {
+		t.Errorf("forest does not span graph: got:%v want:%v", nodeIDs(forest), nodeIDs(g))
+	}
+	for _, e := range graph.EdgesOf(forest.Edges()) {
+		if !g.HasEdgeBetween(e.From().ID(), e.To().ID()) {
+			t.Errorf("forest edge %d-%d is not in graph", e.From().ID(), e.To().ID())
+		}
+	}
+	n := forest.Nodes().Len()
+	if got, want := forest.Edges().Len(), n-len(topo.ConnectedComponents(g)); got != want {
+		t.Errorf("unexpected number of forest edges: got:%d want:%d", got, want)
+	}
+}
+
+func nodeIDs(g graph.Graph) []int64 {
+	var ids []int64
+	for _, n := range graph.NodesOf(g.Nodes()) {
+		ids = append(ids, n.ID())
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// treeKey returns a canonical representation of the edges of g.
+func treeKey(g *simple.UndirectedGraph) string {
+	var edges [][2]int64
+	for _, e := range graph.EdgesOf(g.Edges()) {
+		u, v := e.From().ID(), e.To().ID()
+		if v < u {
+			u, v = v, u
+		}
+		edges = append(edges, [2]int64{u, v})
+	}
+	slices.SortFunc(edges, func(a, b [2]int64) int {
+		if c := cmp.Compare(a[0], b[0]); c != 0 {
+			return c
+		}
+		return cmp.Compare(a[1], b[1])
+	})
+	return fmt.Sprint(edges)
+}
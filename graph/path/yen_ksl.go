@@ -0,0 +1,118 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package path
+
+import (
+	"context"
+	"math"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+// YenKShortestLines returns the k-shortest loopless paths from s to t in the
+// multigraph g with path costs no greater than cost beyond the shortest path.
+// Each path is returned as the sequence of lines traversed, so paths that
+// differ only in which of a set of parallel lines is used are distinct. If k
+// is negative, only path cost will be used to limit the set of returned paths.
+// If s and t are the same node, a single path with no lines is returned.
+//
+// Lines that implement graph.WeightedLine are weighted by their Weight method,
+// other lines have a weight of 1. If g is an undirected multigraph, a line may
+// be traversed in either direction. Self lines are ignored.
+//
+// The search is made by YenKShortestPaths on a copy of g in which each line
+// is replaced by a node joined to the ends of the line, so a loopless path
+// in the copy uses each node and each line of g at most once.
+// YenKShortestLines will panic if g contains a negative line weight.
+func YenKShortestLines(g graph.Multigraph, k int, cost float64, s, t graph.Node) [][]graph.Line {
+	if g.Node(s.ID()) == nil || g.Node(t.ID()) == nil {
+		return nil
+	}
+	sg, lines := splitLines(g)
+	ksp := newYenKSP(sg, cost, s, t)
+	paths, _, _ := yenKShortestPaths(context.Background(), ksp, k)
+
+	linePaths := make([][]graph.Line, len(paths))
+	for i, p := range paths {
+		lp := make([]graph.Line, 0, len(p)/2)
+		for j := 1; j < len(p); j += 2 {
+			l := lines[p[j].ID()]
+			if l.From().ID() != p[j-1].ID() {
+				l = l.ReversedLine()
+			}
+			lp = append(lp, l)
+		}
+		linePaths[i] = lp
+	}
+	return linePaths
+}
+
+// splitLines returns a weighted directed graph holding the nodes of g and
+// a node for each line u→v of g, joined by an edge from u weighted by the
+// line's weight and an edge to v with zero weight, and a map from the IDs
+// of the line nodes to their lines. If g is undirected, the line node is
+// also joined by an edge from v and an edge to u.
+func splitLines(g graph.Multigraph) (*simple.WeightedDirectedGraph, map[int64]graph.Line) {
+	_, isDirected := g.(graph.DirectedMultigraph)
+	sg := simple.NewWeightedDirectedGraph(0, math.Inf(1))
+	nodes := g.Nodes()
+	for nodes.Next() {
+		sg.AddNode(nodes.Node())
+	}
+
+	lines := make(map[int64]graph.Line)
+	seen := make(map[lineKey]struct{})
+	nodes.Reset()
+	for nodes.Next() {
+		uid := nodes.Node().ID()
+		to := g.From(uid)
+		for to.Next() {
+			vid := to.Node().ID()
+			if vid == uid {
+				continue
+			}
+			it := g.Lines(uid, vid)
+			for it.Next() {
+				l := it.Line()
+				key := lineKey{from: l.From().ID(), to: l.To().ID(), id: l.ID()}
+				if !isDirected && key.to < key.from {
+					key.from, key.to = key.to, key.from
+				}
+				if _, ok := seen[key]; ok {
+					continue
+				}
+				seen[key] = struct{}{}
+
+				n := sg.NewNode()
+				sg.AddNode(n)
+				lines[n.ID()] = l
+				w := lineWeight(l)
+				u, v := l.From(), l.To()
+				sg.SetWeightedEdge(simple.WeightedEdge{F: u, T: n, W: w})
+				sg.SetWeightedEdge(simple.WeightedEdge{F: n, T: v, W: 0})
+				if !isDirected {
+					sg.SetWeightedEdge(simple.WeightedEdge{F: v, T: n, W: w})
+					sg.SetWeightedEdge(simple.WeightedEdge{F: n, T: u, W: 0})
+				}
+			}
+		}
+	}
+	return sg, lines
+}
+
+// lineWeight returns the weight of l if it is a graph.WeightedLine
+// and 1 otherwise.
+func lineWeight(l graph.Line) float64 {
+	if l, ok := l.(graph.WeightedLine); ok {
+		return l.Weight()
+	}
+	return 1
+}
+
+// lineKey identifies a line in a multigraph by its end points and ID.
+type lineKey struct {
+	from, to, id int64
+}
@@ -0,0 +1,196 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package path
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/multi"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+var yenShortestLinesTests = []struct {
+	name  string
+	graph func() graph.WeightedLineAdder
+	lines []multi.WeightedLine
+
+	query simple.Edge
+	k     int
+	cost  float64
+
+	// wantPaths holds the line weights
+	// of each expected path.
+	wantPaths [][]float64
+}{
+	{
+		name:  "parallel lines",
+		graph: func() graph.WeightedLineAdder { return multi.NewWeightedDirectedGraph() },
+		lines: []multi.WeightedLine{
+			{F: multi.Node(0), T: multi.Node(1), W: 1, UID: 0},
+			{F: multi.Node(0), T: multi.Node(1), W: 2, UID: 1},
+			{F: multi.Node(1), T: multi.Node(2), W: 10, UID: 0},
+			{F: multi.Node(1), T: multi.Node(2), W: 20, UID: 1},
+			{F: multi.Node(0), T: multi.Node(2), W: 25, UID: 0},
+		},
+		query: simple.Edge{F: simple.Node(0), T: simple.Node(2)},
+		k:     -1,
+		cost:  math.Inf(1),
+		wantPaths: [][]float64{
+			{1, 10},
+			{2, 10},
+			{1, 20},
+			{2, 20},
+			{25},
+		},
+	},
+	{
+		name:  "parallel lines limited",
+		graph: func() graph.WeightedLineAdder { return multi.NewWeightedDirectedGraph() },
+		lines: []multi.WeightedLine{
+			{F: multi.Node(0), T: multi.Node(1), W: 1, UID: 0},
+			{F: multi.Node(0), T: multi.Node(1), W: 2, UID: 1},
+			{F: multi.Node(1), T: multi.Node(2), W: 10, UID: 0},
+			{F: multi.Node(1), T: multi.Node(2), W: 20, UID: 1},
+			{F: multi.Node(0), T: multi.Node(2), W: 25, UID: 0},
+		},
+		query: simple.Edge{F: simple.Node(0), T: simple.Node(2)},
+		k:     2,
+		cost:  math.Inf(1),
+		wantPaths: [][]float64{
+			{1, 10},
+			{2, 10},
+		},
+	},
+	{
+		name:  "parallel lines cost limited",
+		graph: func() graph.WeightedLineAdder { return multi.NewWeightedDirectedGraph() },
+		lines: []multi.WeightedLine{
+			{F: multi.Node(0), T: multi.Node(1), W: 1, UID: 0},
+			{F: multi.Node(0), T: multi.Node(1), W: 2, UID: 1},
+			{F: multi.Node(1), T: multi.Node(2), W: 10, UID: 0},
+			{F: multi.Node(1), T: multi.Node(2), W: 20, UID: 1},
+			{F: multi.Node(0), T: multi.Node(2), W: 25, UID: 0},
+		},
+		query: simple.Edge{F: simple.Node(0), T: simple.Node(2)},
+		k:     -1,
+		cost:  1,
+		wantPaths: [][]float64{
+			{1, 10},
+			{2, 10},
+		},
+	},
+	{
+		name:  "undirected parallel lines",
+		graph: func() graph.WeightedLineAdder { return multi.NewWeightedUndirectedGraph() },
+		lines: []multi.WeightedLine{
+			{F: multi.Node(0), T: multi.Node(1), W: 1, UID: 0},
+			{F: multi.Node(1), T: multi.Node(0), W: 2, UID: 1},
+			{F: multi.Node(1), T: multi.Node(2), W: 10, UID: 0},
+		},
+		query: simple.Edge{F: simple.Node(2), T: simple.Node(0)},
+		k:     -1,
+		cost:  math.Inf(1),
+		wantPaths: [][]float64{
+			{10, 1},
+			{10, 2},
+		},
+	},
+	{
+		name:  "source is target",
+		graph: func() graph.WeightedLineAdder { return multi.NewWeightedUndirectedGraph() },
+		lines: []multi.WeightedLine{
+			{F: multi.Node(0), T: multi.Node(1), W: 1, UID: 0},
+		},
+		query:     simple.Edge{F: simple.Node(0), T: simple.Node(0)},
+		k:         -1,
+		cost:      math.Inf(1),
+		wantPaths: [][]float64{nil},
+	},
+	{
+		name:  "no path",
+		graph: func() graph.WeightedLineAdder { return multi.NewWeightedDirectedGraph() },
+		lines: []multi.WeightedLine{
+			{F: multi.Node(1), T: multi.Node(0), W: 1, UID: 0},
+		},
+		query: simple.Edge{F: simple.Node(0), T: simple.Node(1)},
+		k:     -1,
+		cost:  math.Inf(1),
+	},
+}
+
+func TestYenKShortestLines(t *testing.T) {
+	t.Parallel()
+	for _, test := range yenShortestLinesTests {
+		g := test.graph()
+		for _, l := range test.lines {
+			g.SetWeightedLine(l)
+		}
+
+		paths := YenKShortestLines(g.(graph.Multigraph), test.k, test.cost, test.query.From(), test.query.To())
+		var got [][]float64
+		for _, p := range paths {
+			var weights []float64
+			for i, l := range p {
+				if i == 0 && l.From().ID() != test.query.From().ID() {
+					t.Errorf("unexpected path start for %q: got:%d want:%d", test.name, l.From().ID(), test.query.From().ID())
+				}
+				if i != 0 && l.From().ID() != p[i-1].To().ID() {
+					t.Errorf("discontinuous path for %q: %v", test.name, p)
+				}
+				weights = append(weights, l.(graph.WeightedLine).Weight())
+			}
+			got = append(got, weights)
+		}
+		if !reflect.DeepEqual(got, test.wantPaths) {
+			t.Errorf("unexpected result for %q:\ngot: %v\nwant:%v", test.name, got, test.wantPaths)
+		}
+	}
+}
+
+func TestYenKShortestLinesSimple(t *testing.T) {
+	t.Parallel()
+	for _, test := range yenShortestPathTests {
+		g := test.graph()
+		for _, e := range test.edges {
+			g.SetWeightedEdge(e)
+		}
+		var mg graph.WeightedLineAdder
+		if _, ok := g.(graph.Directed); ok {
+			mg = multi.NewWeightedDirectedGraph()
+		} else {
+			mg = multi.NewWeightedUndirectedGraph()
+		}
+		_, directed := g.(graph.Directed)
+		wg := g.(graph.Weighted)
+		for _, u := range graph.NodesOf(wg.Nodes()) {
+			for _, v := range graph.NodesOf(wg.From(u.ID())) {
+				if !directed && v.ID() < u.ID() {
+					continue
+				}
+				e := wg.WeightedEdge(u.ID(), v.ID())
+				mg.SetWeightedLine(multi.WeightedLine{F: multi.Node(u.ID()), T: multi.Node(v.ID()), W: e.Weight()})
+			}
+		}
+
+		_, want := YenKShortestPathsWeighted(g.(graph.Graph), test.k, test.cost, test.query.From(), test.query.To())
+		got := YenKShortestLines(mg.(graph.Multigraph), test.k, test.cost, test.query.From(), test.query.To())
+		if len(got) != len(want) {
+			t.Errorf("unexpected number of paths for %q: got:%d want:%d", test.name, len(got), len(want))
+			continue
+		}
+		for i, p := range got {
+			var w float64
+			for _, l := range p {
+				w += lineWeight(l)
+			}
+			if w != want[i] {
+				t.Errorf("unexpected weight for path %d of %q: got:%v want:%v", i, test.name, w, want[i])
+			}
+		}
+	}
+}
@@ -6,6 +6,7 @@ package path
 
 import (
 	"cmp"
+	"context"
 	"math"
 	"slices"
 
@@ -18,104 +19,672 @@ import (
 // If k is negative, only path cost will be used to limit the set of returned
 // paths. YenKShortestPaths will panic if g contains a negative edge weight.
 func YenKShortestPaths(g graph.Graph, k int, cost float64, s, t graph.Node) [][]graph.Node {
-	// See https://en.wikipedia.org/wiki/Yen's_algorithm and
-	// the paper at https://doi.org/10.1090%2Fqam%2F253822.
+	paths, _ := YenKShortestPathsWeighted(g, k, cost, s, t)
+	return paths
+}
 
-	_, isDirected := g.(graph.Directed)
-	yk := yenKSPAdjuster{
-		Graph:      g,
-		isDirected: isDirected,
+// YenKShortestPathsWeighted returns the k-shortest loopless paths from s to t
+// in g with path costs no greater than cost beyond the shortest path, and the
+// total weight of each returned path. The semantics of k and cost are the same
+// as for YenKShortestPaths. YenKShortestPathsWeighted will panic if g contains
+// a negative edge weight.
+func YenKShortestPathsWeighted(g graph.Graph, k int, cost float64, s, t graph.Node) (paths [][]graph.Node, weights []float64) {
+	paths, weights, _ = yenKShortestPaths(context.Background(), newYenKSP(g, cost, s, t), k)
+	return paths, weights
+}
+
+// YenPath is a path found by Yen's algorithm.
+type YenPath struct {
+	// Nodes is the sequence of
+	// nodes in the path.
+	Nodes []graph.Node
+
+	// Weight is the total weight
+	// of the path.
+	Weight float64
+
+	// SpurIndex is the index of the spur
+	// node in Nodes where the path deviates
+	// from the path it was derived from.
+	// Nodes[:SpurIndex+1] is the root path
+	// shared with that path. SpurIndex is -1
+	// for the shortest path.
+	SpurIndex int
+}
+
+// YenKShortestPathsDetailed returns the k-shortest loopless paths from s to t
+// in g with path costs no greater than cost beyond the shortest path, with the
+// weight of each path and the position in the path where it deviates from the
+// previously found path it was derived from. The semantics of k and cost are
+// the same as for YenKShortestPaths. YenKShortestPathsDetailed will panic if g
+// contains a negative edge weight.
+func YenKShortestPathsDetailed(g graph.Graph, k int, cost float64, s, t graph.Node) []YenPath {
+	ksp := newYenKSP(g, cost, s, t)
+	var paths []YenPath
+	for {
+		p, ok, _ := ksp.next(context.Background())
+		if !ok {
+			break
+		}
+		paths = append(paths, YenPath{Nodes: p.path, Weight: p.weight, SpurIndex: p.spur})
+		if k >= 0 && len(paths) >= k {
+			break
+		}
 	}
+	return paths
+}
 
+// YenKShortestPathsSafe returns the k-shortest loopless paths from s to t in g
+// with path costs no greater than cost beyond the shortest path. The semantics
+// of k and cost are the same as for YenKShortestPaths. If g contains a negative
+// edge weight, no path is returned and the error is a NegativeWeightError
+// describing the first offending edge found.
+func YenKShortestPathsSafe(g graph.Graph, k int, cost float64, s, t graph.Node) ([][]graph.Node, error) {
+	var weight Weighting
 	if wg, ok := g.(Weighted); ok {
-		yk.weight = wg.Weight
+		weight = wg.Weight
 	} else {
-		yk.weight = UniformCost(g)
+		weight = UniformCost(g)
+	}
+	err := checkNonNegative(g, weight)
+	if err != nil {
+		return nil, err
 	}
+	return YenKShortestPaths(g, k, cost, s, t), nil
+}
 
-	shortest, weight := DijkstraFromTo(s, t, yk)
-	cost += weight // Set cost to absolute cost limit.
-	switch len(shortest) {
-	case 0:
+// YenKShortestPathsLimited returns the k-shortest loopless paths from s to t
+// in g with at most maxHops edges and with path costs no greater than cost
+// beyond the shortest such path. The semantics of k and cost are the same as
+// for YenKShortestPaths. If maxHops is negative, the number of edges in a path
+// is not limited.
+//
+// Paths with more than maxHops edges are retained by the search as the basis
+// for deriving further spur paths, but are not returned; discarding them
+// before they are considered would prevent shorter-hop paths that deviate
+// from them from being found. Spur nodes whose root path cannot be extended
+// to t within the hop limit are not searched, and if no path from s to t has
+// at most maxHops edges no search is made. Since the cost limit is not known
+// until the shortest path within the hop limit has been found, the search may
+// still consider many more paths than are returned when most of the lowest
+// cost paths have more than maxHops edges. YenKShortestPathsLimited will
+// panic if g contains a negative edge weight.
+func YenKShortestPathsLimited(g graph.Graph, k int, cost float64, maxHops int, s, t graph.Node) [][]graph.Node {
+	if maxHops < 0 {
+		return YenKShortestPaths(g, k, cost, s, t)
+	}
+	hops := hopsTo(g, t)
+	if d, ok := hops[s.ID()]; !ok || d > maxHops {
 		return nil
-	case 1:
-		return [][]graph.Node{shortest}
 	}
-	paths := [][]graph.Node{shortest}
-
-	var pot []yenShortest
-	var root []graph.Node
-	for i := int64(1); k < 0 || i < int64(k); i++ {
-		// The spur node ranges from the first node to the next
-		// to last node in the previous k-shortest path.
-		for n := 0; n < len(paths[i-1])-1; n++ {
-			yk.reset()
 
-			spur := paths[i-1][n]
-			root := append(root[:0], paths[i-1][:n+1]...)
+	// The cost limit is relative to the shortest path
+	// within the hop limit, so it is not known until
+	// that path has been found.
+	ksp := newYenKSP(g, math.Inf(1), s, t)
+	ksp.hops = hops
+	ksp.maxHops = maxHops
+	limit := math.Inf(1)
+	var paths [][]graph.Node
+	for {
+		p, ok, _ := ksp.next(context.Background())
+		if !ok || p.weight > limit {
+			break
+		}
+		if len(p.path)-1 > maxHops {
+			continue
+		}
+		if paths == nil {
+			limit = p.weight + cost
+			ksp.cost = limit
+		}
+		paths = append(paths, p.path)
+		if k >= 0 && len(paths) >= k {
+			break
+		}
+	}
+	return paths
+}
 
-			for _, path := range paths {
-				if len(path) <= n {
-					continue
-				}
-				ok := true
-				for x := 0; x < len(root); x++ {
-					if path[x].ID() != root[x].ID() {
-						ok = false
-						break
-					}
-				}
-				if ok {
-					yk.removeEdge(path[n].ID(), path[n+1].ID())
-				}
+// hopsTo returns the number of edges in the shortest paths from each node
+// in g that can reach t, keyed by node ID.
+func hopsTo(g graph.Graph, t graph.Node) map[int64]int {
+	to := g.From
+	if dg, ok := g.(graph.Directed); ok {
+		to = dg.To
+	}
+	hops := map[int64]int{t.ID(): 0}
+	queue := []int64{t.ID()}
+	for len(queue) != 0 {
+		vid := queue[0]
+		queue = queue[1:]
+		it := to(vid)
+		for it.Next() {
+			uid := it.Node().ID()
+			if _, seen := hops[uid]; !seen {
+				hops[uid] = hops[vid] + 1
+				queue = append(queue, uid)
 			}
-			for _, u := range root[:len(root)-1] {
-				yk.removeNode(u.ID())
+		}
+	}
+	return hops
+}
+
+// YenKShortestPathsContext returns the k-shortest loopless paths from s to t
+// in g with path costs no greater than cost beyond the shortest path. The
+// semantics of k and cost are the same as for YenKShortestPaths.
+//
+// If ctx is cancelled or its deadline is exceeded before the search is
+// complete, the paths found so far are returned with the context's error.
+// The returned paths are always a prefix of the complete result.
+// YenKShortestPathsContext will panic if g contains a negative edge weight.
+func YenKShortestPathsContext(ctx context.Context, g graph.Graph, k int, cost float64, s, t graph.Node) ([][]graph.Node, error) {
+	paths, _, err := yenKShortestPaths(ctx, newYenKSP(g, cost, s, t), k)
+	return paths, err
+}
+
+// YenKShortestPathsWith returns the k-shortest loopless paths from s to t in g
+// with path costs no greater than cost beyond the shortest path, using solve to
+// find the shortest path between a spur node and t in the graph adjusted by
+// Yen's algorithm. The semantics of k and cost are the same as for
+// YenKShortestPaths. The solve function must return a nil path if no path
+// exists. DijkstraPath and BellmanFordPath are provided as solvers; when
+// BellmanFordPath is used, g may contain negative edge weights as long as no
+// negative cycle is reachable from s.
+func YenKShortestPathsWith(g graph.Graph, k int, cost float64, s, t graph.Node, solve func(s, t graph.Node, g graph.Graph) ([]graph.Node, float64)) [][]graph.Node {
+	ksp := newYenKSP(g, cost, s, t)
+	ksp.solve = solve
+	paths, _, _ := yenKShortestPaths(context.Background(), ksp, k)
+	return paths
+}
+
+// YenKShortestPathsFunc returns the k-shortest loopless paths from s to t in g
+// with path costs no greater than cost beyond the shortest path, using less to
+// order paths with equal weight. The semantics of k and cost are the same as
+// for YenKShortestPaths. The less function must return a negative number when
+// a should be ordered before b, a positive number when b should be ordered
+// before a and zero when their order is not important. It is only called for
+// paths with equal weight. If less defines a total order over paths, the
+// returned paths do not depend on the iteration order of g. To ensure this,
+// all paths with a weight equal to the weight of the kth path are found before
+// the result is truncated to k paths. YenKShortestPathsFunc will panic if g
+// contains a negative edge weight.
+func YenKShortestPathsFunc(g graph.Graph, k int, cost float64, s, t graph.Node, less func(a, b []graph.Node) int) [][]graph.Node {
+	ksp := newYenKSP(g, cost, s, t)
+	ksp.tieBreak = less
+
+	var found []yenShortest
+	for {
+		p, ok, _ := ksp.next(context.Background())
+		if !ok {
+			break
+		}
+		if k >= 0 && len(found) >= k && (k == 0 || p.weight > found[len(found)-1].weight) {
+			break
+		}
+		found = append(found, p)
+	}
+	slices.SortStableFunc(found, ksp.compare)
+	if k >= 0 && len(found) > k {
+		found = found[:k]
+	}
+
+	var paths [][]graph.Node
+	for _, p := range found {
+		paths = append(paths, p.path)
+	}
+	return paths
+}
+
+// DijkstraPath returns a shortest path from s to t in g and its weight using
+// DijkstraFromTo. It is suitable for use as a solver for YenKShortestPathsWith.
+// DijkstraPath will panic if g has an s-reachable negative edge weight that is
+// discovered before reaching t.
+func DijkstraPath(s, t graph.Node, g graph.Graph) ([]graph.Node, float64) {
+	return DijkstraFromTo(s, t, g)
+}
+
+// BellmanFordPath returns a shortest path from s to t in g and its weight using
+// BellmanFordFrom. It is suitable for use as a solver for YenKShortestPathsWith.
+// If a negative cycle is reachable from s, BellmanFordPath returns a nil path
+// and a weight of -Inf.
+func BellmanFordPath(s, t graph.Node, g graph.Graph) ([]graph.Node, float64) {
+	pt, ok := BellmanFordFrom(s, g)
+	if !ok {
+		return nil, math.Inf(-1)
+	}
+	return pt.To(t.ID())
+}
+
+// yenKShortestPaths returns up to k paths from the Yen's k-shortest paths
+// search, ksp. If k is negative, all paths are returned.
+func yenKShortestPaths(ctx context.Context, ksp *yenKSP, k int) ([][]graph.Node, []float64, error) {
+	var (
+		paths   [][]graph.Node
+		weights []float64
+	)
+	for {
+		p, ok, err := ksp.next(ctx)
+		if err != nil {
+			return paths, weights, err
+		}
+		if !ok {
+			break
+		}
+		paths = append(paths, p.path)
+		weights = append(weights, p.weight)
+		if k >= 0 && len(paths) >= k {
+			break
+		}
+	}
+	return paths, weights, nil
+}
+
+// YenKShortestPathsVia returns the k-shortest paths from nodes[0] to
+// nodes[len(nodes)-1] in g that pass through each of the intermediate nodes
+// in order, with path costs no greater than cost beyond the shortest such
+// path. The semantics of k and cost are the same as for YenKShortestPaths,
+// except that when k is zero no path is returned. The returned paths are
+// formed from loopless segments between consecutive waypoints, but may visit
+// a node more than once when segments intersect. Paths that are formed from
+// different segments but have the same sequence of nodes are only returned
+// once.
+//
+// The k-shortest paths of each segment are found and combined with the best
+// k combinations of the preceding segments, so the work required for each
+// segment is O(k^2) in addition to the work to find the segment's paths. If
+// k is negative, the number of combinations is limited only by cost and may
+// grow as the product of the number of paths found for each segment.
+// YenKShortestPathsVia will panic if g contains a negative edge weight.
+func YenKShortestPathsVia(g graph.Graph, k int, cost float64, nodes []graph.Node) [][]graph.Node {
+	if len(nodes) == 0 || k == 0 {
+		return nil
+	}
+	combined := []yenShortest{{path: []graph.Node{nodes[0]}}}
+	for i := 1; i < len(nodes); i++ {
+		paths, weights := YenKShortestPathsWeighted(g, k, cost, nodes[i-1], nodes[i])
+		if len(paths) == 0 {
+			return nil
+		}
+
+		var next []yenShortest
+		for _, c := range combined {
+			for j, p := range paths {
+				next = append(next, yenShortest{
+					path:   append(slices.Clip(c.path), p[1:]...),
+					weight: c.weight + weights[j],
+				})
 			}
+		}
+		slices.SortStableFunc(next, func(a, b yenShortest) int {
+			return cmp.Compare(a.weight, b.weight)
+		})
 
-			spath, weight := DijkstraFromTo(spur, t, yk)
-			if weight > cost || math.IsInf(weight, 1) {
+		// Retain unique combinations within the cost
+		// limit. Since each following segment adds at
+		// least the weight of its shortest path, no
+		// combination worse than this can contribute.
+		limit := next[0].weight + cost
+		seen := make(map[uint64][][]graph.Node)
+		combined = combined[:0]
+		for _, c := range next {
+			if c.weight > limit || (k >= 0 && len(combined) >= k) {
+				break
+			}
+			h := pathHash(c.path)
+			if slices.ContainsFunc(seen[h], func(p []graph.Node) bool { return isSamePath(p, c.path) }) {
 				continue
 			}
-			if len(root) > 1 {
-				var rootWeight float64
-				for x := 1; x < len(root); x++ {
-					w, _ := yk.weight(root[x-1].ID(), root[x].ID())
-					rootWeight += w
-				}
-				spath = append(root[:len(root)-1], spath...)
-				weight += rootWeight
+			seen[h] = append(seen[h], c.path)
+			combined = append(combined, c)
+		}
+	}
+
+	paths := make([][]graph.Node, len(combined))
+	for i, c := range combined {
+		paths[i] = c.path
+	}
+	return paths
+}
+
+// YenSolver finds k-shortest loopless paths between pairs of nodes in a graph.
+// The search state of a YenSolver is retained between queries to reduce
+// allocation when many queries are made on the same graph. The graph must not
+// be altered while the YenSolver is in use. A YenSolver is not safe for
+// concurrent use.
+type YenSolver struct {
+	ksp *yenKSP
+}
+
+// NewYenSolver returns a new YenSolver for the graph g. If g does not
+// implement Weighted, UniformCost is used.
+func NewYenSolver(g graph.Graph) *YenSolver {
+	ksp := newYenKSP(g, 0, nil, nil)
+	ksp.pooled = true
+	return &YenSolver{ksp: ksp}
+}
+
+// Paths returns the k-shortest loopless paths from s to t with path costs
+// no greater than cost beyond the shortest path. The semantics of k and cost
+// are the same as for YenKShortestPaths. Paths will panic if the graph
+// contains a negative edge weight.
+func (y *YenSolver) Paths(k int, cost float64, s, t graph.Node) [][]graph.Node {
+	y.ksp.init(cost, s, t)
+	paths, _, _ := yenKShortestPaths(context.Background(), y.ksp, k)
+	return paths
+}
+
+// YenKShortestPathsIterator returns an iterator over the loopless paths from
+// s to t in g with path costs no greater than cost beyond the shortest path.
+// Paths are returned in order of increasing cost and each path is only
+// calculated when it is requested by a call to Next. The iterator will panic
+// if g contains a negative edge weight.
+func YenKShortestPathsIterator(g graph.Graph, cost float64, s, t graph.Node) *YenKSPIterator {
+	return &YenKSPIterator{ksp: newYenKSP(g, cost, s, t)}
+}
+
+// YenKSPIterator is an iterator over the loopless paths between a pair of
+// nodes in order of increasing cost. The search state is retained between
+// calls to Next, so no path is calculated more than once.
+type YenKSPIterator struct {
+	ksp *yenKSP
+}
+
+// Next returns the next shortest path. If no further path exists within
+// the cost limit of the iterator, or the iterator has been stopped, Next
+// returns nil and false. An exhausted iterator releases its search state.
+func (it *YenKSPIterator) Next() ([]graph.Node, bool) {
+	if it.ksp == nil {
+		return nil, false
+	}
+	p, ok, _ := it.ksp.next(context.Background())
+	if !ok {
+		it.ksp = nil
+		return nil, false
+	}
+	return p.path, true
+}
+
+// Stop stops the iterator and releases its search state, allowing it to be
+// garbage collected while the iterator remains reachable. After Stop is
+// called, Next returns nil and false.
+func (it *YenKSPIterator) Stop() {
+	it.ksp = nil
+}
+
+// yenKSP holds the state of an incremental Yen's k-shortest paths search.
+type yenKSP struct {
+	yk yenKSPAdjuster
+
+	s, t graph.Node
+
+	// solve is the shortest path solver
+	// used to find spur paths.
+	solve func(s, t graph.Node, g graph.Graph) ([]graph.Node, float64)
+
+	// tieBreak is used to order paths
+	// with equal weight if it is not nil.
+	tieBreak func(a, b []graph.Node) int
+
+	// cost is the absolute cost limit of
+	// returned paths. It is relative to the
+	// shortest path until that path is found.
+	cost float64
+
+	// paths holds the paths that have been
+	// returned by the search.
+	paths [][]graph.Node
+
+	// pot holds the potential k-shortest
+	// paths that have not yet been returned.
+	pot []yenShortest
+	// inPot holds the paths in pot keyed
+	// by their pathHash fingerprint.
+	inPot map[uint64][][]graph.Node
+
+	// hops holds the number of edges in the
+	// shortest paths to t keyed by node ID if
+	// the search is limited to paths with at
+	// most maxHops edges, and is nil otherwise.
+	hops    map[int64]int
+	maxHops int
+
+	// root is the working root path.
+	root []graph.Node
+	// prefix holds the weights of the
+	// prefixes of the previous path.
+	prefix []float64
+
+	// done indicates that no further paths
+	// can be returned.
+	done bool
+
+	// pooled indicates that the search state
+	// is retained for reuse when the search
+	// is complete.
+	pooled bool
+}
+
+// newYenKSP returns a new Yen's k-shortest paths search for paths from s to t
+// in g with path costs no greater than cost beyond the shortest path.
+func newYenKSP(g graph.Graph, cost float64, s, t graph.Node) *yenKSP {
+	_, isDirected := g.(graph.Directed)
+	ksp := &yenKSP{
+		yk: yenKSPAdjuster{
+			Graph:      g,
+			isDirected: isDirected,
+		},
+		solve: DijkstraPath,
+	}
+
+	if wg, ok := g.(Weighted); ok {
+		ksp.yk.weight = wg.Weight
+	} else {
+		ksp.yk.weight = UniformCost(g)
+	}
+	ksp.init(cost, s, t)
+
+	return ksp
+}
+
+// init prepares the search for paths from s to t with path costs no
+// greater than cost beyond the shortest path, retaining any allocated
+// search state.
+func (ksp *yenKSP) init(cost float64, s, t graph.Node) {
+	ksp.s = s
+	ksp.t = t
+	ksp.cost = cost
+	clear(ksp.paths)
+	ksp.paths = ksp.paths[:0]
+	clear(ksp.pot)
+	ksp.pot = ksp.pot[:0]
+	clear(ksp.inPot)
+	clear(ksp.yk.visitedNodes)
+	clear(ksp.yk.visitedEdges)
+	ksp.done = false
+}
+
+// next returns the next shortest path from the search and true, or false
+// if no further path exists. If ctx is done before the next path has been
+// found, the search is stopped and the context's error is returned.
+func (ksp *yenKSP) next(ctx context.Context) (yenShortest, bool, error) {
+	// See https://en.wikipedia.org/wiki/Yen's_algorithm and
+	// the paper at https://doi.org/10.1090%2Fqam%2F253822.
+
+	if ksp.done {
+		return yenShortest{}, false, nil
+	}
+	if err := ctx.Err(); err != nil {
+		ksp.stop()
+		return yenShortest{}, false, err
+	}
+
+	if len(ksp.paths) == 0 {
+		shortest, weight := ksp.solve(ksp.s, ksp.t, ksp.yk)
+		ksp.cost += weight // Set cost to absolute cost limit.
+		switch len(shortest) {
+		case 0:
+			ksp.stop()
+			return yenShortest{}, false, nil
+		case 1:
+			ksp.stop()
+			return yenShortest{path: shortest, weight: weight, spur: -1}, true, nil
+		}
+		ksp.paths = append(ksp.paths, shortest)
+		return yenShortest{path: shortest, weight: weight, spur: -1}, true, nil
+	}
+
+	yk := &ksp.yk
+	paths := ksp.paths
+	prev := paths[len(paths)-1]
+
+	// Calculate the root path weights for
+	// each spur node of the previous path.
+	ksp.prefix = append(ksp.prefix[:0], 0)
+	for x := 1; x < len(prev); x++ {
+		w, _ := yk.weight(prev[x-1].ID(), prev[x].ID())
+		ksp.prefix = append(ksp.prefix, ksp.prefix[x-1]+w)
+	}
+
+	// The spur node ranges from the first node to the next
+	// to last node in the previous k-shortest path.
+	for n := 0; n < len(prev)-1; n++ {
+		if err := ctx.Err(); err != nil {
+			ksp.stop()
+			return yenShortest{}, false, err
+		}
+
+		if ksp.hops != nil {
+			// A path deviating at this spur node has at
+			// least n edges in its root path in addition
+			// to the edges of its spur path.
+			d, ok := ksp.hops[prev[n].ID()]
+			if !ok || n+d > ksp.maxHops {
+				continue
 			}
+		}
+
+		yk.reset()
+
+		spur := prev[n]
+		root := append(ksp.root[:0], prev[:n+1]...)
+		ksp.root = root
 
-			// Add the potential k-shortest path if it is new.
-			isNewPot := true
-			for x := range pot {
-				if isSamePath(pot[x].path, spath) {
-					isNewPot = false
+		for _, path := range paths {
+			if len(path) <= n {
+				continue
+			}
+			ok := true
+			for x := 0; x < len(root); x++ {
+				if path[x].ID() != root[x].ID() {
+					ok = false
 					break
 				}
 			}
-			if isNewPot {
-				pot = append(pot, yenShortest{spath, weight})
+			if ok {
+				yk.removeEdge(path[n].ID(), path[n+1].ID())
 			}
 		}
+		for _, u := range root[:len(root)-1] {
+			yk.removeNode(u.ID())
+		}
 
-		if len(pot) == 0 {
-			break
+		spath, weight := ksp.solve(spur, ksp.t, *yk)
+		if len(spath) == 0 || math.IsInf(weight, 1) {
+			continue
+		}
+		if len(root) > 1 {
+			spath = append(slices.Clip(root[:len(root)-1]), spath...)
+			weight += ksp.prefix[n]
+		}
+		// The cost limit is checked against the complete
+		// path since a solver that allows negative edge
+		// weights may give a root path a negative weight.
+		if weight > ksp.cost {
+			continue
 		}
 
-		slices.SortFunc(pot, func(a, b yenShortest) int {
-			return cmp.Compare(a.weight, b.weight)
-		})
-		best := pot[0]
-		if len(best.path) <= 1 || best.weight > cost {
+		// Add the potential k-shortest path if it is new.
+		if ksp.addPot(spath) {
+			ksp.pot = append(ksp.pot, yenShortest{path: spath, weight: weight, spur: n})
+		}
+	}
+
+	if len(ksp.pot) == 0 {
+		ksp.stop()
+		return yenShortest{}, false, nil
+	}
+
+	slices.SortFunc(ksp.pot, ksp.compare)
+	best := ksp.pot[0]
+	if len(best.path) <= 1 || best.weight > ksp.cost {
+		ksp.stop()
+		return yenShortest{}, false, nil
+	}
+	ksp.paths = append(ksp.paths, best.path)
+	ksp.pot = ksp.pot[1:]
+	ksp.removePot(best.path)
+
+	return best, true, nil
+}
+
+// addPot adds p to the set of paths held in the pot, returning
+// false if the path is already present.
+func (ksp *yenKSP) addPot(p []graph.Node) bool {
+	if ksp.inPot == nil {
+		ksp.inPot = make(map[uint64][][]graph.Node)
+	}
+	h := pathHash(p)
+	for _, q := range ksp.inPot[h] {
+		if isSamePath(p, q) {
+			return false
+		}
+	}
+	ksp.inPot[h] = append(ksp.inPot[h], p)
+	return true
+}
+
+// removePot removes p from the set of paths held in the pot.
+func (ksp *yenKSP) removePot(p []graph.Node) {
+	h := pathHash(p)
+	paths := ksp.inPot[h]
+	for i, q := range paths {
+		if isSamePath(p, q) {
+			paths[i] = paths[len(paths)-1]
+			paths[len(paths)-1] = nil
+			paths = paths[:len(paths)-1]
 			break
 		}
-		paths = append(paths, best.path)
-		pot = pot[1:]
 	}
+	if len(paths) == 0 {
+		delete(ksp.inPot, h)
+		return
+	}
+	ksp.inPot[h] = paths
+}
+
+// compare returns the order of a and b by weight, and by the
+// tie-breaking function of the search when the weights are equal.
+func (ksp *yenKSP) compare(a, b yenShortest) int {
+	c := cmp.Compare(a.weight, b.weight)
+	if c == 0 && ksp.tieBreak != nil {
+		c = ksp.tieBreak(a.path, b.path)
+	}
+	return c
+}
 
-	return paths
+// stop marks the search as complete and releases the search state
+// unless the search is pooled.
+func (ksp *yenKSP) stop() {
+	ksp.done = true
+	if ksp.pooled {
+		return
+	}
+	ksp.paths = nil
+	ksp.pot = nil
+	ksp.inPot = nil
+	ksp.yk.visitedNodes = nil
+	ksp.yk.visitedEdges = nil
 }
 
 func isSamePath(a, b []graph.Node) bool {
@@ -131,10 +700,32 @@ func isSamePath(a, b []graph.Node) bool {
 	return true
 }
 
+// pathHash returns the FNV-1a hash of the sequence of node IDs in p.
+func pathHash(p []graph.Node) uint64 {
+	const (
+		offset = 14695981039346656037
+		prime  = 1099511628211
+	)
+	h := uint64(offset)
+	for _, n := range p {
+		id := uint64(n.ID())
+		for i := 0; i < 8; i++ {
+			h ^= id & 0xff
+			h *= prime
+			id >>= 8
+		}
+	}
+	return h
+}
+
 // yenShortest holds a path and its weight for sorting.
 type yenShortest struct {
 	path   []graph.Node
 	weight float64
+
+	// spur is the index of the spur node
+	// in path, or -1 for the shortest path.
+	spur int
 }
 
 // yenKSPAdjuster allows walked edges to be omitted from a graph
@@ -195,8 +786,13 @@ func (g yenKSPAdjuster) removeEdge(u, v int64) {
 }
 
 func (g *yenKSPAdjuster) reset() {
-	g.visitedNodes = make(map[int64]struct{})
-	g.visitedEdges = make(map[[2]int64]struct{})
+	if g.visitedNodes == nil {
+		g.visitedNodes = make(map[int64]struct{})
+		g.visitedEdges = make(map[[2]int64]struct{})
+		return
+	}
+	clear(g.visitedNodes)
+	clear(g.visitedEdges)
 }
 
 func (g yenKSPAdjuster) Weight(xid, yid int64) (w float64, ok bool) {
@@ -6,7 +6,9 @@ package path
 
 import (
 	"cmp"
+	"context"
 	"math"
+	"math/rand/v2"
 	"reflect"
 	"slices"
 	"testing"
@@ -420,3 +422,630 @@ func pathWeight(path []graph.Node, g graph.Weighted) float64 {
 		return w
 	}
 }
+
+func TestYenKSPIterator(t *testing.T) {
+	t.Parallel()
+	for _, test := range yenShortestPathTests {
+		g := test.graph()
+		for _, e := range test.edges {
+			g.SetWeightedEdge(e)
+		}
+
+		want := YenKShortestPaths(g.(graph.Graph), test.k, test.cost, test.query.From(), test.query.To())
+
+		it := YenKShortestPathsIterator(g.(graph.Graph), test.cost, test.query.From(), test.query.To())
+		var got [][]graph.Node
+		for test.k < 0 || len(got) < test.k {
+			p, ok := it.Next()
+			if !ok {
+				if _, ok := it.Next(); ok {
+					t.Errorf("unexpected path from exhausted iterator for %q", test.name)
+				}
+				break
+			}
+			got = append(got, p)
+		}
+		it.Stop()
+		if _, ok := it.Next(); ok {
+			t.Errorf("unexpected path from stopped iterator for %q", test.name)
+		}
+
+		if len(got) != len(want) {
+			t.Errorf("unexpected number of paths for %q: got:%d want:%d", test.name, len(got), len(want))
+			continue
+		}
+		for i := range got {
+			gotWeight := pathWeight(got[i], g.(graph.Weighted))
+			wantWeight := pathWeight(want[i], g.(graph.Weighted))
+			if gotWeight != wantWeight {
+				t.Errorf("unexpected weight for path %d of %q: got:%v want:%v", i, test.name, gotWeight, wantWeight)
+			}
+		}
+	}
+}
+
+func TestYenKSPWeighted(t *testing.T) {
+	t.Parallel()
+	for _, test := range yenShortestPathTests {
+		g := test.graph()
+		for _, e := range test.edges {
+			g.SetWeightedEdge(e)
+		}
+
+		paths, weights := YenKShortestPathsWeighted(g.(graph.Graph), test.k, test.cost, test.query.From(), test.query.To())
+		if len(paths) != len(weights) {
+			t.Errorf("mismatched paths and weights lengths for %q: %d != %d", test.name, len(paths), len(weights))
+			continue
+		}
+		for i, p := range paths {
+			want := pathWeight(p, g.(graph.Weighted))
+			if weights[i] != want {
+				t.Errorf("unexpected weight for path %d of %q: got:%v want:%v", i, test.name, weights[i], want)
+			}
+		}
+	}
+}
+
+func TestYenKSPContext(t *testing.T) {
+	t.Parallel()
+	for _, test := range yenShortestPathTests {
+		g := test.graph()
+		for _, e := range test.edges {
+			g.SetWeightedEdge(e)
+		}
+
+		want := YenKShortestPaths(g.(graph.Graph), test.k, test.cost, test.query.From(), test.query.To())
+
+		got, err := YenKShortestPathsContext(context.Background(), g.(graph.Graph), test.k, test.cost, test.query.From(), test.query.To())
+		if err != nil {
+			t.Errorf("unexpected error for %q: %v", test.name, err)
+		}
+		if len(got) != len(want) {
+			t.Errorf("unexpected number of paths for %q: got:%d want:%d", test.name, len(got), len(want))
+		}
+
+		for n := 0; n < 10; n++ {
+			ctx := &countdownContext{Context: context.Background(), n: n}
+			got, err := YenKShortestPathsContext(ctx, g.(graph.Graph), test.k, test.cost, test.query.From(), test.query.To())
+			if err == nil {
+				if len(got) != len(want) {
+					t.Errorf("unexpected number of paths for %q with countdown %d: got:%d want:%d", test.name, n, len(got), len(want))
+				}
+				continue
+			}
+			if err != context.Canceled {
+				t.Errorf("unexpected error for %q with countdown %d: %v", test.name, n, err)
+			}
+			if len(got) > len(want) {
+				t.Errorf("too many paths for %q with countdown %d: got:%d want at most:%d", test.name, n, len(got), len(want))
+				continue
+			}
+			for i := range got {
+				gotWeight := pathWeight(got[i], g.(graph.Weighted))
+				wantWeight := pathWeight(want[i], g.(graph.Weighted))
+				if gotWeight != wantWeight {
+					t.Errorf("unexpected weight for path %d of %q with countdown %d: got:%v want:%v", i, test.name, n, gotWeight, wantWeight)
+				}
+			}
+		}
+	}
+}
+
+// countdownContext is a context.Context that is cancelled
+// after its Err method has been called n times.
+type countdownContext struct {
+	context.Context
+	n int
+}
+
+func (ctx *countdownContext) Err() error {
+	if ctx.n <= 0 {
+		return context.Canceled
+	}
+	ctx.n--
+	return nil
+}
+
+func TestYenKSPSafe(t *testing.T) {
+	t.Parallel()
+	for _, test := range yenShortestPathTests {
+		g := test.graph()
+		for _, e := range test.edges {
+			g.SetWeightedEdge(e)
+		}
+
+		want := YenKShortestPaths(g.(graph.Graph), test.k, test.cost, test.query.From(), test.query.To())
+		got, err := YenKShortestPathsSafe(g.(graph.Graph), test.k, test.cost, test.query.From(), test.query.To())
+		if err != nil {
+			t.Errorf("unexpected error for %q: %v", test.name, err)
+		}
+		if len(got) != len(want) {
+			t.Errorf("unexpected number of paths for %q: got:%d want:%d", test.name, len(got), len(want))
+		}
+	}
+
+	g := simple.NewWeightedDirectedGraph(0, math.Inf(1))
+	g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(0), T: simple.Node(1), W: 1})
+	g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(1), T: simple.Node(2), W: -1})
+	g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(0), T: simple.Node(2), W: 3})
+	paths, err := YenKShortestPathsSafe(g, 2, math.Inf(1), simple.Node(0), simple.Node(2))
+	if paths != nil {
+		t.Errorf("unexpected paths for negative weight graph: %v", paths)
+	}
+	want := NegativeWeightError{From: 1, To: 2, Weight: -1}
+	if err != want {
+		t.Errorf("unexpected error for negative weight graph: got:%v want:%v", err, want)
+	}
+}
+
+func TestYenKSPWith(t *testing.T) {
+	t.Parallel()
+	for _, test := range yenShortestPathTests {
+		g := test.graph()
+		for _, e := range test.edges {
+			g.SetWeightedEdge(e)
+		}
+
+		_, want := YenKShortestPathsWeighted(g.(graph.Graph), test.k, test.cost, test.query.From(), test.query.To())
+		for _, solver := range []struct {
+			name  string
+			solve func(s, t graph.Node, g graph.Graph) ([]graph.Node, float64)
+		}{
+			{name: "dijkstra", solve: DijkstraPath},
+			{name: "bellman-ford", solve: BellmanFordPath},
+		} {
+			got := YenKShortestPathsWith(g.(graph.Graph), test.k, test.cost, test.query.From(), test.query.To(), solver.solve)
+			if len(got) != len(want) {
+				t.Errorf("unexpected number of paths for %q with %s: got:%d want:%d", test.name, solver.name, len(got), len(want))
+				continue
+			}
+			for i, p := range got {
+				if w := pathWeight(p, g.(graph.Weighted)); w != want[i] {
+					t.Errorf("unexpected weight for path %d of %q with %s: got:%v want:%v", i, test.name, solver.name, w, want[i])
+				}
+			}
+		}
+	}
+}
+
+func TestYenKSPWithNegativeWeights(t *testing.T) {
+	t.Parallel()
+	g := simple.NewWeightedDirectedGraph(0, math.Inf(1))
+	for _, e := range []simple.WeightedEdge{
+		{F: simple.Node(0), T: simple.Node(1), W: 2},
+		{F: simple.Node(0), T: simple.Node(2), W: 3},
+		{F: simple.Node(1), T: simple.Node(3), W: -2},
+		{F: simple.Node(2), T: simple.Node(3), W: 1},
+		{F: simple.Node(1), T: simple.Node(2), W: -1},
+	} {
+		g.SetWeightedEdge(e)
+	}
+
+	got := YenKShortestPathsWith(g, -1, math.Inf(1), simple.Node(0), simple.Node(3), BellmanFordPath)
+	want := [][]int64{
+		{0, 1, 3},
+		{0, 1, 2, 3},
+		{0, 2, 3},
+	}
+	if gotIDs := pathIDs(got); !reflect.DeepEqual(gotIDs, want) {
+		t.Errorf("unexpected result:\ngot: %v\nwant:%v", gotIDs, want)
+	}
+}
+
+func TestYenKSPWithNegativeRoot(t *testing.T) {
+	t.Parallel()
+	// The spur path 1→2→3 from the second spur node
+	// has a weight over the absolute cost limit of -3,
+	// but the root path 0→1 brings the complete path
+	// back within the limit.
+	g := simple.NewWeightedDirectedGraph(0, math.Inf(1))
+	for _, e := range []simple.WeightedEdge{
+		{F: simple.Node(0), T: simple.Node(1), W: -5},
+		{F: simple.Node(1), T: simple.Node(3), W: 1},
+		{F: simple.Node(1), T: simple.Node(2), W: 1},
+		{F: simple.Node(2), T: simple.Node(3), W: 1},
+		{F: simple.Node(0), T: simple.Node(3), W: 10},
+	} {
+		g.SetWeightedEdge(e)
+	}
+
+	got := YenKShortestPathsWith(g, -1, 1, simple.Node(0), simple.Node(3), BellmanFordPath)
+	want := [][]int64{
+		{0, 1, 3},
+		{0, 1, 2, 3},
+	}
+	if gotIDs := pathIDs(got); !reflect.DeepEqual(gotIDs, want) {
+		t.Errorf("unexpected result:\ngot: %v\nwant:%v", gotIDs, want)
+	}
+}
+
+func TestYenKSPFunc(t *testing.T) {
+	t.Parallel()
+	byIDs := func(a, b []graph.Node) int {
+		return slices.CompareFunc(a, b, func(u, v graph.Node) int {
+			return cmp.Compare(u.ID(), v.ID())
+		})
+	}
+	for _, test := range yenShortestPathTests {
+		if test.relaxed {
+			continue
+		}
+		g := test.graph()
+		for _, e := range test.edges {
+			g.SetWeightedEdge(e)
+		}
+
+		got := pathIDs(YenKShortestPathsFunc(g.(graph.Graph), test.k, test.cost, test.query.From(), test.query.To(), byIDs))
+		if !reflect.DeepEqual(got, test.wantPaths) {
+			t.Errorf("unexpected result for %q:\ngot: %v\nwant:%v", test.name, got, test.wantPaths)
+		}
+	}
+
+	// Ties at the kth path are resolved by the tie-breaking function.
+	g := simple.NewWeightedDirectedGraph(0, math.Inf(1))
+	for _, e := range bipartite(5, 3, 0) {
+		g.SetWeightedEdge(e)
+	}
+	for k := 0; k <= 5; k++ {
+		for i := 0; i < 10; i++ {
+			got := pathIDs(YenKShortestPathsFunc(g, k, math.Inf(1), simple.Node(-1), simple.Node(1), byIDs))
+			want := [][]int64{{-1, 2, 1}, {-1, 3, 1}, {-1, 4, 1}, {-1, 5, 1}, {-1, 6, 1}}[:k]
+			if k == 0 {
+				want = nil
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("unexpected result for k=%d:\ngot: %v\nwant:%v", k, got, want)
+			}
+		}
+	}
+}
+
+func TestYenSolver(t *testing.T) {
+	t.Parallel()
+	for _, test := range yenShortestPathTests {
+		g := test.graph()
+		for _, e := range test.edges {
+			g.SetWeightedEdge(e)
+		}
+
+		_, want := YenKShortestPathsWeighted(g.(graph.Graph), test.k, test.cost, test.query.From(), test.query.To())
+		solver := NewYenSolver(g.(graph.Graph))
+		for i := 0; i < 3; i++ {
+			// Interleave a reversed query to check
+			// that the solver state is reset.
+			solver.Paths(test.k, test.cost, test.query.To(), test.query.From())
+
+			got := solver.Paths(test.k, test.cost, test.query.From(), test.query.To())
+			if len(got) != len(want) {
+				t.Errorf("unexpected number of paths for %q on query %d: got:%d want:%d", test.name, i, len(got), len(want))
+				continue
+			}
+			for j, p := range got {
+				if w := pathWeight(p, g.(graph.Weighted)); w != want[j] {
+					t.Errorf("unexpected weight for path %d of %q on query %d: got:%v want:%v", j, test.name, i, w, want[j])
+				}
+			}
+		}
+	}
+}
+
+var yenShortestPathsViaTests = []struct {
+	name  string
+	graph func() graph.WeightedEdgeAdder
+	edges []simple.WeightedEdge
+
+	via       []int64
+	k         int
+	cost      float64
+	wantPaths [][]int64
+}{
+	{
+		name:      "empty graph",
+		graph:     func() graph.WeightedEdgeAdder { return simple.NewWeightedDirectedGraph(0, math.Inf(1)) },
+		via:       []int64{0, 1, 2},
+		k:         2,
+		cost:      math.Inf(1),
+		wantPaths: nil,
+	},
+	{
+		name:  "two diamonds",
+		graph: func() graph.WeightedEdgeAdder { return simple.NewWeightedDirectedGraph(0, math.Inf(1)) },
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 1},
+			{F: simple.Node(0), T: simple.Node(2), W: 2},
+			{F: simple.Node(1), T: simple.Node(3), W: 1},
+			{F: simple.Node(2), T: simple.Node(3), W: 2},
+			{F: simple.Node(3), T: simple.Node(4), W: 1},
+			{F: simple.Node(3), T: simple.Node(5), W: 3},
+			{F: simple.Node(4), T: simple.Node(6), W: 1},
+			{F: simple.Node(5), T: simple.Node(6), W: 3},
+			{F: simple.Node(0), T: simple.Node(6), W: 1},
+		},
+		via:  []int64{0, 3, 6},
+		k:    3,
+		cost: math.Inf(1),
+		wantPaths: [][]int64{
+			{0, 1, 3, 4, 6},
+			{0, 2, 3, 4, 6},
+			{0, 1, 3, 5, 6},
+		},
+	},
+	{
+		name:  "two diamonds cost limited",
+		graph: func() graph.WeightedEdgeAdder { return simple.NewWeightedDirectedGraph(0, math.Inf(1)) },
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 1},
+			{F: simple.Node(0), T: simple.Node(2), W: 2},
+			{F: simple.Node(1), T: simple.Node(3), W: 1},
+			{F: simple.Node(2), T: simple.Node(3), W: 2},
+			{F: simple.Node(3), T: simple.Node(4), W: 1},
+			{F: simple.Node(3), T: simple.Node(5), W: 3},
+			{F: simple.Node(4), T: simple.Node(6), W: 1},
+			{F: simple.Node(5), T: simple.Node(6), W: 3},
+		},
+		via:  []int64{0, 3, 6},
+		k:    -1,
+		cost: 2,
+		wantPaths: [][]int64{
+			{0, 1, 3, 4, 6},
+			{0, 2, 3, 4, 6},
+		},
+	},
+	{
+		name:  "revisit",
+		graph: func() graph.WeightedEdgeAdder { return simple.NewWeightedUndirectedGraph(0, math.Inf(1)) },
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 1},
+			{F: simple.Node(1), T: simple.Node(2), W: 1},
+		},
+		via:  []int64{0, 2, 1},
+		k:    -1,
+		cost: math.Inf(1),
+		wantPaths: [][]int64{
+			{0, 1, 2, 1},
+		},
+	},
+}
+
+func TestYenKSPVia(t *testing.T) {
+	t.Parallel()
+	for _, test := range yenShortestPathsViaTests {
+		g := test.graph()
+		for _, e := range test.edges {
+			g.SetWeightedEdge(e)
+		}
+
+		var via []graph.Node
+		for _, id := range test.via {
+			via = append(via, simple.Node(id))
+		}
+		got := pathIDs(YenKShortestPathsVia(g.(graph.Graph), test.k, test.cost, via))
+		if !reflect.DeepEqual(got, test.wantPaths) {
+			t.Errorf("unexpected result for %q:\ngot: %v\nwant:%v", test.name, got, test.wantPaths)
+		}
+	}
+}
+
+func TestYenKSPDetailed(t *testing.T) {
+	t.Parallel()
+	for _, test := range yenShortestPathTests {
+		g := test.graph()
+		for _, e := range test.edges {
+			g.SetWeightedEdge(e)
+		}
+
+		got := YenKShortestPathsDetailed(g.(graph.Graph), test.k, test.cost, test.query.From(), test.query.To())
+		_, want := YenKShortestPathsWeighted(g.(graph.Graph), test.k, test.cost, test.query.From(), test.query.To())
+		if len(got) != len(want) {
+			t.Errorf("unexpected number of paths for %q: got:%d want:%d", test.name, len(got), len(want))
+			continue
+		}
+		for i, p := range got {
+			if p.Weight != want[i] {
+				t.Errorf("unexpected weight for path %d of %q: got:%v want:%v", i, test.name, p.Weight, want[i])
+			}
+			if w := pathWeight(p.Nodes, g.(graph.Weighted)); w != p.Weight {
+				t.Errorf("mismatched weight for path %d of %q: got:%v want:%v", i, test.name, p.Weight, w)
+			}
+			if i == 0 {
+				if p.SpurIndex != -1 {
+					t.Errorf("unexpected spur index for shortest path of %q: got:%d want:-1", test.name, p.SpurIndex)
+				}
+				continue
+			}
+			if p.SpurIndex < 0 || p.SpurIndex >= len(p.Nodes)-1 {
+				t.Errorf("spur index out of range for path %d of %q: %d", i, test.name, p.SpurIndex)
+				continue
+			}
+
+			// The root path must be shared with an earlier
+			// path that deviates after the spur node.
+			root := p.Nodes[:p.SpurIndex+1]
+			var found bool
+			for _, q := range got[:i] {
+				if len(q.Nodes) > len(root) && isSamePath(q.Nodes[:len(root)], root) && q.Nodes[len(root)].ID() != p.Nodes[len(root)].ID() {
+					found = true
+					break
+				}
+			}
+			if !found {
+				t.Errorf("no earlier path shares root of path %d of %q: root:%v", i, test.name, root)
+			}
+		}
+	}
+}
+
+var yenShortestPathsLimitedTests = []struct {
+	name  string
+	edges []simple.WeightedEdge
+
+	query     simple.Edge
+	k         int
+	cost      float64
+	maxHops   int
+	wantPaths [][]int64
+}{
+	{
+		// The second shortest path exceeds the hop limit
+		// but is the parent of the third shortest path.
+		name: "spur from long path",
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(3), W: 1},
+			{F: simple.Node(0), T: simple.Node(1), W: 1},
+			{F: simple.Node(1), T: simple.Node(2), W: 1},
+			{F: simple.Node(2), T: simple.Node(3), W: 1},
+			{F: simple.Node(1), T: simple.Node(3), W: 4},
+		},
+		query:     simple.Edge{F: simple.Node(0), T: simple.Node(3)},
+		k:         -1,
+		cost:      math.Inf(1),
+		maxHops:   2,
+		wantPaths: [][]int64{{0, 3}, {0, 1, 3}},
+	},
+	{
+		name: "unbounded",
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(3), W: 1},
+			{F: simple.Node(0), T: simple.Node(1), W: 1},
+			{F: simple.Node(1), T: simple.Node(2), W: 1},
+			{F: simple.Node(2), T: simple.Node(3), W: 1},
+			{F: simple.Node(1), T: simple.Node(3), W: 4},
+		},
+		query:     simple.Edge{F: simple.Node(0), T: simple.Node(3)},
+		k:         -1,
+		cost:      math.Inf(1),
+		maxHops:   -1,
+		wantPaths: [][]int64{{0, 3}, {0, 1, 2, 3}, {0, 1, 3}},
+	},
+	{
+		// The cost limit is relative to the shortest
+		// path within the hop limit.
+		name: "cost relative to limited",
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 1},
+			{F: simple.Node(1), T: simple.Node(2), W: 1},
+			{F: simple.Node(2), T: simple.Node(3), W: 1},
+			{F: simple.Node(0), T: simple.Node(3), W: 10},
+			{F: simple.Node(0), T: simple.Node(4), W: 6},
+			{F: simple.Node(4), T: simple.Node(3), W: 6},
+			{F: simple.Node(0), T: simple.Node(5), W: 7},
+			{F: simple.Node(5), T: simple.Node(3), W: 7},
+		},
+		query:     simple.Edge{F: simple.Node(0), T: simple.Node(3)},
+		k:         -1,
+		cost:      2,
+		maxHops:   2,
+		wantPaths: [][]int64{{0, 3}, {0, 4, 3}},
+	},
+	{
+		name: "k limited",
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 1},
+			{F: simple.Node(1), T: simple.Node(2), W: 1},
+			{F: simple.Node(2), T: simple.Node(3), W: 1},
+			{F: simple.Node(0), T: simple.Node(3), W: 10},
+			{F: simple.Node(0), T: simple.Node(4), W: 6},
+			{F: simple.Node(4), T: simple.Node(3), W: 6},
+		},
+		query:     simple.Edge{F: simple.Node(0), T: simple.Node(3)},
+		k:         1,
+		cost:      math.Inf(1),
+		maxHops:   2,
+		wantPaths: [][]int64{{0, 3}},
+	},
+	{
+		name: "no path within limit",
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 1},
+			{F: simple.Node(1), T: simple.Node(2), W: 1},
+		},
+		query:     simple.Edge{F: simple.Node(0), T: simple.Node(2)},
+		k:         -1,
+		cost:      math.Inf(1),
+		maxHops:   1,
+		wantPaths: nil,
+	},
+}
+
+func TestYenKSPLimited(t *testing.T) {
+	t.Parallel()
+	for _, test := range yenShortestPathsLimitedTests {
+		g := simple.NewWeightedDirectedGraph(0, math.Inf(1))
+		for _, e := range test.edges {
+			g.SetWeightedEdge(e)
+		}
+
+		got := pathIDs(YenKShortestPathsLimited(g, test.k, test.cost, test.maxHops, test.query.From(), test.query.To()))
+		if !reflect.DeepEqual(got, test.wantPaths) {
+			t.Errorf("unexpected result for %q:\ngot: %v\nwant:%v", test.name, got, test.wantPaths)
+		}
+	}
+}
+
+func TestYenKSPLimitedDense(t *testing.T) {
+	t.Parallel()
+	// Without pruning of the spur nodes, these queries
+	// would enumerate every loopless path of the graph.
+	const n = 14
+	for _, test := range []struct {
+		name      string
+		direct    bool
+		maxHops   int
+		wantPaths int
+	}{
+		{name: "no path within limit", direct: false, maxHops: 1, wantPaths: 0},
+		{name: "direct path", direct: true, maxHops: 1, wantPaths: 1},
+		{name: "two hops", direct: false, maxHops: 2, wantPaths: n - 2},
+		{name: "direct and two hops", direct: true, maxHops: 2, wantPaths: n - 1},
+	} {
+		g := simple.NewWeightedDirectedGraph(0, math.Inf(1))
+		for u := 0; u < n; u++ {
+			for v := 0; v < n; v++ {
+				if u == v || (!test.direct && u == 0 && v == n-1) {
+					continue
+				}
+				g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(u), T: simple.Node(v), W: 1})
+			}
+		}
+
+		got := YenKShortestPathsLimited(g, -1, math.Inf(1), test.maxHops, simple.Node(0), simple.Node(n-1))
+		if len(got) != test.wantPaths {
+			t.Errorf("unexpected number of paths for %q: got:%d want:%d", test.name, len(got), test.wantPaths)
+		}
+		for _, p := range got {
+			if len(p)-1 > test.maxHops {
+				t.Errorf("path exceeds hop limit for %q: %v", test.name, pathIDs([][]graph.Node{p}))
+			}
+		}
+	}
+}
+
+func TestYenKSPLimitedRandom(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for trial := 0; trial < 50; trial++ {
+		const n = 8
+		g := simple.NewWeightedDirectedGraph(0, math.Inf(1))
+		for u := 0; u < n; u++ {
+			for v := 0; v < n; v++ {
+				if u != v && rnd.Float64() < 0.4 {
+					g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(u), T: simple.Node(v), W: float64(1 + rnd.IntN(5))})
+				}
+			}
+		}
+		maxHops := 1 + rnd.IntN(4)
+
+		var want [][]int64
+		for _, p := range YenKShortestPaths(g, -1, math.Inf(1), simple.Node(0), simple.Node(n-1)) {
+			if len(p)-1 <= maxHops {
+				want = append(want, pathIDs([][]graph.Node{p})[0])
+			}
+		}
+		got := pathIDs(YenKShortestPathsLimited(g, -1, math.Inf(1), maxHops, simple.Node(0), simple.Node(n-1)))
+		slices.SortFunc(want, slices.Compare)
+		slices.SortFunc(got, slices.Compare)
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("unexpected result for graph %d with maxHops=%d:\ngot: %v\nwant:%v", trial, maxHops, got, want)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package simple
+
+import (
+	"fmt"
+	"math"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/internal/order"
+	"gonum.org/v1/gonum/mat"
+)
+
+// AdjacencyMatrix returns the adjacency matrix of g and a map from node ID
+// to the row and column index of the node in the matrix. Row and column i
+// of the matrix correspond to nodes[i], so the ordering of the matrix can be
+// aligned with other matrices. If nodes is nil, all the nodes of g are used in
+// order of ID.
+//
+// Element (i, j) of the matrix holds the weight of the edge from nodes[i] to
+// nodes[j] if g is a graph.Weighted, and 1 otherwise, or zero if there is no
+// such edge. The adjacency matrix of an undirected graph is symmetric. Edges
+// to nodes not in nodes are ignored. The weights of multigraphs are those
+// returned by their Weight method and unweighted multigraphs are given 1 for
+// any number of lines between a pair of nodes.
+//
+// AdjacencyMatrix will panic if a node in nodes is not in g or is repeated.
+func AdjacencyMatrix(g graph.Graph, nodes []graph.Node) (*mat.Dense, map[int64]int) {
+	if nodes == nil {
+		nodes = graph.NodesOf(g.Nodes())
+		order.ByID(nodes)
+	}
+	index := make(map[int64]int, len(nodes))
+	for i, n := range nodes {
+		id := n.ID()
+		if g.Node(id) == nil {
+			panic(fmt.Sprintf("simple: node %d not in graph", id))
+		}
+		if _, exists := index[id]; exists {
+			panic(fmt.Sprintf("simple: repeated node %d", id))
+		}
+		index[id] = i
+	}
+	if len(nodes) == 0 {
+		return &mat.Dense{}, index
+	}
+
+	wg, isWeighted := g.(graph.Weighted)
+	m := mat.NewDense(len(nodes), len(nodes), nil)
+	for i, u := range nodes {
+		uid := u.ID()
+		to := g.From(uid)
+		for to.Next() {
+			vid := to.Node().ID()
+			j, ok := index[vid]
+			if !ok {
+				continue
+			}
+			w := 1.0
+			if isWeighted {
+				w, _ = wg.Weight(uid, vid)
+			}
+			m.Set(i, j, w)
+		}
+	}
+	return m, index
+}
+
+// FromAdjacency returns a weighted directed graph with a node for each row of
+// the square matrix m, with IDs from 0 to n-1 in row order, and an edge from
+// node i to node j weighted by element (i, j) of m for each off-diagonal
+// element with a magnitude greater than threshold. Diagonal elements of m are
+// ignored. The returned graph has zero-weight self connections and absent
+// edges are given an infinite weight.
+//
+// FromAdjacency will panic if m is not square or if threshold is negative.
+func FromAdjacency(m mat.Matrix, threshold float64) *WeightedDirectedGraph {
+	r, c := m.Dims()
+	if r != c {
+		panic(mat.ErrShape)
+	}
+	if threshold < 0 {
+		panic("simple: negative adjacency threshold")
+	}
+	g := NewWeightedDirectedGraph(0, math.Inf(1))
+	for i := 0; i < r; i++ {
+		g.AddNode(Node(i))
+	}
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			if i == j {
+				continue
+			}
+			w := m.At(i, j)
+			if math.Abs(w) > threshold {
+				g.SetWeightedEdge(WeightedEdge{F: Node(i), T: Node(j), W: w})
+			}
+		}
+	}
+	return g
+}
@@ -0,0 +1,159 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package simple_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/multi"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestAdjacencyMatrix(t *testing.T) {
+	t.Parallel()
+
+	undirected := simple.NewUndirectedGraph()
+	undirected.SetEdge(simple.Edge{F: simple.Node(2), T: simple.Node(5)})
+	undirected.SetEdge(simple.Edge{F: simple.Node(5), T: simple.Node(7)})
+	undirected.AddNode(simple.Node(9))
+
+	weighted := simple.NewWeightedDirectedGraph(0, math.Inf(1))
+	weighted.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(0), T: simple.Node(1), W: 2})
+	weighted.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(1), T: simple.Node(2), W: -0.5})
+	weighted.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(2), T: simple.Node(0), W: 3})
+
+	lines := multi.NewDirectedGraph()
+	lines.SetLine(lines.NewLine(simple.Node(0), simple.Node(1)))
+	lines.SetLine(lines.NewLine(simple.Node(0), simple.Node(1)))
+	lines.SetLine(lines.NewLine(simple.Node(1), simple.Node(1)))
+
+	for _, test := range []struct {
+		name  string
+		g     graph.Graph
+		nodes []int64
+
+		want      *mat.Dense
+		wantIndex map[int64]int
+	}{
+		{
+			name: "undirected by ID",
+			g:    undirected,
+			want: mat.NewDense(4, 4, []float64{
+				0, 1, 0, 0,
+				1, 0, 1, 0,
+				0, 1, 0, 0,
+				0, 0, 0, 0,
+			}),
+			wantIndex: map[int64]int{2: 0, 5: 1, 7: 2, 9: 3},
+		},
+		{
+			name:  "undirected subset",
+			g:     undirected,
+			nodes: []int64{7, 5},
+			want: mat.NewDense(2, 2, []float64{
+				0, 1,
+				1, 0,
+			}),
+			wantIndex: map[int64]int{7: 0, 5: 1},
+		},
+		{
+			name:  "weighted directed reordered",
+			g:     weighted,
+			nodes: []int64{2, 0, 1},
+			want: mat.NewDense(3, 3, []float64{
+				0, 3, 0,
+				0, 0, 2,
+				-0.5, 0, 0,
+			}),
+			wantIndex: map[int64]int{2: 0, 0: 1, 1: 2},
+		},
+		{
+			name: "multigraph",
+			g:    lines,
+			want: mat.NewDense(2, 2, []float64{
+				0, 1,
+				0, 1,
+			}),
+			wantIndex: map[int64]int{0: 0, 1: 1},
+		},
+	} {
+		var nodes []graph.Node
+		for _, id := range test.nodes {
+			nodes = append(nodes, simple.Node(id))
+		}
+		got, index := simple.AdjacencyMatrix(test.g, nodes)
+		if !mat.Equal(got, test.want) {
+			t.Errorf("unexpected adjacency matrix for %q:\ngot:\n%v\nwant:\n%v",
+				test.name, mat.Formatted(got), mat.Formatted(test.want))
+		}
+		if !reflect.DeepEqual(index, test.wantIndex) {
+			t.Errorf("unexpected index for %q: got:%v want:%v", test.name, index, test.wantIndex)
+		}
+	}
+
+	for _, test := range []struct {
+		name  string
+		nodes []int64
+	}{
+		{name: "missing", nodes: []int64{2, 3}},
+		{name: "repeated", nodes: []int64{2, 5, 2}},
+	} {
+		var nodes []graph.Node
+		for _, id := range test.nodes {
+			nodes = append(nodes, simple.Node(id))
+		}
+		if !panics(func() { simple.AdjacencyMatrix(undirected, nodes) }) {
+			t.Errorf("expected panic for %s node", test.name)
+		}
+	}
+}
+
+func TestFromAdjacency(t *testing.T) {
+	t.Parallel()
+	m := mat.NewDense(3, 3, []float64{
+		5, 0.1, -2,
+		0, 0, 1,
+		0.5, 0, 0,
+	})
+	g := simple.FromAdjacency(m, 0.2)
+	if n := g.Nodes().Len(); n != 3 {
+		t.Errorf("unexpected number of nodes: got:%d want:3", n)
+	}
+	want := map[[2]int64]float64{{0, 2}: -2, {1, 2}: 1, {2, 0}: 0.5}
+	got := make(map[[2]int64]float64)
+	for _, e := range graph.WeightedEdgesOf(g.WeightedEdges()) {
+		got[[2]int64{e.From().ID(), e.To().ID()}] = e.Weight()
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected edges: got:%v want:%v", got, want)
+	}
+
+	// Converting back recovers the thresholded matrix.
+	back, _ := simple.AdjacencyMatrix(g, nil)
+	wantBack := mat.NewDense(3, 3, []float64{
+		0, 0, -2,
+		0, 0, 1,
+		0.5, 0, 0,
+	})
+	if !mat.Equal(back, wantBack) {
+		t.Errorf("unexpected round trip matrix:\ngot:\n%v\nwant:\n%v", mat.Formatted(back), mat.Formatted(wantBack))
+	}
+
+	if !panics(func() { simple.FromAdjacency(mat.NewDense(2, 3, nil), 0) }) {
+		t.Error("expected panic for non-square matrix")
+	}
+}
+
+func panics(fn func()) (ok bool) {
+	defer func() {
+		ok = recover() != nil
+	}()
+	fn()
+	return
+}
@@ -0,0 +1,61 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package simple
+
+import (
+	"fmt"
+
+	"gonum.org/v1/gonum/graph"
+)
+
+// ContractEdge returns a copy of g with the edge between u and v contracted,
+// and a map from the ID of each node of g to the ID of its node in the
+// returned graph. The node v is merged into u, so the edges of v are moved to
+// u and v is removed, and the edge between u and v is removed rather than
+// becoming a self edge. All other nodes keep their IDs. If u and v are not
+// adjacent in g, the two nodes are merged in the same way.
+//
+// Parallel edges created by the contraction are collapsed into a single edge,
+// as are parallel lines of a multigraph, and self edges of g are ignored.
+//
+// Nodes from g are used to construct the returned graph, so if the Node type
+// used in g is pointer or reference-like, then the values will be shared
+// between the graphs.
+//
+// ContractEdge will panic if u or v is not in g or if u and v are the same
+// node.
+func ContractEdge(g graph.Undirected, u, v graph.Node) (*UndirectedGraph, map[int64]int64) {
+	uid, vid := u.ID(), v.ID()
+	for _, id := range []int64{uid, vid} {
+		if g.Node(id) == nil {
+			panic(fmt.Sprintf("simple: node %d not in graph", id))
+		}
+	}
+	if uid == vid {
+		panic("simple: contraction of self edge")
+	}
+
+	mapping := make(map[int64]int64)
+	dst := NewUndirectedGraph()
+	nodes := g.Nodes()
+	for nodes.Next() {
+		n := nodes.Node()
+		id := n.ID()
+		if id == vid {
+			mapping[id] = uid
+			continue
+		}
+		mapping[id] = id
+		dst.AddNode(n)
+	}
+	eachEdgeOf(g, func(xid, yid int64) {
+		xid, yid = mapping[xid], mapping[yid]
+		if xid == yid {
+			return
+		}
+		dst.SetEdge(Edge{F: dst.Node(xid), T: dst.Node(yid)})
+	})
+	return dst, mapping
+}
@@ -0,0 +1,103 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package simple_test
+
+import (
+	"reflect"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/multi"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+func TestContractEdge(t *testing.T) {
+	t.Parallel()
+
+	// A triangle 0-1-2 with a tail 2-3 and
+	// an isolated node 4.
+	triangle := simple.NewUndirectedGraph()
+	for _, e := range [][2]int64{{0, 1}, {1, 2}, {2, 0}, {2, 3}} {
+		triangle.SetEdge(simple.Edge{F: simple.Node(e[0]), T: simple.Node(e[1])})
+	}
+	triangle.AddNode(simple.Node(4))
+
+	lines := multi.NewUndirectedGraph()
+	lines.SetLine(lines.NewLine(multi.Node(0), multi.Node(1)))
+	lines.SetLine(lines.NewLine(multi.Node(0), multi.Node(1)))
+	lines.SetLine(lines.NewLine(multi.Node(1), multi.Node(2)))
+	lines.SetLine(lines.NewLine(multi.Node(2), multi.Node(2)))
+
+	for _, test := range []struct {
+		name        string
+		g           graph.Undirected
+		u, v        int64
+		wantNodes   []int64
+		wantEdges   [][2]int64
+		wantMapping map[int64]int64
+	}{
+		{
+			// The parallel edges 0-2 and 1-2 are collapsed.
+			name:        "triangle",
+			g:           triangle,
+			u:           2,
+			v:           1,
+			wantNodes:   []int64{0, 2, 3, 4},
+			wantEdges:   [][2]int64{{0, 2}, {2, 3}},
+			wantMapping: map[int64]int64{0: 0, 1: 2, 2: 2, 3: 3, 4: 4},
+		},
+		{
+			name:        "non-adjacent",
+			g:           triangle,
+			u:           0,
+			v:           3,
+			wantNodes:   []int64{0, 1, 2, 4},
+			wantEdges:   [][2]int64{{0, 1}, {0, 2}, {1, 2}},
+			wantMapping: map[int64]int64{0: 0, 1: 1, 2: 2, 3: 0, 4: 4},
+		},
+		{
+			name:        "multigraph",
+			g:           lines,
+			u:           0,
+			v:           1,
+			wantNodes:   []int64{0, 2},
+			wantEdges:   [][2]int64{{0, 2}},
+			wantMapping: map[int64]int64{0: 0, 1: 0, 2: 2},
+		},
+	} {
+		g, mapping := simple.ContractEdge(test.g, simple.Node(test.u), simple.Node(test.v))
+		if got := nodeIDsOf(g); !reflect.DeepEqual(got, test.wantNodes) {
+			t.Errorf("unexpected nodes for %s: got:%v want:%v", test.name, got, test.wantNodes)
+		}
+		if got := edgesOf(g); !reflect.DeepEqual(got, test.wantEdges) {
+			t.Errorf("unexpected edges for %s: got:%v want:%v", test.name, got, test.wantEdges)
+		}
+		if !reflect.DeepEqual(mapping, test.wantMapping) {
+			t.Errorf("unexpected mapping for %s: got:%v want:%v", test.name, mapping, test.wantMapping)
+		}
+	}
+	if !triangle.HasEdgeBetween(1, 2) || triangle.Node(1) == nil {
+		t.Error("contraction modified input graph")
+	}
+}
+
+func TestContractEdgePanics(t *testing.T) {
+	t.Parallel()
+
+	g := simple.NewUndirectedGraph()
+	g.SetEdge(simple.Edge{F: simple.Node(0), T: simple.Node(1)})
+	for _, test := range []struct {
+		name string
+		u, v int64
+	}{
+		{name: "missing u", u: 2, v: 1},
+		{name: "missing v", u: 0, v: 2},
+		{name: "same node", u: 1, v: 1},
+	} {
+		if !panics(func() { simple.ContractEdge(g, simple.Node(test.u), simple.Node(test.v)) }) {
+			t.Errorf("expected panic for %s", test.name)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package simple
+
+import (
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+// LineGraph returns the line graph of g, holding a node for each edge of g and
+// an edge between each pair of nodes whose edges in g share an end node, and a
+// map from each node ID of the line graph to the IDs of the end nodes of its
+// edge in g. The first end node ID of each pair is the lower of the two.
+//
+// The nodes of the line graph are given IDs from 0 in the order of their edges
+// in g, with edges ordered by the IDs of their end nodes. Self edges of g are
+// ignored, and parallel lines of a multigraph are treated as a single edge.
+func LineGraph(g graph.Undirected) (lg *UndirectedGraph, edgeOf map[int64][2]int64) {
+	lg = NewUndirectedGraph()
+	edgeOf = make(map[int64][2]int64)
+	incident := make(map[int64][]int64)
+	for _, e := range orderedEdges(g, func(uid, vid int64) bool { return uid < vid }) {
+		id := int64(len(edgeOf))
+		lg.AddNode(Node(id))
+		edgeOf[id] = e
+		for _, end := range e {
+			for _, other := range incident[end] {
+				lg.SetEdge(Edge{F: Node(other), T: Node(id)})
+			}
+			incident[end] = append(incident[end], id)
+		}
+	}
+	return lg, edgeOf
+}
+
+// LineDigraph returns the line digraph of g, holding a node for each edge of
+// g and an edge from the node of each edge u→v in g to the node of each edge
+// v→w in g, and a map from each node ID of the line digraph to the IDs of the
+// from and to nodes of its edge in g.
+//
+// The nodes of the line digraph are given IDs from 0 in the order of their
+// edges in g, with edges ordered by the IDs of their from and then to nodes.
+// Self edges of g are ignored, and parallel lines of a multigraph are treated
+// as a single edge.
+func LineDigraph(g graph.Directed) (lg *DirectedGraph, edgeOf map[int64][2]int64) {
+	lg = NewDirectedGraph()
+	edgeOf = make(map[int64][2]int64)
+	out := make(map[int64][]int64)
+	in := make(map[int64][]int64)
+	for _, e := range orderedEdges(g, func(uid, vid int64) bool { return uid != vid }) {
+		id := int64(len(edgeOf))
+		lg.AddNode(Node(id))
+		edgeOf[id] = e
+		out[e[0]] = append(out[e[0]], id)
+		in[e[1]] = append(in[e[1]], id)
+	}
+	for v, to := range in {
+		for _, uv := range to {
+			for _, vw := range out[v] {
+				lg.SetEdge(Edge{F: Node(uv), T: Node(vw)})
+			}
+		}
+	}
+	return lg, edgeOf
+}
+
+// orderedEdges returns the end node ID pairs of the edges of g for which keep
+// returns true, ordered by the IDs of the from and then to nodes.
+func orderedEdges(g graph.Graph, keep func(uid, vid int64) bool) [][2]int64 {
+	var edges [][2]int64
+	nodes := graph.NodesOf(g.Nodes())
+	order.ByID(nodes)
+	for _, u := range nodes {
+		uid := u.ID()
+		to := graph.NodesOf(g.From(uid))
+		order.ByID(to)
+		for _, v := range to {
+			if vid := v.ID(); keep(uid, vid) {
+				edges = append(edges, [2]int64{uid, vid})
+			}
+		}
+	}
+	return edges
+}
@@ -0,0 +1,111 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package simple_test
+
+import (
+	"reflect"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/multi"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+func TestLineGraph(t *testing.T) {
+	t.Parallel()
+
+	star := simple.NewUndirectedGraph()
+	for _, v := range []int64{1, 2, 3} {
+		star.SetEdge(simple.Edge{F: simple.Node(v), T: simple.Node(0)})
+	}
+
+	path := simple.NewUndirectedGraph()
+	for _, e := range [][2]int64{{4, 3}, {3, 2}, {1, 2}} {
+		path.SetEdge(simple.Edge{F: simple.Node(e[0]), T: simple.Node(e[1])})
+	}
+	path.AddNode(simple.Node(9))
+
+	lines := multi.NewUndirectedGraph()
+	lines.SetLine(lines.NewLine(multi.Node(0), multi.Node(1)))
+	lines.SetLine(lines.NewLine(multi.Node(0), multi.Node(1)))
+	lines.SetLine(lines.NewLine(multi.Node(1), multi.Node(1)))
+	lines.SetLine(lines.NewLine(multi.Node(1), multi.Node(2)))
+
+	for _, test := range []struct {
+		name       string
+		g          graph.Undirected
+		wantEdgeOf map[int64][2]int64
+		wantEdges  [][2]int64
+	}{
+		{
+			name:       "empty",
+			g:          simple.NewUndirectedGraph(),
+			wantEdgeOf: map[int64][2]int64{},
+		},
+		{
+			name:       "star",
+			g:          star,
+			wantEdgeOf: map[int64][2]int64{0: {0, 1}, 1: {0, 2}, 2: {0, 3}},
+			wantEdges:  [][2]int64{{0, 1}, {0, 2}, {1, 2}},
+		},
+		{
+			name:       "path",
+			g:          path,
+			wantEdgeOf: map[int64][2]int64{0: {1, 2}, 1: {2, 3}, 2: {3, 4}},
+			wantEdges:  [][2]int64{{0, 1}, {1, 2}},
+		},
+		{
+			name:       "multigraph",
+			g:          lines,
+			wantEdgeOf: map[int64][2]int64{0: {0, 1}, 1: {1, 2}},
+			wantEdges:  [][2]int64{{0, 1}},
+		},
+	} {
+		lg, edgeOf := simple.LineGraph(test.g)
+		if !reflect.DeepEqual(edgeOf, test.wantEdgeOf) {
+			t.Errorf("unexpected edge map for %s: got:%v want:%v", test.name, edgeOf, test.wantEdgeOf)
+		}
+		if n := lg.Nodes().Len(); n != len(test.wantEdgeOf) {
+			t.Errorf("unexpected number of nodes for %s: got:%d want:%d", test.name, n, len(test.wantEdgeOf))
+		}
+		if m := lg.Edges().Len(); m != len(test.wantEdges) {
+			t.Errorf("unexpected number of edges for %s: got:%d want:%d", test.name, m, len(test.wantEdges))
+		}
+		for _, e := range test.wantEdges {
+			if !lg.HasEdgeBetween(e[0], e[1]) {
+				t.Errorf("missing edge %d-%d for %s", e[0], e[1], test.name)
+			}
+		}
+	}
+}
+
+func TestLineDigraph(t *testing.T) {
+	t.Parallel()
+
+	g := simple.NewDirectedGraph()
+	for _, e := range [][2]int64{{0, 1}, {1, 2}, {2, 0}, {1, 0}} {
+		g.SetEdge(simple.Edge{F: simple.Node(e[0]), T: simple.Node(e[1])})
+	}
+
+	lg, edgeOf := simple.LineDigraph(g)
+	wantEdgeOf := map[int64][2]int64{0: {0, 1}, 1: {1, 0}, 2: {1, 2}, 3: {2, 0}}
+	if !reflect.DeepEqual(edgeOf, wantEdgeOf) {
+		t.Errorf("unexpected edge map: got:%v want:%v", edgeOf, wantEdgeOf)
+	}
+	wantEdges := [][2]int64{
+		{0, 1}, {0, 2}, // 0→1 to 1→0 and 1→2.
+		{1, 0}, // 1→0 to 0→1.
+		{2, 3}, // 1→2 to 2→0.
+		{3, 0}, // 2→0 to 0→1.
+	}
+	if m := lg.Edges().Len(); m != len(wantEdges) {
+		t.Errorf("unexpected number of edges: got:%d want:%d", m, len(wantEdges))
+	}
+	for _, e := range wantEdges {
+		if !lg.HasEdgeFromTo(e[0], e[1]) {
+			t.Errorf("missing edge %d→%d", e[0], e[1])
+		}
+	}
+}
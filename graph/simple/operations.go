@@ -0,0 +1,148 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package simple
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/graph"
+)
+
+// Union returns a graph holding the nodes of a and b and the edges that are in
+// a or in b. Nodes that are in both a and b are taken from a. Self edges of a
+// and b are ignored.
+func Union(a, b graph.Undirected) *UndirectedGraph {
+	dst := NewUndirectedGraph()
+	addNodesOf(dst, a, b)
+	for _, g := range []graph.Graph{a, b} {
+		eachEdgeOf(g, func(uid, vid int64) {
+			dst.SetEdge(Edge{F: dst.Node(uid), T: dst.Node(vid)})
+		})
+	}
+	return dst
+}
+
+// Intersection returns a graph holding the nodes of a and b and the edges that
+// are in both a and b. Nodes that are in both a and b are taken from a. Self
+// edges of a and b are ignored.
+func Intersection(a, b graph.Undirected) *UndirectedGraph {
+	dst := NewUndirectedGraph()
+	addNodesOf(dst, a, b)
+	eachEdgeOf(a, func(uid, vid int64) {
+		if b.HasEdgeBetween(uid, vid) {
+			dst.SetEdge(Edge{F: dst.Node(uid), T: dst.Node(vid)})
+		}
+	})
+	return dst
+}
+
+// Difference returns a graph holding the nodes of a and b and the edges that
+// are in a and not in b. Nodes that are in both a and b are taken from a. Self
+// edges of a are ignored.
+func Difference(a, b graph.Undirected) *UndirectedGraph {
+	dst := NewUndirectedGraph()
+	addNodesOf(dst, a, b)
+	eachEdgeOf(a, func(uid, vid int64) {
+		if !b.HasEdgeBetween(uid, vid) {
+			dst.SetEdge(Edge{F: dst.Node(uid), T: dst.Node(vid)})
+		}
+	})
+	return dst
+}
+
+// WeightedUnion returns a weighted graph holding the nodes of a and b and the
+// edges that are in a or in b, as described for Union. Edges that are in only
+// one of a and b keep their weight, and the weight of edges that are in both
+// a and b is combine(wa, wb) where wa and wb are the weights of the edge in a
+// and b. The returned graph has zero-weight self connections and absent edges
+// are given an infinite weight.
+func WeightedUnion(a, b graph.WeightedUndirected, combine func(wa, wb float64) float64) *WeightedUndirectedGraph {
+	dst := NewWeightedUndirectedGraph(0, math.Inf(1))
+	addNodesOf(dst, a, b)
+	eachEdgeOf(a, func(uid, vid int64) {
+		w, _ := a.Weight(uid, vid)
+		if b.HasEdgeBetween(uid, vid) {
+			wb, _ := b.Weight(uid, vid)
+			w = combine(w, wb)
+		}
+		dst.SetWeightedEdge(WeightedEdge{F: dst.Node(uid), T: dst.Node(vid), W: w})
+	})
+	eachEdgeOf(b, func(uid, vid int64) {
+		if a.HasEdgeBetween(uid, vid) {
+			return
+		}
+		w, _ := b.Weight(uid, vid)
+		dst.SetWeightedEdge(WeightedEdge{F: dst.Node(uid), T: dst.Node(vid), W: w})
+	})
+	return dst
+}
+
+// WeightedIntersection returns a weighted graph holding the nodes of a and b
+// and the edges that are in both a and b, as described for Intersection. The
+// weight of each edge is combine(wa, wb) where wa and wb are the weights of
+// the edge in a and b. The returned graph has zero-weight self connections
+// and absent edges are given an infinite weight.
+func WeightedIntersection(a, b graph.WeightedUndirected, combine func(wa, wb float64) float64) *WeightedUndirectedGraph {
+	dst := NewWeightedUndirectedGraph(0, math.Inf(1))
+	addNodesOf(dst, a, b)
+	eachEdgeOf(a, func(uid, vid int64) {
+		if !b.HasEdgeBetween(uid, vid) {
+			return
+		}
+		wa, _ := a.Weight(uid, vid)
+		wb, _ := b.Weight(uid, vid)
+		dst.SetWeightedEdge(WeightedEdge{F: dst.Node(uid), T: dst.Node(vid), W: combine(wa, wb)})
+	})
+	return dst
+}
+
+// WeightedDifference returns a weighted graph holding the nodes of a and b and
+// the edges that are in a and not in b, as described for Difference, with the
+// weights they have in a. The returned graph has zero-weight self connections
+// and absent edges are given an infinite weight.
+func WeightedDifference(a, b graph.WeightedUndirected) *WeightedUndirectedGraph {
+	dst := NewWeightedUndirectedGraph(0, math.Inf(1))
+	addNodesOf(dst, a, b)
+	eachEdgeOf(a, func(uid, vid int64) {
+		if b.HasEdgeBetween(uid, vid) {
+			return
+		}
+		w, _ := a.Weight(uid, vid)
+		dst.SetWeightedEdge(WeightedEdge{F: dst.Node(uid), T: dst.Node(vid), W: w})
+	})
+	return dst
+}
+
+// addNodesOf adds the nodes of a and then the nodes of b that are not in a
+// to dst.
+func addNodesOf(dst interface {
+	graph.Graph
+	graph.NodeAdder
+}, a, b graph.Graph) {
+	for _, g := range []graph.Graph{a, b} {
+		nodes := g.Nodes()
+		for nodes.Next() {
+			n := nodes.Node()
+			if dst.Node(n.ID()) == nil {
+				dst.AddNode(n)
+			}
+		}
+	}
+}
+
+// eachEdgeOf calls fn once for each edge of g that is not a self edge, with
+// the lower of the end node IDs as uid.
+func eachEdgeOf(g graph.Graph, fn func(uid, vid int64)) {
+	nodes := g.Nodes()
+	for nodes.Next() {
+		uid := nodes.Node().ID()
+		to := g.From(uid)
+		for to.Next() {
+			if vid := to.Node().ID(); uid < vid {
+				fn(uid, vid)
+			}
+		}
+	}
+}
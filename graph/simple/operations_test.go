@@ -0,0 +1,138 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package simple_test
+
+import (
+	"cmp"
+	"math"
+	"reflect"
+	"slices"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+func TestSetOperations(t *testing.T) {
+	t.Parallel()
+
+	a := simple.NewUndirectedGraph()
+	for _, e := range [][2]int64{{0, 1}, {1, 2}, {2, 3}} {
+		a.SetEdge(simple.Edge{F: simple.Node(e[0]), T: simple.Node(e[1])})
+	}
+	b := simple.NewUndirectedGraph()
+	for _, e := range [][2]int64{{2, 1}, {3, 4}} {
+		b.SetEdge(simple.Edge{F: simple.Node(e[0]), T: simple.Node(e[1])})
+	}
+	b.AddNode(simple.Node(5))
+
+	wantNodes := []int64{0, 1, 2, 3, 4, 5}
+	for _, test := range []struct {
+		name string
+		op   func(a, b graph.Undirected) *simple.UndirectedGraph
+		want [][2]int64
+	}{
+		{name: "union", op: simple.Union, want: [][2]int64{{0, 1}, {1, 2}, {2, 3}, {3, 4}}},
+		{name: "intersection", op: simple.Intersection, want: [][2]int64{{1, 2}}},
+		{name: "difference", op: simple.Difference, want: [][2]int64{{0, 1}, {2, 3}}},
+	} {
+		g := test.op(a, b)
+		if got := nodeIDsOf(g); !reflect.DeepEqual(got, wantNodes) {
+			t.Errorf("unexpected nodes for %s: got:%v want:%v", test.name, got, wantNodes)
+		}
+		if got := edgesOf(g); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("unexpected edges for %s: got:%v want:%v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestWeightedSetOperations(t *testing.T) {
+	t.Parallel()
+
+	a := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+	for _, e := range []simple.WeightedEdge{
+		{F: simple.Node(0), T: simple.Node(1), W: 1},
+		{F: simple.Node(1), T: simple.Node(2), W: 2},
+	} {
+		a.SetWeightedEdge(e)
+	}
+	b := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+	for _, e := range []simple.WeightedEdge{
+		{F: simple.Node(2), T: simple.Node(1), W: 5},
+		{F: simple.Node(2), T: simple.Node(3), W: 3},
+	} {
+		b.SetWeightedEdge(e)
+	}
+
+	for _, test := range []struct {
+		name string
+		op   func(a, b graph.WeightedUndirected) *simple.WeightedUndirectedGraph
+		want map[[2]int64]float64
+	}{
+		{
+			name: "union sum",
+			op: func(a, b graph.WeightedUndirected) *simple.WeightedUndirectedGraph {
+				return simple.WeightedUnion(a, b, func(wa, wb float64) float64 { return wa + wb })
+			},
+			want: map[[2]int64]float64{{0, 1}: 1, {1, 2}: 7, {2, 3}: 3},
+		},
+		{
+			name: "union max",
+			op: func(a, b graph.WeightedUndirected) *simple.WeightedUndirectedGraph {
+				return simple.WeightedUnion(a, b, math.Max)
+			},
+			want: map[[2]int64]float64{{0, 1}: 1, {1, 2}: 5, {2, 3}: 3},
+		},
+		{
+			name: "intersection min",
+			op: func(a, b graph.WeightedUndirected) *simple.WeightedUndirectedGraph {
+				return simple.WeightedIntersection(a, b, math.Min)
+			},
+			want: map[[2]int64]float64{{1, 2}: 2},
+		},
+		{
+			name: "difference",
+			op:   simple.WeightedDifference,
+			want: map[[2]int64]float64{{0, 1}: 1},
+		},
+	} {
+		g := test.op(a, b)
+		if n := g.Nodes().Len(); n != 4 {
+			t.Errorf("unexpected number of nodes for %s: got:%d want:4", test.name, n)
+		}
+		got := make(map[[2]int64]float64)
+		for _, e := range graph.WeightedEdgesOf(g.WeightedEdges()) {
+			u, v := e.From().ID(), e.To().ID()
+			got[[2]int64{min(u, v), max(u, v)}] = e.Weight()
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("unexpected edges for %s: got:%v want:%v", test.name, got, test.want)
+		}
+	}
+}
+
+func nodeIDsOf(g graph.Graph) []int64 {
+	var ids []int64
+	for _, n := range graph.NodesOf(g.Nodes()) {
+		ids = append(ids, n.ID())
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+func edgesOf(g *simple.UndirectedGraph) [][2]int64 {
+	var edges [][2]int64
+	for _, e := range graph.EdgesOf(g.Edges()) {
+		u, v := e.From().ID(), e.To().ID()
+		edges = append(edges, [2]int64{min(u, v), max(u, v)})
+	}
+	slices.SortFunc(edges, func(a, b [2]int64) int {
+		if c := cmp.Compare(a[0], b[0]); c != 0 {
+			return c
+		}
+		return cmp.Compare(a[1], b[1])
+	})
+	return edges
+}
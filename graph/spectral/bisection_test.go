@@ -0,0 +1,104 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package spectral
+
+import (
+	"reflect"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+var spectralBisectionTests = []struct {
+	name  string
+	edges []simple.WeightedEdge
+	nodes []int64
+
+	wantA, wantB []int64
+}{
+	{
+		name:  "empty",
+		wantA: nil,
+		wantB: nil,
+	},
+	{
+		name:  "single",
+		nodes: []int64{3},
+		wantA: []int64{3},
+		wantB: nil,
+	},
+	{
+		name: "path",
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 1},
+			{F: simple.Node(1), T: simple.Node(2), W: 1},
+			{F: simple.Node(2), T: simple.Node(3), W: 1},
+		},
+		wantA: []int64{0, 1},
+		wantB: []int64{2, 3},
+	},
+	{
+		// Two 4-cliques joined by a single bridge.
+		name: "barbell",
+		edges: append(clique(0, 4, 1), append(clique(4, 8, 1),
+			simple.WeightedEdge{F: simple.Node(3), T: simple.Node(4), W: 1})...),
+		wantA: []int64{0, 1, 2, 3},
+		wantB: []int64{4, 5, 6, 7},
+	},
+	{
+		// The light edges of the ring form the cut.
+		name: "weighted ring",
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 10},
+			{F: simple.Node(1), T: simple.Node(2), W: 0.1},
+			{F: simple.Node(2), T: simple.Node(3), W: 10},
+			{F: simple.Node(3), T: simple.Node(4), W: 10},
+			{F: simple.Node(4), T: simple.Node(5), W: 0.1},
+			{F: simple.Node(5), T: simple.Node(0), W: 10},
+		},
+		wantA: []int64{0, 1, 5},
+		wantB: []int64{2, 3, 4},
+	},
+}
+
+func TestSpectralBisection(t *testing.T) {
+	for _, test := range spectralBisectionTests {
+		g := simple.NewWeightedUndirectedGraph(0, 0)
+		for _, id := range test.nodes {
+			g.AddNode(simple.Node(id))
+		}
+		for _, e := range test.edges {
+			g.SetWeightedEdge(e)
+		}
+		partA, partB := SpectralBisection(g)
+		if got := ids(partA); !reflect.DeepEqual(got, test.wantA) {
+			t.Errorf("unexpected part A for %q: got:%v want:%v", test.name, got, test.wantA)
+		}
+		if got := ids(partB); !reflect.DeepEqual(got, test.wantB) {
+			t.Errorf("unexpected part B for %q: got:%v want:%v", test.name, got, test.wantB)
+		}
+	}
+}
+
+// clique returns the edges of a clique over the nodes from lo to hi-1
+// with weight w.
+func clique(lo, hi int64, w float64) []simple.WeightedEdge {
+	var edges []simple.WeightedEdge
+	for u := lo; u < hi; u++ {
+		for v := u + 1; v < hi; v++ {
+			edges = append(edges, simple.WeightedEdge{F: simple.Node(u), T: simple.Node(v), W: w})
+		}
+	}
+	return edges
+}
+
+func ids(nodes []graph.Node) []int64 {
+	var ids []int64
+	for _, n := range nodes {
+		ids = append(ids, n.ID())
+	}
+	return ids
+}
@@ -5,9 +5,11 @@
 package spectral
 
 import (
+	"fmt"
 	"math"
 
 	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/internal/order"
 	"gonum.org/v1/gonum/mat"
 )
 
@@ -97,6 +99,113 @@ func NewSymNormLaplacian(g graph.Undirected) Laplacian {
 	return Laplacian{Matrix: l, Nodes: nodes, Index: indexOf}
 }
 
+// NewWeightedLaplacian returns a Laplacian matrix for the undirected graph g
+// with rows and columns in the order of nodes. If nodes is nil, all the nodes
+// of g are used in order of ID. The Laplacian is defined as D-W where W is the
+// weighted adjacency matrix of g, holding the edge weights if g is a
+// graph.Weighted and 1 for each edge otherwise, and D is a diagonal matrix
+// holding the weighted degree of each node, the sum of its row of W. Edges to
+// nodes not in nodes are ignored, so the Laplacian is that of the subgraph of
+// g induced by nodes. The Matrix field of the returned Laplacian is a
+// *mat.SymDense suitable for use with mat.EigenSym.
+//
+// If g contains self edges or a node in nodes is not in g or is repeated,
+// NewWeightedLaplacian will panic.
+func NewWeightedLaplacian(g graph.Undirected, nodes []graph.Node) Laplacian {
+	nodes, indexOf := laplacianNodes(g, nodes)
+	l, deg := weightedAdjacency(g, nodes, indexOf)
+	for i := range nodes {
+		for j := i + 1; j < len(nodes); j++ {
+			l.SetSym(i, j, -l.At(i, j))
+		}
+		l.SetSym(i, i, deg[i])
+	}
+	return Laplacian{Matrix: l, Nodes: nodes, Index: indexOf}
+}
+
+// NewWeightedSymNormLaplacian returns a symmetric normalized Laplacian matrix
+// for the undirected graph g with rows and columns in the order of nodes. If
+// nodes is nil, all the nodes of g are used in order of ID. The normalized
+// Laplacian is defined as I-D^(-1/2)WD^(-1/2) where W and D are the weighted
+// adjacency and degree matrices described for NewWeightedLaplacian. Rows and
+// columns for nodes with a zero weighted degree are zero. The Matrix field of
+// the returned Laplacian is a *mat.SymDense.
+//
+// If g contains self edges or a node in nodes is not in g or is repeated,
+// NewWeightedSymNormLaplacian will panic.
+func NewWeightedSymNormLaplacian(g graph.Undirected, nodes []graph.Node) Laplacian {
+	nodes, indexOf := laplacianNodes(g, nodes)
+	l, deg := weightedAdjacency(g, nodes, indexOf)
+	for i := range nodes {
+		if deg[i] == 0 {
+			continue
+		}
+		for j := i + 1; j < len(nodes); j++ {
+			if deg[j] != 0 {
+				l.SetSym(i, j, -l.At(i, j)/math.Sqrt(deg[i]*deg[j]))
+			}
+		}
+		l.SetSym(i, i, 1)
+	}
+	return Laplacian{Matrix: l, Nodes: nodes, Index: indexOf}
+}
+
+// laplacianNodes returns the nodes to use for a Laplacian of g and a mapping
+// from their IDs to row and column indices. If nodes is nil, the nodes of g
+// are returned in order of ID.
+func laplacianNodes(g graph.Graph, nodes []graph.Node) ([]graph.Node, map[int64]int) {
+	if nodes == nil {
+		nodes = graph.NodesOf(g.Nodes())
+		order.ByID(nodes)
+	}
+	indexOf := make(map[int64]int, len(nodes))
+	for i, n := range nodes {
+		id := n.ID()
+		if g.Node(id) == nil {
+			panic(fmt.Sprintf("spectral: node %d not in graph", id))
+		}
+		if _, exists := indexOf[id]; exists {
+			panic(fmt.Sprintf("spectral: repeated node %d", id))
+		}
+		indexOf[id] = i
+	}
+	return nodes, indexOf
+}
+
+// weightedAdjacency returns the weighted adjacency matrix of the subgraph
+// of g induced by nodes and the weighted degrees of the nodes.
+func weightedAdjacency(g graph.Undirected, nodes []graph.Node, indexOf map[int64]int) (*mat.SymDense, []float64) {
+	if len(nodes) == 0 {
+		return &mat.SymDense{}, nil
+	}
+	wg, isWeighted := g.(graph.Weighted)
+	a := mat.NewSymDense(len(nodes), nil)
+	deg := make([]float64, len(nodes))
+	for i, u := range nodes {
+		uid := u.ID()
+		to := g.From(uid)
+		for to.Next() {
+			vid := to.Node().ID()
+			if uid == vid {
+				panic("spectral: self edge in graph")
+			}
+			j, ok := indexOf[vid]
+			if !ok {
+				continue
+			}
+			w := 1.0
+			if isWeighted {
+				w, _ = wg.Weight(uid, vid)
+			}
+			deg[i] += w
+			if i < j {
+				a.SetSym(i, j, w)
+			}
+		}
+	}
+	return a, deg
+}
+
 // NewRandomWalkLaplacian returns a damp-scaled random walk Laplacian matrix for
 // the simple graph g.
 // The random walk Laplacian is defined as I-D^(-1)A where D is a diagonal matrix
@@ -5,6 +5,7 @@
 package spectral
 
 import (
+	"math"
 	"testing"
 
 	"gonum.org/v1/gonum/floats/scalar"
@@ -122,6 +123,117 @@ func TestRandomWalkLaplacian(t *testing.T) {
 	}
 }
 
+var weightedLaplacianTests = []struct {
+	name  string
+	edges []simple.WeightedEdge
+	nodes []int64
+
+	want, wantSymNorm *mat.Dense
+}{
+	{
+		name: "path",
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 1},
+			{F: simple.Node(1), T: simple.Node(2), W: 3},
+		},
+		want: mat.NewDense(3, 3, []float64{
+			1, -1, 0,
+			-1, 4, -3,
+			0, -3, 3,
+		}),
+		wantSymNorm: mat.NewDense(3, 3, []float64{
+			1, -0.5, 0,
+			-0.5, 1, -math.Sqrt(3) / 2,
+			0, -math.Sqrt(3) / 2, 1,
+		}),
+	},
+	{
+		// Node 3 is ignored, so not counted in the
+		// degree of node 1, and 2 has a zero degree.
+		name: "ordered subgraph",
+		edges: []simple.WeightedEdge{
+			{F: simple.Node(0), T: simple.Node(1), W: 2},
+			{F: simple.Node(1), T: simple.Node(3), W: 5},
+			{F: simple.Node(3), T: simple.Node(2), W: 1},
+		},
+		nodes: []int64{2, 1, 0},
+		want: mat.NewDense(3, 3, []float64{
+			0, 0, 0,
+			0, 2, -2,
+			0, -2, 2,
+		}),
+		wantSymNorm: mat.NewDense(3, 3, []float64{
+			0, 0, 0,
+			0, 1, -1,
+			0, -1, 1,
+		}),
+	},
+}
+
+func TestWeightedLaplacian(t *testing.T) {
+	const tol = 1e-14
+	for _, test := range weightedLaplacianTests {
+		g := simple.NewWeightedUndirectedGraph(0, 0)
+		for _, e := range test.edges {
+			g.SetWeightedEdge(e)
+		}
+		var nodes []graph.Node
+		for _, id := range test.nodes {
+			nodes = append(nodes, simple.Node(id))
+		}
+
+		l := NewWeightedLaplacian(g, nodes)
+		if !mat.EqualApprox(l, test.want, tol) {
+			t.Errorf("unexpected Laplacian for %q:\ngot:\n% .2v\nwant:\n% .2v",
+				test.name, mat.Formatted(l), mat.Formatted(test.want))
+		}
+		for i, n := range l.Nodes {
+			if l.Index[n.ID()] != i {
+				t.Errorf("unexpected index for node %d in %q: got:%d want:%d", n.ID(), test.name, l.Index[n.ID()], i)
+			}
+			if test.nodes != nil && n.ID() != test.nodes[i] {
+				t.Errorf("unexpected node order for %q: got:%d want:%d at %d", test.name, n.ID(), test.nodes[i], i)
+			}
+		}
+		var eig mat.EigenSym
+		if !eig.Factorize(l.Matrix.(*mat.SymDense), false) {
+			t.Errorf("failed to factorize Laplacian for %q", test.name)
+		} else if min := eig.Values(nil)[0]; !scalar.EqualWithinAbs(min, 0, 1e-12) {
+			t.Errorf("unexpected smallest eigenvalue for %q: got:%v want:0", test.name, min)
+		}
+
+		l = NewWeightedSymNormLaplacian(g, nodes)
+		if !mat.EqualApprox(l, test.wantSymNorm, tol) {
+			t.Errorf("unexpected normalized Laplacian for %q:\ngot:\n% .2v\nwant:\n% .2v",
+				test.name, mat.Formatted(l), mat.Formatted(test.wantSymNorm))
+		}
+	}
+}
+
+func TestWeightedLaplacianUnweighted(t *testing.T) {
+	g := simple.NewUndirectedGraph()
+	for _, e := range [][2]int64{{0, 1}, {1, 2}, {2, 0}, {2, 3}, {4, 3}} {
+		g.SetEdge(simple.Edge{F: simple.Node(e[0]), T: simple.Node(e[1])})
+	}
+	for _, test := range []struct {
+		name      string
+		got, want Laplacian
+	}{
+		{name: "Laplacian", got: NewWeightedLaplacian(g, nil), want: NewLaplacian(g)},
+		{name: "normalized Laplacian", got: NewWeightedSymNormLaplacian(g, nil), want: NewSymNormLaplacian(g)},
+	} {
+		for _, u := range test.got.Nodes {
+			for _, v := range test.got.Nodes {
+				got := test.got.At(test.got.Index[u.ID()], test.got.Index[v.ID()])
+				want := test.want.At(test.want.Index[u.ID()], test.want.Index[v.ID()])
+				if !scalar.EqualWithinAbs(got, want, 1e-14) {
+					t.Errorf("unexpected %s element for %d-%d: got:%v want:%v", test.name, u.ID(), v.ID(), got, want)
+				}
+			}
+		}
+	}
+}
+
 type sortedNodeGraph struct {
 	graph.Graph
 }
@@ -0,0 +1,179 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package topo
+
+import (
+	"cmp"
+	"slices"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+// BiconnectedComponents returns the biconnected components of the undirected
+// graph g. Each component is returned as the set of edges it holds, since a
+// node may belong to more than one biconnected component. Nodes without edges
+// do not belong to any component and self loops are ignored. The edges are
+// those returned by g.Edge for the node pairs in the component.
+//
+// BiconnectedComponents and ArticulationPoints are found by the Hopcroft-Tarjan
+// low-link depth-first search, doi:10.1145/362248.362272. The search uses an
+// explicit stack rather than recursion so it is suitable for very deep graphs.
+func BiconnectedComponents(g graph.Undirected) [][]graph.Edge {
+	return newLowLinks(g).components
+}
+
+// ArticulationPoints returns the articulation points of the undirected graph g,
+// the nodes whose removal increases the number of connected components of g.
+// The returned nodes are sorted by ID.
+func ArticulationPoints(g graph.Undirected) []graph.Node {
+	return newLowLinks(g).articulation
+}
+
+// Bridges returns the bridges of the undirected graph g, the edges whose removal
+// increases the number of connected components of g. If g is a multigraph that
+// implements graph.UndirectedMultigraph, edges formed by more than one line are
+// not bridges since removing a single line leaves the nodes connected. The
+// edges are those returned by g.Edge and are sorted by the lower and then the
+// higher ID of their end nodes.
+//
+// Bridges are found by the same low-link depth-first search used by
+// BiconnectedComponents.
+func Bridges(g graph.Undirected) []graph.Edge {
+	return newLowLinks(g).bridges
+}
+
+// lowLinks holds the results of a low-link depth-first search.
+type lowLinks struct {
+	components   [][]graph.Edge
+	articulation []graph.Node
+	bridges      []graph.Edge
+}
+
+// lowLinkEdge is an edge held on the low-link search edge stack.
+type lowLinkEdge struct {
+	uid, vid int64
+	e        graph.Edge
+}
+
+// newLowLinks performs a low-link depth-first search of g.
+func newLowLinks(g graph.Undirected) lowLinks {
+	var (
+		l lowLinks
+
+		time int
+		disc = make(map[int64]int)
+		low  = make(map[int64]int)
+
+		// edges is the stack of edges of
+		// the components being found.
+		edges []lowLinkEdge
+	)
+
+	// lowLinkFrame is a suspended visit of the
+	// node v, reached from parent, with to
+	// holding the unvisited neighbours of v.
+	type lowLinkFrame struct {
+		v, parent graph.Node
+		to        graph.Nodes
+
+		// skipped indicates that the
+		// edge to the parent has been
+		// passed over.
+		skipped bool
+	}
+	var frames []lowLinkFrame
+	visit := func(v, parent graph.Node) {
+		time++
+		disc[v.ID()] = time
+		low[v.ID()] = time
+		frames = append(frames, lowLinkFrame{v: v, parent: parent, to: g.From(v.ID())})
+	}
+
+	mg, isMulti := g.(graph.UndirectedMultigraph)
+	var bridges []lowLinkEdge
+
+	nodes := graph.NodesOf(g.Nodes())
+	order.ByID(nodes)
+	for _, root := range nodes {
+		if disc[root.ID()] != 0 {
+			continue
+		}
+		var children int
+		isCut := make(map[int64]bool)
+		visit(root, nil)
+		for len(frames) != 0 {
+			f := &frames[len(frames)-1]
+			vid := f.v.ID()
+			if f.to.Next() {
+				w := f.to.Node()
+				wid := w.ID()
+				switch {
+				case wid == vid:
+					// Ignore self loops.
+				case f.parent != nil && wid == f.parent.ID() && !f.skipped:
+					f.skipped = true
+				case disc[wid] == 0:
+					edges = append(edges, lowLinkEdge{vid, wid, g.Edge(vid, wid)})
+					if vid == root.ID() {
+						children++
+					}
+					visit(w, f.v)
+				case disc[wid] < disc[vid]:
+					// w is an ancestor of v.
+					edges = append(edges, lowLinkEdge{vid, wid, g.Edge(vid, wid)})
+					low[vid] = min(low[vid], disc[wid])
+				}
+				continue
+			}
+
+			// All neighbours of v have been considered.
+			frames = frames[:len(frames)-1]
+			if f.parent == nil {
+				continue
+			}
+			u := f.parent
+			uid := u.ID()
+			low[uid] = min(low[uid], low[vid])
+			if low[vid] > disc[uid] && (!isMulti || mg.LinesBetween(uid, vid).Len() == 1) {
+				bridges = append(bridges, lowLinkEdge{min(uid, vid), max(uid, vid), g.Edge(uid, vid)})
+			}
+			if low[vid] < disc[uid] {
+				continue
+			}
+
+			// u separates the subtree rooted at v
+			// from the rest of the graph, so pop the
+			// component ending with the edge (u, v).
+			if uid != root.ID() {
+				isCut[uid] = true
+			}
+			var c []graph.Edge
+			for {
+				e := edges[len(edges)-1]
+				edges = edges[:len(edges)-1]
+				c = append(c, e.e)
+				if e.uid == uid && e.vid == vid {
+					break
+				}
+			}
+			l.components = append(l.components, c)
+		}
+		if children > 1 {
+			isCut[root.ID()] = true
+		}
+		for id := range isCut {
+			l.articulation = append(l.articulation, g.Node(id))
+		}
+	}
+	order.ByID(l.articulation)
+	slices.SortFunc(bridges, func(a, b lowLinkEdge) int {
+		return cmp.Or(cmp.Compare(a.uid, b.uid), cmp.Compare(a.vid, b.vid))
+	})
+	for _, e := range bridges {
+		l.bridges = append(l.bridges, e.e)
+	}
+	return l
+}
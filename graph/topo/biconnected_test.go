@@ -0,0 +1,240 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package topo
+
+import (
+	"cmp"
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/multi"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+var biconnectedTests = []struct {
+	name string
+	g    []intset
+
+	wantComponents   [][]int64
+	wantArticulation []int64
+	wantBridges      [][2]int64
+}{
+	{
+		name: "empty",
+	},
+	{
+		name:             "isolated",
+		g:                []intset{0: nil, 1: nil},
+		wantComponents:   nil,
+		wantArticulation: nil,
+	},
+	{
+		name: "path",
+		g: []intset{
+			0: linksTo(1),
+			1: linksTo(2),
+			2: nil,
+		},
+		wantComponents:   [][]int64{{0, 1}, {1, 2}},
+		wantArticulation: []int64{1},
+		wantBridges:      [][2]int64{{0, 1}, {1, 2}},
+	},
+	{
+		name: "bowtie",
+		g: []intset{
+			0: linksTo(1, 2),
+			1: linksTo(2),
+			2: linksTo(3, 4),
+			3: linksTo(4),
+			4: nil,
+		},
+		wantComponents:   [][]int64{{0, 1, 2}, {2, 3, 4}},
+		wantArticulation: []int64{2},
+	},
+	{
+		name: "cycle with pendants",
+		g: []intset{
+			0: linksTo(1, 3),
+			1: linksTo(2),
+			2: linksTo(3, 4),
+			3: nil,
+			4: linksTo(5),
+			5: nil,
+			6: linksTo(0),
+		},
+		wantComponents:   [][]int64{{0, 1, 2, 3}, {0, 6}, {2, 4}, {4, 5}},
+		wantArticulation: []int64{0, 2, 4},
+		wantBridges:      [][2]int64{{0, 6}, {2, 4}, {4, 5}},
+	},
+	{
+		name: "two components",
+		g: []intset{
+			0: linksTo(1),
+			1: nil,
+			2: linksTo(3, 4),
+			3: linksTo(4),
+			4: nil,
+		},
+		wantComponents:   [][]int64{{0, 1}, {2, 3, 4}},
+		wantArticulation: nil,
+		wantBridges:      [][2]int64{{0, 1}},
+	},
+}
+
+func TestBiconnectedComponents(t *testing.T) {
+	for _, test := range biconnectedTests {
+		g := simple.NewUndirectedGraph()
+		for u, e := range test.g {
+			// Add nodes that are not defined by an edge.
+			if g.Node(int64(u)) == nil {
+				g.AddNode(simple.Node(u))
+			}
+			for v := range e {
+				g.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+			}
+		}
+
+		got := componentNodeIDs(BiconnectedComponents(g))
+		if !reflect.DeepEqual(got, test.wantComponents) {
+			t.Errorf("unexpected biconnected components for %q:\ngot: %v\nwant:%v", test.name, got, test.wantComponents)
+		}
+
+		var gotArticulation []int64
+		for _, n := range ArticulationPoints(g) {
+			gotArticulation = append(gotArticulation, n.ID())
+		}
+		if !reflect.DeepEqual(gotArticulation, test.wantArticulation) {
+			t.Errorf("unexpected articulation points for %q: got:%v want:%v", test.name, gotArticulation, test.wantArticulation)
+		}
+
+		var gotBridges [][2]int64
+		for _, e := range Bridges(g) {
+			gotBridges = append(gotBridges, [2]int64{min(e.From().ID(), e.To().ID()), max(e.From().ID(), e.To().ID())})
+		}
+		if !reflect.DeepEqual(gotBridges, test.wantBridges) {
+			t.Errorf("unexpected bridges for %q: got:%v want:%v", test.name, gotBridges, test.wantBridges)
+		}
+	}
+}
+
+func TestBridgesMultigraph(t *testing.T) {
+	g := multi.NewUndirectedGraph()
+	for _, l := range [][2]int64{{0, 1}, {1, 2}, {1, 2}, {2, 3}} {
+		g.SetLine(g.NewLine(multi.Node(l[0]), multi.Node(l[1])))
+	}
+	var got [][2]int64
+	for _, e := range Bridges(g) {
+		got = append(got, [2]int64{min(e.From().ID(), e.To().ID()), max(e.From().ID(), e.To().ID())})
+	}
+	want := [][2]int64{{0, 1}, {2, 3}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected bridges: got:%v want:%v", got, want)
+	}
+}
+
+func TestBiconnectedComponentsRandom(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for trial := 0; trial < 50; trial++ {
+		g := simple.NewUndirectedGraph()
+		const n = 20
+		for i := 0; i < n; i++ {
+			g.AddNode(simple.Node(i))
+		}
+		for i := 0; i < n; i++ {
+			for j := 0; j < i; j++ {
+				if rnd.Float64() < 0.12 {
+					g.SetEdge(simple.Edge{F: simple.Node(i), T: simple.Node(j)})
+				}
+			}
+		}
+
+		// Every edge belongs to exactly one component.
+		seen := make(map[[2]int64]bool)
+		for _, c := range BiconnectedComponents(g) {
+			for _, e := range c {
+				k := [2]int64{e.From().ID(), e.To().ID()}
+				if k[0] > k[1] {
+					k[0], k[1] = k[1], k[0]
+				}
+				if seen[k] {
+					t.Errorf("edge %v in more than one component", k)
+				}
+				seen[k] = true
+			}
+		}
+		if len(seen) != g.Edges().Len() {
+			t.Errorf("unexpected number of edges in components: got:%d want:%d", len(seen), g.Edges().Len())
+		}
+
+		// A node is an articulation point exactly when
+		// its removal increases the number of components.
+		var want []int64
+		base := len(ConnectedComponents(g))
+		for i := 0; i < n; i++ {
+			h := simple.NewUndirectedGraph()
+			graph.Copy(h, g)
+			h.RemoveNode(int64(i))
+			after := len(ConnectedComponents(h))
+			if g.From(int64(i)).Len() == 0 {
+				// Removing an isolated node
+				// removes its component.
+				after++
+			}
+			if after > base {
+				want = append(want, int64(i))
+			}
+		}
+		var got []int64
+		for _, n := range ArticulationPoints(g) {
+			got = append(got, n.ID())
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("unexpected articulation points: got:%v want:%v", got, want)
+		}
+
+		// An edge is a bridge exactly when its removal
+		// increases the number of components.
+		var wantBridges [][2]int64
+		for _, e := range graph.EdgesOf(g.Edges()) {
+			uid, vid := e.From().ID(), e.To().ID()
+			h := simple.NewUndirectedGraph()
+			graph.Copy(h, g)
+			h.RemoveEdge(uid, vid)
+			if len(ConnectedComponents(h)) > base {
+				wantBridges = append(wantBridges, [2]int64{min(uid, vid), max(uid, vid)})
+			}
+		}
+		slices.SortFunc(wantBridges, func(a, b [2]int64) int {
+			return cmp.Or(cmp.Compare(a[0], b[0]), cmp.Compare(a[1], b[1]))
+		})
+		var gotBridges [][2]int64
+		for _, e := range Bridges(g) {
+			gotBridges = append(gotBridges, [2]int64{min(e.From().ID(), e.To().ID()), max(e.From().ID(), e.To().ID())})
+		}
+		if !reflect.DeepEqual(gotBridges, wantBridges) {
+			t.Errorf("unexpected bridges: got:%v want:%v", gotBridges, wantBridges)
+		}
+	}
+}
+
+// componentNodeIDs returns the sorted node IDs of each component held in
+// edge sets, with the components sorted.
+func componentNodeIDs(components [][]graph.Edge) [][]int64 {
+	var ids [][]int64
+	for _, c := range components {
+		var nodes []int64
+		for _, e := range c {
+			nodes = append(nodes, e.From().ID(), e.To().ID())
+		}
+		slices.Sort(nodes)
+		ids = append(ids, slices.Compact(nodes))
+	}
+	order.BySliceValues(ids)
+	return ids
+}
@@ -0,0 +1,70 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package topo
+
+import "math/bits"
+
+// bitset is a fixed size set of non-negative integers.
+type bitset []uint64
+
+// newBitset returns a bitset able to hold the integers in [0, n).
+func newBitset(n int) bitset { return make(bitset, (n+63)/64) }
+
+func (s bitset) has(i int) bool { return s[i/64]&(1<<(uint(i)%64)) != 0 }
+func (s bitset) add(i int)      { s[i/64] |= 1 << (uint(i) % 64) }
+
+// union adds the members of t to s.
+func (s bitset) union(t bitset) {
+	for i, w := range t {
+		s[i] |= w
+	}
+}
+
+// difference removes the members of t from s.
+func (s bitset) difference(t bitset) {
+	for i, w := range t {
+		s[i] &^= w
+	}
+}
+
+// intersectionCount returns the number of members of
+// the intersection of s and t.
+func (s bitset) intersectionCount(t bitset) int {
+	var n int
+	for i, w := range s {
+		n += bits.OnesCount64(w & t[i])
+	}
+	return n
+}
+
+// remove removes i from s.
+func (s bitset) remove(i int) { s[i/64] &^= 1 << (uint(i) % 64) }
+
+// count returns the number of members of s.
+func (s bitset) count() int {
+	var n int
+	for _, w := range s {
+		n += bits.OnesCount64(w)
+	}
+	return n
+}
+
+// next returns the least member of s that is not less than i,
+// or -1 if there is none.
+func (s bitset) next(i int) int {
+	for w := i / 64; w < len(s); w++ {
+		word := s[w]
+		if w == i/64 {
+			word &^= 1<<(uint(i)%64) - 1
+		}
+		if word != 0 {
+			return w*64 + bits.TrailingZeros64(word)
+		}
+	}
+	return -1
+}
+
+// clone returns a copy of s.
+func (s bitset) clone() bitset { return append(bitset(nil), s...) }
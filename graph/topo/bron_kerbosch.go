@@ -9,6 +9,7 @@ import (
 
 	"gonum.org/v1/gonum/graph"
 	"gonum.org/v1/gonum/graph/internal/set"
+	"gonum.org/v1/gonum/internal/order"
 )
 
 // DegeneracyOrdering returns the degeneracy ordering and the k-cores of
@@ -40,6 +41,43 @@ func KCore(k int, g graph.Undirected) []graph.Node {
 	return core
 }
 
+// KCoreSubgraph returns the k-core of the undirected graph g as a subgraph of
+// g, the maximal subgraph of g in which every node has degree at least k. The
+// returned graph is a read-only view of g induced by the nodes of the k-core,
+// as returned by graph.InducedSubgraph, holding the nodes in order of ID. If
+// g is a graph.WeightedUndirected, so is the returned graph. If no node of g is
+// in the k-core, the returned graph has no nodes.
+func KCoreSubgraph(k int, g graph.Undirected) graph.Undirected {
+	cores := CoreNumbers(g)
+	var core []graph.Node
+	for _, n := range graph.NodesOf(g.Nodes()) {
+		if cores[n.ID()] >= k {
+			core = append(core, n)
+		}
+	}
+	order.ByID(core)
+	return graph.InducedSubgraph(g, core).(graph.Undirected)
+}
+
+// CoreNumbers returns the core number of each node of the undirected graph g.
+// The core number of a node is the largest k such that the node is in the
+// k-core of g, the maximal subgraph of g in which every node has degree at
+// least k. The core numbers are found by repeatedly removing a node of least
+// degree, as for DegeneracyOrdering.
+func CoreNumbers(g graph.Undirected) map[int64]int {
+	order, offsets := degeneracyOrdering(g)
+
+	cores := make(map[int64]int, len(order))
+	var offset int
+	for k, n := range offsets {
+		for _, u := range order[offset : offset+n] {
+			cores[u.ID()] = k
+		}
+		offset += n
+	}
+	return cores
+}
+
 // degeneracyOrdering is the common code for DegeneracyOrdering and KCore. It
 // returns l, the nodes of g in optimal ordering for coloring number and
 // s, a set of relative offsets into l for each k-core, where k is an index
@@ -134,6 +172,22 @@ func degeneracyOrdering(g graph.Undirected) (l []graph.Node, s []int) {
 
 // BronKerbosch returns the set of maximal cliques of the undirected graph g.
 func BronKerbosch(g graph.Undirected) [][]graph.Node {
+	var cliques [][]graph.Node
+	BronKerboschFunc(g, func(c []graph.Node) bool {
+		cliques = append(cliques, c)
+		return true
+	})
+	return cliques
+}
+
+// BronKerboschFunc calls fn with each maximal clique of the undirected graph g,
+// stopping when fn returns false or all maximal cliques have been found. The
+// cliques are found as they are by BronKerbosch, using pivoting and a
+// degeneracy ordering of the nodes of g, but are not retained, so
+// BronKerboschFunc may be used on graphs with too many maximal cliques to
+// hold in memory, such as the Moon–Moser graphs with 3^(n/3) maximal cliques
+// in n nodes. The clique passed to fn may be retained by fn.
+func BronKerboschFunc(g graph.Undirected, fn func(clique []graph.Node) bool) {
 	nodes := graph.NodesOf(g.Nodes())
 
 	// The algorithm used here is essentially BronKerbosch3 as described at
@@ -144,7 +198,7 @@ func BronKerbosch(g graph.Undirected) [][]graph.Node {
 		p.Add(n)
 	}
 	x := set.NewNodes()
-	var bk bronKerbosch
+	bk := bronKerbosch{fn: fn}
 	order, _ := degeneracyOrdering(g)
 	slices.Reverse(order)
 	for _, v := range order {
@@ -153,19 +207,24 @@ func BronKerbosch(g graph.Undirected) [][]graph.Node {
 		for _, n := range neighbours {
 			nv.Add(n)
 		}
-		bk.maximalCliquePivot(g, []graph.Node{v}, set.IntersectionOfNodes(p, nv), set.IntersectionOfNodes(x, nv))
+		if !bk.maximalCliquePivot(g, []graph.Node{v}, set.IntersectionOfNodes(p, nv), set.IntersectionOfNodes(x, nv)) {
+			return
+		}
 		p.Remove(v)
 		x.Add(v)
 	}
-	return bk
 }
 
-type bronKerbosch [][]graph.Node
+// bronKerbosch holds the function called with each maximal clique.
+type bronKerbosch struct {
+	fn func([]graph.Node) bool
+}
 
-func (bk *bronKerbosch) maximalCliquePivot(g graph.Undirected, r []graph.Node, p, x set.Nodes) {
+// maximalCliquePivot reports the maximal cliques extending r, returning
+// false if the search has been stopped.
+func (bk *bronKerbosch) maximalCliquePivot(g graph.Undirected, r []graph.Node, p, x set.Nodes) bool {
 	if len(p) == 0 && len(x) == 0 {
-		*bk = append(*bk, r)
-		return
+		return bk.fn(r)
 	}
 
 	neighbours := bk.choosePivotFrom(g, p, x)
@@ -196,10 +255,13 @@ func (bk *bronKerbosch) maximalCliquePivot(g graph.Undirected, r []graph.Node, p
 			sr = append(r[:len(r):len(r)], v)
 		}
 
-		bk.maximalCliquePivot(g, sr, set.IntersectionOfNodes(p, nv), set.IntersectionOfNodes(x, nv))
+		if !bk.maximalCliquePivot(g, sr, set.IntersectionOfNodes(p, nv), set.IntersectionOfNodes(x, nv)) {
+			return false
+		}
 		p.Remove(v)
 		x.Add(v)
 	}
+	return true
 }
 
 func (*bronKerbosch) choosePivotFrom(g graph.Undirected, p, x set.Nodes) (neighbors []graph.Node) {
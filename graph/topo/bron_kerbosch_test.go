@@ -126,6 +126,93 @@ func TestKCore(t *testing.T) {
 	}
 }
 
+func TestKCoreSubgraph(t *testing.T) {
+	for i, test := range vOrderTests {
+		g := simple.NewUndirectedGraph()
+		for u, e := range test.g {
+			// Add nodes that are not defined by an edge.
+			if g.Node(int64(u)) == nil {
+				g.AddNode(simple.Node(u))
+			}
+			for v := range e {
+				g.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+			}
+		}
+
+		for k := 0; k <= test.wantK+2; k++ {
+			var want []int64
+			if k < len(test.wantCore) {
+				for _, c := range test.wantCore[k:] {
+					want = append(want, c...)
+				}
+			}
+			slices.Sort(want)
+
+			core := KCoreSubgraph(k, g)
+			var got []int64
+			for _, n := range graph.NodesOf(core.Nodes()) {
+				got = append(got, n.ID())
+				if deg := core.From(n.ID()).Len(); deg < k {
+					t.Errorf("node %d in %d-core for test %d has degree %d", n.ID(), k, i, deg)
+				}
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("unexpected %d-core for test %d:\ngot: %v\nwant:%v", k, i, got, want)
+			}
+
+			// Edges between core nodes are retained.
+			for _, uid := range got {
+				for _, v := range graph.NodesOf(g.From(uid)) {
+					if core.Node(v.ID()) != nil && !core.HasEdgeBetween(uid, v.ID()) {
+						t.Errorf("missing edge %d--%d in %d-core for test %d", uid, v.ID(), k, i)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestCoreNumbers(t *testing.T) {
+	for i, test := range vOrderTests {
+		g := simple.NewUndirectedGraph()
+		for u, e := range test.g {
+			// Add nodes that are not defined by an edge.
+			if g.Node(int64(u)) == nil {
+				g.AddNode(simple.Node(u))
+			}
+			for v := range e {
+				g.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+			}
+		}
+
+		want := make(map[int64]int)
+		for k, c := range test.wantCore {
+			for _, id := range c {
+				want[id] = k
+			}
+		}
+		got := CoreNumbers(g)
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("unexpected core numbers for test %d:\ngot: %v\nwant:%v", i, got, want)
+		}
+
+		// Each node with core number k must have at least
+		// k neighbours with core number at least k.
+		for id, k := range got {
+			var n int
+			to := g.From(id)
+			for to.Next() {
+				if got[to.Node().ID()] >= k {
+					n++
+				}
+			}
+			if n < k {
+				t.Errorf("node %d in test %d has core number %d with %d neighbours in the core", id, i, k, n)
+			}
+		}
+	}
+}
+
 var bronKerboschTests = []struct {
 	name string
 	g    []intset
@@ -203,6 +290,85 @@ func TestBronKerbosch(t *testing.T) {
 	}
 }
 
+func TestBronKerboschFuncMoonMoser(t *testing.T) {
+	// The Moon–Moser graph on 3k nodes is the complete
+	// k-partite graph with parts of size 3. Its maximal
+	// cliques take one node from each part, giving 3^k
+	// maximal cliques of size k.
+	const k = 6
+	g := simple.NewUndirectedGraph()
+	for u := 0; u < 3*k; u++ {
+		for v := u + 1; v < 3*k; v++ {
+			if u/3 != v/3 {
+				g.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+			}
+		}
+	}
+
+	seen := make(map[[k]int64]bool)
+	BronKerboschFunc(g, func(c []graph.Node) bool {
+		if len(c) != k {
+			t.Errorf("unexpected clique size: got:%d want:%d", len(c), k)
+			return true
+		}
+		var key [k]int64
+		for _, n := range c {
+			key[n.ID()/3] = n.ID()
+		}
+		for part, id := range key {
+			if id/3 != int64(part) {
+				t.Errorf("clique does not have one node from each part: %v", c)
+			}
+		}
+		if seen[key] {
+			t.Errorf("clique found twice: %v", c)
+		}
+		seen[key] = true
+		return true
+	})
+	if want := 729; len(seen) != want {
+		t.Errorf("unexpected number of maximal cliques: got:%d want:%d", len(seen), want)
+	}
+
+	var n int
+	BronKerboschFunc(g, func([]graph.Node) bool {
+		n++
+		return n < 10
+	})
+	if n != 10 {
+		t.Errorf("unexpected number of calls after stop: got:%d want:10", n)
+	}
+}
+
+func TestBronKerboschFunc(t *testing.T) {
+	for _, test := range bronKerboschTests {
+		g := simple.NewUndirectedGraph()
+		for u, e := range test.g {
+			// Add nodes that are not defined by an edge.
+			if g.Node(int64(u)) == nil {
+				g.AddNode(simple.Node(u))
+			}
+			for v := range e {
+				g.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+			}
+		}
+		var got [][]int64
+		BronKerboschFunc(g, func(c []graph.Node) bool {
+			ids := make([]int64, len(c))
+			for k, n := range c {
+				ids[k] = n.ID()
+			}
+			slices.Sort(ids)
+			got = append(got, ids)
+			return true
+		})
+		order.BySliceValues(got)
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("unexpected cliques for test %q:\ngot: %v\nwant:%v", test.name, got, test.want)
+		}
+	}
+}
+
 func BenchmarkBronKerbosch(b *testing.B) {
 	for _, test := range bronKerboschTests {
 		g := simple.NewUndirectedGraph()
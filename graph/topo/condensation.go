@@ -0,0 +1,98 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package topo
+
+import (
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+// Condensation builds the condensation of the directed graph g in dst using
+// StrongComponent and CondensationEdge nodes and edges, and returns the
+// mapping from the IDs of nodes in g to the IDs of the strongly connected
+// components containing them. Each strongly connected component of g is a
+// node of the condensation, and there is a single edge from one component to
+// another if g has at least one edge from a member of the first to a member of
+// the second. The condensation is acyclic and has no self edges. Component IDs
+// are those returned by StrongComponentLabels, so they are dense and in a
+// topological order of the condensation. The dst graph is not cleared.
+func Condensation(dst Builder, g graph.Directed) (membership map[int64]int) {
+	membership = StrongComponentLabels(g)
+
+	nodes := graph.NodesOf(g.Nodes())
+	order.ByID(nodes)
+	members := make([][]graph.Node, 0, len(membership))
+	for _, n := range nodes {
+		c := membership[n.ID()]
+		if c >= len(members) {
+			members = append(members, make([][]graph.Node, c-len(members)+1)...)
+		}
+		members[c] = append(members[c], n)
+	}
+	components := make([]StrongComponent, len(members))
+	for i, m := range members {
+		components[i] = StrongComponent{id: int64(i), nodes: m}
+		dst.AddNode(components[i])
+	}
+
+	var pairs [][2]int
+	edges := make(map[[2]int][]graph.Edge)
+	for _, u := range nodes {
+		uid := u.ID()
+		cu := membership[uid]
+		to := g.From(uid)
+		for to.Next() {
+			vid := to.Node().ID()
+			cv := membership[vid]
+			if cu == cv {
+				continue
+			}
+			key := [2]int{cu, cv}
+			if _, ok := edges[key]; !ok {
+				pairs = append(pairs, key)
+			}
+			edges[key] = append(edges[key], g.Edge(uid, vid))
+		}
+	}
+	for _, p := range pairs {
+		dst.SetEdge(CondensationEdge{from: components[p[0]], to: components[p[1]], edges: edges[p]})
+	}
+	return membership
+}
+
+// StrongComponent is a node in a condensation graph.
+type StrongComponent struct {
+	id    int64
+	nodes []graph.Node
+}
+
+// ID returns the node ID.
+func (n StrongComponent) ID() int64 { return n.id }
+
+// Nodes returns the nodes in the strongly connected component,
+// sorted by ID.
+func (n StrongComponent) Nodes() []graph.Node { return n.nodes }
+
+// CondensationEdge is an edge in a condensation graph.
+type CondensationEdge struct {
+	from, to StrongComponent
+	edges    []graph.Edge
+}
+
+// From returns the from node of the edge.
+func (e CondensationEdge) From() graph.Node { return e.from }
+
+// To returns the to node of the edge.
+func (e CondensationEdge) To() graph.Node { return e.to }
+
+// ReversedEdge returns a new CondensationEdge with
+// the edge end points swapped. The edges of the
+// new edge are shared with the receiver and are
+// not reversed.
+func (e CondensationEdge) ReversedEdge() graph.Edge { e.from, e.to = e.to, e.from; return e }
+
+// Edges returns the edges of the underlying graph from members of the
+// from component to members of the to component of the condensation edge.
+func (e CondensationEdge) Edges() []graph.Edge { return e.edges }
@@ -0,0 +1,73 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package topo
+
+import (
+	"reflect"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+func TestCondensation(t *testing.T) {
+	for i, test := range tarjanTests {
+		g := simple.NewDirectedGraph()
+		for u, e := range test.g {
+			// Add nodes that are not defined by an edge.
+			if g.Node(int64(u)) == nil {
+				g.AddNode(simple.Node(u))
+			}
+			for v := range e {
+				g.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+			}
+		}
+		dst := simple.NewDirectedGraph()
+		membership := Condensation(dst, g)
+		if !reflect.DeepEqual(membership, StrongComponentLabels(g)) {
+			t.Errorf("unexpected membership for test %d: got:%v", i, membership)
+		}
+		if n, want := dst.Nodes().Len(), len(TarjanSCC(g)); n != want {
+			t.Errorf("unexpected number of components for test %d: got:%d want:%d", i, n, want)
+		}
+		if _, err := Sort(dst); err != nil {
+			t.Errorf("condensation for test %d is not acyclic: %v", i, err)
+		}
+
+		for _, n := range graph.NodesOf(dst.Nodes()) {
+			c := n.(StrongComponent)
+			for j, m := range c.Nodes() {
+				if membership[m.ID()] != int(c.ID()) {
+					t.Errorf("node %d in component %d has membership %d for test %d", m.ID(), c.ID(), membership[m.ID()], i)
+				}
+				if j > 0 && c.Nodes()[j-1].ID() >= m.ID() {
+					t.Errorf("component members not sorted for test %d: %v", i, c.Nodes())
+				}
+			}
+		}
+
+		want := make(map[[2]int64]int)
+		for _, e := range graph.EdgesOf(g.Edges()) {
+			cu, cv := int64(membership[e.From().ID()]), int64(membership[e.To().ID()])
+			if cu != cv {
+				want[[2]int64{cu, cv}]++
+			}
+		}
+		got := make(map[[2]int64]int)
+		for _, e := range graph.EdgesOf(dst.Edges()) {
+			ce := e.(CondensationEdge)
+			key := [2]int64{ce.From().ID(), ce.To().ID()}
+			got[key] += len(ce.Edges())
+			for _, ue := range ce.Edges() {
+				if int64(membership[ue.From().ID()]) != key[0] || int64(membership[ue.To().ID()]) != key[1] {
+					t.Errorf("edge %d->%d does not join components %v for test %d", ue.From().ID(), ue.To().ID(), key, i)
+				}
+			}
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("unexpected condensation edges for test %d:\ngot: %v\nwant:%v", i, got, want)
+		}
+	}
+}
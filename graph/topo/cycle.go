@@ -0,0 +1,114 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package topo
+
+import (
+	"slices"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+// FindCycle returns a cycle in the directed graph g, or nil if g is acyclic.
+// Self edges are cycles. The cycle is taken from the first cyclic strongly
+// connected component of g in a topological order of the components, and is
+// a shortest cycle through the lowest ID node of that component. As for
+// DirectedCyclesIn, the first and last nodes of the returned cycle are the
+// same. FindCycle is deterministic for a given graph.
+func FindCycle(g graph.Directed) []graph.Node {
+	sccs := tarjanSCCstabilized(g, lexical)
+	for i := len(sccs) - 1; i >= 0; i-- {
+		c := sccs[i]
+		if len(c) == 1 {
+			u := c[0]
+			if g.HasEdgeFromTo(u.ID(), u.ID()) {
+				return []graph.Node{u, u}
+			}
+			continue
+		}
+		order.ByID(c)
+		return shortestCycleIn(g, c)
+	}
+	return nil
+}
+
+// FindCycleUndirected returns a cycle in the undirected graph g, or nil if g
+// is a forest. The edge from a node to its parent in the depth-first search
+// used to find the cycle is not considered a cycle, unless g implements
+// graph.UndirectedMultigraph and more than one line joins the nodes. Self
+// edges are cycles. The first and last nodes of the returned cycle are the
+// same. FindCycleUndirected is deterministic for a given graph.
+func FindCycleUndirected(g graph.Undirected) []graph.Node {
+	mg, isMulti := g.(graph.UndirectedMultigraph)
+
+	nodes := graph.NodesOf(g.Nodes())
+	order.ByID(nodes)
+	parent := make(map[int64]graph.Node, len(nodes))
+	type frame struct {
+		u    graph.Node
+		next []graph.Node
+	}
+	for _, root := range nodes {
+		if _, ok := parent[root.ID()]; ok {
+			continue
+		}
+		parent[root.ID()] = nil
+		stack := []frame{{u: root, next: neighboursByID(g, root)}}
+		for len(stack) != 0 {
+			f := &stack[len(stack)-1]
+			if len(f.next) == 0 {
+				stack = stack[:len(stack)-1]
+				continue
+			}
+			u := f.u
+			v := f.next[0]
+			f.next = f.next[1:]
+			uid, vid := u.ID(), v.ID()
+
+			if uid == vid {
+				return []graph.Node{u, u}
+			}
+			pv, seen := parent[vid]
+			if !seen {
+				parent[vid] = u
+				stack = append(stack, frame{u: v, next: neighboursByID(g, v)})
+				continue
+			}
+			if pv != nil && pv.ID() == uid {
+				// The tree edge to a finished child
+				// has already been considered from
+				// the child.
+				continue
+			}
+			if pu := parent[uid]; pu != nil && pu.ID() == vid {
+				if isMulti && mg.LinesBetween(uid, vid).Len() > 1 {
+					return []graph.Node{v, u, v}
+				}
+				continue
+			}
+
+			// v is an ancestor of u in the search
+			// tree since an undirected depth-first
+			// search has no cross edges and an edge
+			// to a finished descendant of u would
+			// have been found from the descendant.
+			cycle := []graph.Node{v}
+			for n := u; n.ID() != vid; n = parent[n.ID()] {
+				cycle = append(cycle, n)
+			}
+			cycle = append(cycle, v)
+			slices.Reverse(cycle)
+			return cycle
+		}
+	}
+	return nil
+}
+
+// neighboursByID returns the nodes reachable from u in g sorted by ID.
+func neighboursByID(g graph.Graph, u graph.Node) []graph.Node {
+	to := graph.NodesOf(g.From(u.ID()))
+	order.ByID(to)
+	return to
+}
@@ -0,0 +1,216 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package topo
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/multi"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+var findCycleTests = []struct {
+	name string
+	g    []intset
+	want []int64
+}{
+	{
+		name: "acyclic",
+		g: []intset{
+			0: linksTo(1, 2),
+			1: linksTo(2),
+			2: nil,
+		},
+		want: nil,
+	},
+	{
+		name: "self edge",
+		g: []intset{
+			0: linksTo(1),
+			1: linksTo(1),
+		},
+		want: []int64{1, 1},
+	},
+	{
+		name: "triangle",
+		g: []intset{
+			0: linksTo(1),
+			1: linksTo(2),
+			2: linksTo(0),
+		},
+		want: []int64{0, 1, 2, 0},
+	},
+	{
+		name: "shortest",
+		g: []intset{
+			0: linksTo(1, 3),
+			1: linksTo(2),
+			2: linksTo(3),
+			3: linksTo(0),
+		},
+		want: []int64{0, 3, 0},
+	},
+	{
+		name: "first component",
+		g: []intset{
+			0: linksTo(1),
+			1: linksTo(0, 2),
+			2: linksTo(3),
+			3: linksTo(2),
+			4: linksTo(5),
+			5: linksTo(4, 0),
+		},
+		want: []int64{4, 5, 4},
+	},
+}
+
+func TestFindCycle(t *testing.T) {
+	for _, test := range findCycleTests {
+		// A multigraph is used to allow self edges.
+		g := multi.NewDirectedGraph()
+		for u, e := range test.g {
+			// Add nodes that are not defined by an edge.
+			if g.Node(int64(u)) == nil {
+				g.AddNode(multi.Node(u))
+			}
+			for v := range e {
+				g.SetLine(g.NewLine(multi.Node(u), multi.Node(v)))
+			}
+		}
+		var got []int64
+		for _, n := range FindCycle(g) {
+			got = append(got, n.ID())
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("unexpected cycle for %q: got:%v want:%v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestFindCycleTarjan(t *testing.T) {
+	for i, test := range tarjanTests {
+		g := simple.NewDirectedGraph()
+		for u, e := range test.g {
+			// Add nodes that are not defined by an edge.
+			if g.Node(int64(u)) == nil {
+				g.AddNode(simple.Node(u))
+			}
+			for v := range e {
+				g.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+			}
+		}
+		cycle := FindCycle(g)
+		if (cycle == nil) != test.sortable {
+			t.Errorf("unexpected cycle for test %d: got:%v sortable:%t", i, cycle, test.sortable)
+		}
+		if cycle != nil {
+			checkCycle(t, "tarjan", g, cycle)
+		}
+	}
+}
+
+var findCycleUndirectedTests = []struct {
+	name  string
+	edges [][2]int64
+	want  []int64
+}{
+	{
+		name:  "tree",
+		edges: [][2]int64{{0, 1}, {0, 2}, {2, 3}, {2, 4}},
+		want:  nil,
+	},
+	{
+		name:  "triangle",
+		edges: [][2]int64{{0, 1}, {1, 2}, {2, 0}},
+		want:  []int64{0, 1, 2, 0},
+	},
+	{
+		name:  "tail",
+		edges: [][2]int64{{0, 1}, {1, 2}, {2, 3}, {3, 4}, {4, 2}},
+		want:  []int64{2, 3, 4, 2},
+	},
+	{
+		name:  "parallel",
+		edges: [][2]int64{{0, 1}, {1, 2}, {1, 2}},
+		want:  []int64{1, 2, 1},
+	},
+	{
+		name:  "self edge",
+		edges: [][2]int64{{0, 1}, {1, 1}},
+		want:  []int64{1, 1},
+	},
+	{
+		name:  "forest",
+		edges: [][2]int64{{0, 1}, {2, 3}, {3, 4}},
+		want:  nil,
+	},
+}
+
+func TestFindCycleUndirected(t *testing.T) {
+	for _, test := range findCycleUndirectedTests {
+		g := multi.NewUndirectedGraph()
+		for _, e := range test.edges {
+			g.SetLine(g.NewLine(multi.Node(e[0]), multi.Node(e[1])))
+		}
+		var got []int64
+		for _, n := range FindCycleUndirected(g) {
+			got = append(got, n.ID())
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("unexpected cycle for %q: got:%v want:%v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestFindCycleUndirectedRandom(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for trial := 0; trial < 50; trial++ {
+		const n = 20
+		g := simple.NewUndirectedGraph()
+		for i := 0; i < n; i++ {
+			g.AddNode(simple.Node(i))
+		}
+		for i := 0; i < n-3+trial%6; i++ {
+			u, v := rnd.IntN(n), rnd.IntN(n)
+			if u != v {
+				g.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+			}
+		}
+		// A graph is a forest if and only if it has
+		// one fewer edge than nodes in each component.
+		forest := g.Edges().Len() == n-len(ConnectedComponents(g))
+		cycle := FindCycleUndirected(g)
+		if (cycle == nil) != forest {
+			t.Errorf("unexpected cycle for graph %d: got:%v forest:%t", trial, cycle, forest)
+		}
+		if cycle != nil {
+			if len(cycle) < 4 {
+				t.Errorf("cycle for graph %d is too short: %v", trial, cycle)
+			}
+			checkCycle(t, "random", g, cycle)
+		}
+	}
+}
+
+// checkCycle checks that cycle is an elementary closed path in g.
+func checkCycle(t *testing.T, name string, g graph.Graph, cycle []graph.Node) {
+	t.Helper()
+	if len(cycle) < 2 || cycle[0].ID() != cycle[len(cycle)-1].ID() {
+		t.Errorf("cycle for %q is not closed: %v", name, cycle)
+	}
+	if !IsPathIn(g, cycle) {
+		t.Errorf("cycle for %q is not a path in the graph: %v", name, cycle)
+	}
+	seen := make(map[int64]bool)
+	for _, n := range cycle[1:] {
+		if seen[n.ID()] {
+			t.Errorf("cycle for %q is not elementary: %v", name, cycle)
+		}
+		seen[n.ID()] = true
+	}
+}
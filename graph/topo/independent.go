@@ -0,0 +1,173 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package topo
+
+import (
+	"slices"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+// MaximumIndependentSet returns a maximum independent set of the undirected
+// graph g, a largest set of nodes of g no two of which are joined by an edge.
+// The nodes of the returned set are sorted by ID. Self edges are ignored.
+//
+// An independent set of g is a clique of the complement of g, and the nodes
+// of g that are not in an independent set form a vertex cover of g, so the
+// complement of a maximum independent set is a minimum vertex cover.
+//
+// MaximumIndependentSet performs a branch and bound search with an initial
+// bound given by GreedyIndependentSet. In the worst case the time taken is
+// exponential in the number of nodes of g, so MaximumIndependentSet is suited
+// to small graphs; GreedyIndependentSet may be used for large graphs.
+func MaximumIndependentSet(g graph.Undirected) []graph.Node {
+	nodes, adj := indexedAdjacency(g)
+	m := misSearch{adj: adj, best: greedyIndependentSet(adj)}
+	cand := newBitset(len(nodes))
+	for i := range nodes {
+		cand.add(i)
+	}
+	m.search(cand)
+	return nodesAt(nodes, m.best)
+}
+
+// GreedyIndependentSet returns a maximal independent set of the undirected
+// graph g, a set of nodes of g no two of which are joined by an edge and to
+// which no other node of g can be added. The set is constructed by repeatedly
+// adding the node of least degree among the remaining nodes, with ties broken
+// by lowest ID, and removing it and its neighbours. The returned set is not
+// necessarily a maximum independent set. The nodes of the returned set are
+// sorted by ID. Self edges are ignored.
+func GreedyIndependentSet(g graph.Undirected) []graph.Node {
+	nodes, adj := indexedAdjacency(g)
+	return nodesAt(nodes, greedyIndependentSet(adj))
+}
+
+// indexedAdjacency returns the nodes of g sorted by ID and the adjacency
+// of each node, indexed by position in nodes, excluding self edges.
+func indexedAdjacency(g graph.Undirected) (nodes []graph.Node, adj []bitset) {
+	nodes = graph.NodesOf(g.Nodes())
+	order.ByID(nodes)
+	indexOf := make(map[int64]int, len(nodes))
+	for i, n := range nodes {
+		indexOf[n.ID()] = i
+	}
+	adj = make([]bitset, len(nodes))
+	for i, u := range nodes {
+		adj[i] = newBitset(len(nodes))
+		to := g.From(u.ID())
+		for to.Next() {
+			if j := indexOf[to.Node().ID()]; j != i {
+				adj[i].add(j)
+			}
+		}
+	}
+	return nodes, adj
+}
+
+// nodesAt returns the nodes at the given indices in ascending index order.
+func nodesAt(nodes []graph.Node, indices []int) []graph.Node {
+	slices.Sort(indices)
+	set := make([]graph.Node, len(indices))
+	for i, idx := range indices {
+		set[i] = nodes[idx]
+	}
+	return set
+}
+
+// greedyIndependentSet returns the indices of a maximal independent set of
+// the graph with the given adjacency, constructed in order of least degree.
+func greedyIndependentSet(adj []bitset) []int {
+	remaining := newBitset(len(adj))
+	for i := range adj {
+		remaining.add(i)
+	}
+	var set []int
+	for {
+		v := -1
+		var deg int
+		for u := remaining.next(0); u >= 0; u = remaining.next(u + 1) {
+			d := adj[u].intersectionCount(remaining)
+			if v < 0 || d < deg {
+				v = u
+				deg = d
+			}
+		}
+		if v < 0 {
+			return set
+		}
+		set = append(set, v)
+		remaining.remove(v)
+		remaining.difference(adj[v])
+	}
+}
+
+// misSearch is a branch and bound maximum independent
+// set search over the graph with adjacency adj.
+type misSearch struct {
+	adj []bitset
+
+	// cur is the independent set being
+	// extended and best is the largest
+	// independent set found so far.
+	cur  []int
+	best []int
+}
+
+// search extends the current independent set with the candidate nodes
+// in cand, none of which is adjacent to a member of the current set.
+func (m *misSearch) search(cand bitset) {
+	c := cand.count()
+	if len(m.cur)+c <= len(m.best) {
+		// The current set cannot be
+		// extended beyond the best.
+		return
+	}
+	if c == 0 {
+		m.best = append(m.best[:0], m.cur...)
+		return
+	}
+
+	minV, maxV := -1, -1
+	var minDeg, maxDeg int
+	for u := cand.next(0); u >= 0; u = cand.next(u + 1) {
+		d := m.adj[u].intersectionCount(cand)
+		if minV < 0 || d < minDeg {
+			minV, minDeg = u, d
+		}
+		if maxV < 0 || d > maxDeg {
+			maxV, maxDeg = u, d
+		}
+	}
+
+	if minDeg <= 1 {
+		// A node with at most one neighbour
+		// is in some maximum independent set
+		// of the candidates, so no branch is
+		// needed.
+		m.include(cand, minV)
+		return
+	}
+
+	// Branch on the node of greatest degree,
+	// first including it and then excluding
+	// it from the independent set.
+	m.include(cand, maxV)
+	cand = cand.clone()
+	cand.remove(maxV)
+	m.search(cand)
+}
+
+// include searches for extensions of the current independent set with v
+// added, using the candidates in cand that are not adjacent to v.
+func (m *misSearch) include(cand bitset, v int) {
+	next := cand.clone()
+	next.remove(v)
+	next.difference(m.adj[v])
+	m.cur = append(m.cur, v)
+	m.search(next)
+	m.cur = m.cur[:len(m.cur)-1]
+}
@@ -0,0 +1,222 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package topo
+
+import (
+	"math/bits"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/matching"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+var independentSetTests = []struct {
+	name string
+	g    []intset
+	want int
+}{
+	{
+		name: "empty",
+		g:    nil,
+		want: 0,
+	},
+	{
+		name: "isolated",
+		g: []intset{
+			0: nil,
+			1: nil,
+			2: nil,
+		},
+		want: 3,
+	},
+	{
+		name: "path",
+		g: []intset{
+			0: linksTo(1),
+			1: linksTo(2),
+			2: linksTo(3),
+			3: nil,
+		},
+		want: 2,
+	},
+	{
+		name: "pentagon",
+		g: []intset{
+			0: linksTo(1, 4),
+			1: linksTo(2),
+			2: linksTo(3),
+			3: linksTo(4),
+			4: nil,
+		},
+		want: 2,
+	},
+	{
+		name: "star",
+		g: []intset{
+			0: linksTo(1, 2, 3, 4),
+			1: nil,
+			2: nil,
+			3: nil,
+			4: nil,
+		},
+		want: 4,
+	},
+	{
+		name: "petersen",
+		g: []intset{
+			0: linksTo(1, 4, 5),
+			1: linksTo(2, 6),
+			2: linksTo(3, 7),
+			3: linksTo(4, 8),
+			4: linksTo(9),
+			5: linksTo(7, 8),
+			6: linksTo(8, 9),
+			7: linksTo(9),
+			8: nil,
+			9: nil,
+		},
+		want: 4,
+	},
+	{
+		name: "complete",
+		g: []intset{
+			0: linksTo(1, 2, 3),
+			1: linksTo(2, 3),
+			2: linksTo(3),
+			3: nil,
+		},
+		want: 1,
+	},
+}
+
+func TestMaximumIndependentSet(t *testing.T) {
+	for _, test := range independentSetTests {
+		g := simple.NewUndirectedGraph()
+		for u, e := range test.g {
+			// Add nodes that are not defined by an edge.
+			if g.Node(int64(u)) == nil {
+				g.AddNode(simple.Node(u))
+			}
+			for v := range e {
+				g.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+			}
+		}
+		got := MaximumIndependentSet(g)
+		if len(got) != test.want {
+			t.Errorf("unexpected independent set size for %q: got:%d want:%d", test.name, len(got), test.want)
+		}
+		checkIndependentSet(t, test.name, g, got, false)
+		checkIndependentSet(t, test.name, g, GreedyIndependentSet(g), true)
+	}
+}
+
+func TestMaximumIndependentSetBipartite(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for trial := 0; trial < 20; trial++ {
+		// By König's theorem the size of a maximum independent
+		// set of a bipartite graph is the number of nodes less
+		// the size of a maximum matching.
+		const n = 12
+		g := simple.NewUndirectedGraph()
+		for i := 0; i < 2*n; i++ {
+			g.AddNode(simple.Node(i))
+		}
+		for u := 0; u < n; u++ {
+			for v := n; v < 2*n; v++ {
+				if rnd.Float64() < 0.2 {
+					g.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+				}
+			}
+		}
+		got := MaximumIndependentSet(g)
+		want := 2*n - len(matching.MaximumMatching(g))
+		if len(got) != want {
+			t.Errorf("unexpected independent set size for bipartite graph %d: got:%d want:%d", trial, len(got), want)
+		}
+		checkIndependentSet(t, "bipartite", g, got, false)
+	}
+}
+
+func TestMaximumIndependentSetRandom(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for trial := 0; trial < 20; trial++ {
+		const n = 14
+		g := simple.NewUndirectedGraph()
+		for i := 0; i < n; i++ {
+			g.AddNode(simple.Node(i))
+		}
+		var adj [n]uint
+		for u := 0; u < n; u++ {
+			for v := u + 1; v < n; v++ {
+				if rnd.Float64() < 0.3 {
+					g.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+					adj[u] |= 1 << v
+					adj[v] |= 1 << u
+				}
+			}
+		}
+		var want int
+	subsets:
+		for s := uint(0); s < 1<<n; s++ {
+			for u := 0; u < n; u++ {
+				if s&(1<<u) != 0 && s&adj[u] != 0 {
+					continue subsets
+				}
+			}
+			want = max(want, bits.OnesCount(s))
+		}
+		got := MaximumIndependentSet(g)
+		if len(got) != want {
+			t.Errorf("unexpected independent set size for graph %d: got:%d want:%d", trial, len(got), want)
+		}
+		checkIndependentSet(t, "random", g, got, false)
+		greedy := GreedyIndependentSet(g)
+		if len(greedy) > want {
+			t.Errorf("greedy independent set larger than maximum for graph %d: got:%d want<=%d", trial, len(greedy), want)
+		}
+		checkIndependentSet(t, "random greedy", g, greedy, true)
+	}
+}
+
+// checkIndependentSet checks that set is an independent set of g sorted by ID,
+// and if maximal is true, that no node of g can be added to it.
+func checkIndependentSet(t *testing.T, name string, g graph.Undirected, set []graph.Node, maximal bool) {
+	t.Helper()
+	in := make(map[int64]bool)
+	for i, u := range set {
+		if i > 0 && set[i-1].ID() >= u.ID() {
+			t.Errorf("independent set for %q not sorted: %v", name, set)
+		}
+		in[u.ID()] = true
+	}
+	for _, u := range set {
+		to := g.From(u.ID())
+		for to.Next() {
+			if in[to.Node().ID()] {
+				t.Errorf("independent set for %q has adjacent nodes %d and %d", name, u.ID(), to.Node().ID())
+			}
+		}
+	}
+	if !maximal {
+		return
+	}
+	nodes := g.Nodes()
+outer:
+	for nodes.Next() {
+		u := nodes.Node()
+		if in[u.ID()] {
+			continue
+		}
+		to := g.From(u.ID())
+		for to.Next() {
+			if in[to.Node().ID()] {
+				continue outer
+			}
+		}
+		t.Errorf("independent set for %q is not maximal: %d can be added", name, u.ID())
+	}
+}
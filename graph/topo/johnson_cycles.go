@@ -24,16 +24,40 @@ type johnson struct {
 
 	stack []graph.Node
 
-	result [][]graph.Node
+	// fn is called with each circuit
+	// found. done is set when fn
+	// returns false.
+	fn   func([]graph.Node) bool
+	done bool
 }
 
 // DirectedCyclesIn returns the set of elementary cycles in the graph g.
 func DirectedCyclesIn(g graph.Directed) [][]graph.Node {
+	var cycles [][]graph.Node
+	DirectedCyclesInFunc(g, func(c []graph.Node) bool {
+		cycles = append(cycles, c)
+		return true
+	})
+	return cycles
+}
+
+// DirectedCyclesInFunc calls fn with each elementary cycle in the graph g,
+// stopping when fn returns false or all cycles have been found. The cycles
+// are found as they are by DirectedCyclesIn using Johnson's algorithm, but are
+// not retained. The first and last nodes of each cycle are the same, and the
+// cycle passed to fn may be retained by fn.
+//
+// The number of elementary cycles in a graph may be exponential in the number
+// of nodes. The time taken to find all C cycles of a graph with V nodes and E
+// edges is O((V+E)(C+1)), so fn returning false may be used to bound the work
+// done.
+func DirectedCyclesInFunc(g graph.Directed, fn func(cycle []graph.Node) bool) {
 	jg := johnsonGraphFrom(g)
 	j := johnson{
 		adjacent: jg,
 		b:        make([]set.Ints[int], len(jg.orig)),
 		blocked:  make([]bool, len(jg.orig)),
+		fn:       fn,
 	}
 
 	// len(j.nodes) is the order of g.
@@ -62,10 +86,11 @@ func DirectedCyclesIn(g graph.Directed) [][]graph.Node {
 		}
 		//L3:
 		_ = j.circuit(j.s)
+		if j.done {
+			return
+		}
 		j.s++
 	}
-
-	return j.result
 }
 
 // circuit is the CIRCUIT sub-procedure in the paper.
@@ -83,12 +108,19 @@ func (j *johnson) circuit(v int) bool {
 			r := make([]graph.Node, len(j.stack)+1)
 			copy(r, j.stack)
 			r[len(r)-1] = j.adjacent.orig[j.s]
-			j.result = append(j.result, r)
 			f = true
+			if !j.fn(r) {
+				// The search state is abandoned.
+				j.done = true
+				return f
+			}
 		} else if !j.blocked[w] {
 			if j.circuit(w) {
 				f = true
 			}
+			if j.done {
+				return f
+			}
 		}
 	}
 
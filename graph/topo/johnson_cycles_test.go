@@ -5,9 +5,11 @@
 package topo
 
 import (
+	"fmt"
 	"reflect"
 	"testing"
 
+	"gonum.org/v1/gonum/graph"
 	"gonum.org/v1/gonum/graph/simple"
 	"gonum.org/v1/gonum/internal/order"
 )
@@ -114,3 +116,58 @@ func TestDirectedCyclesIn(t *testing.T) {
 		}
 	}
 }
+
+func TestDirectedCyclesInFunc(t *testing.T) {
+	// The complete directed graph on n nodes has
+	// \sum_{k=2}^n C(n,k)(k-1)! elementary cycles.
+	for _, test := range []struct {
+		n    int
+		want int
+	}{
+		{n: 1, want: 0},
+		{n: 2, want: 1},
+		{n: 3, want: 3 + 2},
+		{n: 4, want: 6 + 4*2 + 6},
+		{n: 5, want: 10 + 10*2 + 5*6 + 24},
+		{n: 6, want: 15 + 20*2 + 15*6 + 6*24 + 120},
+	} {
+		g := simple.NewDirectedGraph()
+		for u := 0; u < test.n; u++ {
+			g.AddNode(simple.Node(u))
+		}
+		for u := 0; u < test.n; u++ {
+			for v := 0; v < test.n; v++ {
+				if u != v {
+					g.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+				}
+			}
+		}
+		seen := make(map[string]bool)
+		DirectedCyclesInFunc(g, func(c []graph.Node) bool {
+			if c[0].ID() != c[len(c)-1].ID() || !IsPathIn(g, c) {
+				t.Errorf("invalid cycle in K_%d: %v", test.n, c)
+			}
+			key := fmt.Sprint(c)
+			if seen[key] {
+				t.Errorf("cycle found twice in K_%d: %v", test.n, c)
+			}
+			seen[key] = true
+			return true
+		})
+		if len(seen) != test.want {
+			t.Errorf("unexpected number of cycles in K_%d: got:%d want:%d", test.n, len(seen), test.want)
+		}
+
+		if test.want < 4 {
+			continue
+		}
+		var n int
+		DirectedCyclesInFunc(g, func([]graph.Node) bool {
+			n++
+			return n < test.want/2
+		})
+		if n != test.want/2 {
+			t.Errorf("unexpected number of calls after stop in K_%d: got:%d want:%d", test.n, n, test.want/2)
+		}
+	}
+}
@@ -0,0 +1,178 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package topo
+
+import (
+	"container/heap"
+	"slices"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+// SortKahn performs a topological sort of the directed graph g using Kahn's
+// algorithm, returning the 'from' to 'to' sort order. Among the nodes that are
+// available to be placed at each step, the least node according to less is
+// placed first, so the returned order is the least topological ordering of g
+// lexicographically with respect to less. If less is nil, nodes are ordered by
+// ID. Self edges are ignored.
+//
+// SortKahn differs from SortStabilized in that the ordering function is used
+// to choose between all nodes whose predecessors have been placed, rather than
+// to order the depth first traversal of g.
+//
+// If a topological ordering is not possible, an Unorderable error is returned
+// listing cyclic components in g in topological order with each cyclic
+// component's members sorted by less, and sorted holds the nodes that could be
+// placed before reaching a cycle.
+func SortKahn(g graph.Directed, less func(a, b graph.Node) bool) (sorted []graph.Node, err error) {
+	if less == nil {
+		less = func(a, b graph.Node) bool { return a.ID() < b.ID() }
+	}
+
+	nodes := graph.NodesOf(g.Nodes())
+	inDegree := make(map[int64]int, len(nodes))
+	ready := &kahnQueue{less: less}
+	for _, n := range nodes {
+		id := n.ID()
+		to := g.To(id)
+		for to.Next() {
+			if to.Node().ID() != id {
+				inDegree[id]++
+			}
+		}
+		if inDegree[id] == 0 {
+			ready.nodes = append(ready.nodes, n)
+		}
+	}
+	heap.Init(ready)
+
+	sorted = make([]graph.Node, 0, len(nodes))
+	for ready.Len() != 0 {
+		u := heap.Pop(ready).(graph.Node)
+		sorted = append(sorted, u)
+		uid := u.ID()
+		from := g.From(uid)
+		for from.Next() {
+			v := from.Node()
+			vid := v.ID()
+			if vid == uid {
+				continue
+			}
+			inDegree[vid]--
+			if inDegree[vid] == 0 {
+				heap.Push(ready, v)
+			}
+		}
+	}
+	if len(sorted) == len(nodes) {
+		return sorted, nil
+	}
+
+	sortFn := func(nodes []graph.Node) { slices.SortFunc(nodes, byLess(less)) }
+	_, err = sortedFrom(tarjanSCCstabilized(g, sortFn), sortFn)
+	return sorted, err
+}
+
+// byLess returns a three-way comparison function for use with
+// slices.SortFunc corresponding to the less function.
+func byLess(less func(a, b graph.Node) bool) func(a, b graph.Node) int {
+	return func(a, b graph.Node) int {
+		switch {
+		case less(a, b):
+			return -1
+		case less(b, a):
+			return 1
+		default:
+			return 0
+		}
+	}
+}
+
+// kahnQueue is a priority queue of nodes ordered by less.
+type kahnQueue struct {
+	less  func(a, b graph.Node) bool
+	nodes []graph.Node
+}
+
+func (q *kahnQueue) Len() int           { return len(q.nodes) }
+func (q *kahnQueue) Less(i, j int) bool { return q.less(q.nodes[i], q.nodes[j]) }
+func (q *kahnQueue) Swap(i, j int)      { q.nodes[i], q.nodes[j] = q.nodes[j], q.nodes[i] }
+func (q *kahnQueue) Push(x interface{}) { q.nodes = append(q.nodes, x.(graph.Node)) }
+func (q *kahnQueue) Pop() interface{} {
+	n := q.nodes[len(q.nodes)-1]
+	q.nodes = q.nodes[:len(q.nodes)-1]
+	return n
+}
+
+// AllTopologicalSorts calls fn with each topological ordering of the directed
+// graph g, in lexical order of node IDs, stopping when fn returns false or all
+// orderings have been visited. Self edges are ignored. The slice passed to fn
+// is reused between calls and must not be retained or modified by fn.
+//
+// The number of topological orderings may grow factorially with the number of
+// nodes in g; a graph with n nodes and no edges has n! orderings. fn returning
+// false may be used to end the enumeration early.
+//
+// If g is not acyclic, fn is not called and an Unorderable error is returned
+// as it would be by Sort.
+func AllTopologicalSorts(g graph.Directed, fn func(order []graph.Node) bool) error {
+	if _, err := Sort(g); err != nil {
+		return err
+	}
+
+	nodes := graph.NodesOf(g.Nodes())
+	order.ByID(nodes)
+	indexOf := make(map[int64]int, len(nodes))
+	for i, n := range nodes {
+		indexOf[n.ID()] = i
+	}
+	succ := make([][]int, len(nodes))
+	inDegree := make([]int, len(nodes))
+	for i, u := range nodes {
+		uid := u.ID()
+		from := g.From(uid)
+		for from.Next() {
+			vid := from.Node().ID()
+			if vid == uid {
+				continue
+			}
+			j := indexOf[vid]
+			succ[i] = append(succ[i], j)
+			inDegree[j]++
+		}
+	}
+
+	sorted := make([]graph.Node, 0, len(nodes))
+	placed := make([]bool, len(nodes))
+	var visit func() bool
+	visit = func() bool {
+		if len(sorted) == len(nodes) {
+			return fn(sorted)
+		}
+		for i, n := range nodes {
+			if placed[i] || inDegree[i] != 0 {
+				continue
+			}
+			placed[i] = true
+			for _, j := range succ[i] {
+				inDegree[j]--
+			}
+			sorted = append(sorted, n)
+			ok := visit()
+			sorted = sorted[:len(sorted)-1]
+			for _, j := range succ[i] {
+				inDegree[j]++
+			}
+			placed[i] = false
+			if !ok {
+				return false
+			}
+		}
+		return true
+	}
+	visit()
+	return nil
+}
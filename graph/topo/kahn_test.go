@@ -0,0 +1,298 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package topo
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/multi"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+var sortKahnTests = []struct {
+	g    []intset
+	less func(a, b graph.Node) bool
+
+	want []int64
+	err  [][]int64
+}{
+	{
+		g: []intset{
+			0: linksTo(3),
+			1: linksTo(3),
+			2: linksTo(0, 1),
+			3: nil,
+			4: nil,
+		},
+		want: []int64{2, 0, 1, 3, 4},
+	},
+	{
+		g: []intset{
+			0: linksTo(3),
+			1: linksTo(3),
+			2: linksTo(0, 1),
+			3: nil,
+			4: nil,
+		},
+		less: func(a, b graph.Node) bool { return a.ID() > b.ID() },
+		want: []int64{4, 2, 1, 0, 3},
+	},
+	{
+		// Self edges do not prevent ordering.
+		g: []intset{
+			0: linksTo(0, 1),
+			1: linksTo(1),
+		},
+		want: []int64{0, 1},
+	},
+	{
+		g: []intset{
+			0: linksTo(1),
+			1: linksTo(2, 7),
+			2: linksTo(3, 6),
+			3: linksTo(4),
+			4: linksTo(2, 5),
+			6: linksTo(3, 5),
+			7: linksTo(0, 6),
+			8: linksTo(0),
+		},
+		want: []int64{8},
+		err: [][]int64{
+			{0, 1, 7},
+			{2, 3, 4, 6},
+		},
+	},
+	{
+		g: []intset{
+			0: linksTo(1),
+			1: linksTo(0, 2),
+			2: linksTo(1),
+			3: linksTo(1),
+		},
+		less: func(a, b graph.Node) bool { return a.ID() > b.ID() },
+		want: []int64{3},
+		err: [][]int64{
+			{2, 1, 0},
+		},
+	},
+}
+
+func TestSortKahn(t *testing.T) {
+	for i, test := range sortKahnTests {
+		// A multigraph is used to allow self edges.
+		g := multi.NewDirectedGraph()
+		for u, e := range test.g {
+			// Add nodes that are not defined by an edge.
+			if g.Node(int64(u)) == nil {
+				g.AddNode(multi.Node(u))
+			}
+			for v := range e {
+				g.SetLine(g.NewLine(multi.Node(u), multi.Node(v)))
+			}
+		}
+		sorted, err := SortKahn(g, test.less)
+		var got []int64
+		for _, n := range sorted {
+			got = append(got, n.ID())
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("unexpected sort result for test %d: got:%d want:%d", i, got, test.want)
+		}
+		var gotErr [][]int64
+		if err != nil {
+			for _, c := range err.(Unorderable) {
+				var ids []int64
+				for _, n := range c {
+					ids = append(ids, n.ID())
+				}
+				gotErr = append(gotErr, ids)
+			}
+		}
+		if !reflect.DeepEqual(gotErr, test.err) {
+			t.Errorf("unexpected sort error for test %d: got:%v want:%v", i, gotErr, test.err)
+		}
+	}
+}
+
+func TestSortKahnDeterministic(t *testing.T) {
+	g := simple.NewDirectedGraph()
+	for u, e := range batageljZaversnikGraph {
+		if g.Node(int64(u)) == nil {
+			g.AddNode(simple.Node(u))
+		}
+		for v := range e {
+			g.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+		}
+	}
+	want, err := SortKahn(g, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	pos := make(map[int64]int)
+	for i, n := range want {
+		pos[n.ID()] = i
+	}
+	edges := g.Edges()
+	for edges.Next() {
+		e := edges.Edge()
+		if pos[e.From().ID()] >= pos[e.To().ID()] {
+			t.Errorf("edge %d->%d out of order", e.From().ID(), e.To().ID())
+		}
+	}
+	for i := 0; i < 10; i++ {
+		got, _ := SortKahn(g, nil)
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("sort not deterministic: got:%d want:%d", got, want)
+		}
+	}
+}
+
+var allTopologicalSortsTests = []struct {
+	g    []intset
+	want [][]int64
+	err  bool
+}{
+	{
+		g:    []intset{},
+		want: [][]int64{nil},
+	},
+	{
+		g: []intset{
+			0: nil,
+			1: nil,
+			2: nil,
+		},
+		want: [][]int64{
+			{0, 1, 2}, {0, 2, 1},
+			{1, 0, 2}, {1, 2, 0},
+			{2, 0, 1}, {2, 1, 0},
+		},
+	},
+	{
+		g: []intset{
+			0: linksTo(1, 2),
+			1: linksTo(3),
+			2: linksTo(3),
+			3: nil,
+		},
+		want: [][]int64{
+			{0, 1, 2, 3},
+			{0, 2, 1, 3},
+		},
+	},
+	{
+		// Self edges are ignored.
+		g: []intset{
+			0: linksTo(0, 1),
+			1: nil,
+		},
+		want: [][]int64{
+			{0, 1},
+		},
+	},
+	{
+		g: []intset{
+			0: linksTo(1),
+			1: linksTo(2),
+			2: linksTo(0),
+			3: nil,
+		},
+		err: true,
+	},
+}
+
+func TestAllTopologicalSorts(t *testing.T) {
+	for i, test := range allTopologicalSortsTests {
+		g := multi.NewDirectedGraph()
+		for u, e := range test.g {
+			// Add nodes that are not defined by an edge.
+			if g.Node(int64(u)) == nil {
+				g.AddNode(multi.Node(u))
+			}
+			for v := range e {
+				g.SetLine(g.NewLine(multi.Node(u), multi.Node(v)))
+			}
+		}
+		var got [][]int64
+		err := AllTopologicalSorts(g, func(order []graph.Node) bool {
+			var ids []int64
+			for _, n := range order {
+				ids = append(ids, n.ID())
+			}
+			got = append(got, ids)
+			return true
+		})
+		if (err != nil) != test.err {
+			t.Errorf("unexpected error for test %d: %v", i, err)
+		}
+		if _, ok := err.(Unorderable); err != nil && !ok {
+			t.Errorf("unexpected error type for test %d: %T", i, err)
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("unexpected orderings for test %d: got:%d want:%d", i, got, test.want)
+		}
+	}
+}
+
+func TestAllTopologicalSortsEarlyStop(t *testing.T) {
+	g := simple.NewDirectedGraph()
+	for i := 0; i < 10; i++ {
+		g.AddNode(simple.Node(i))
+	}
+	var n int
+	err := AllTopologicalSorts(g, func([]graph.Node) bool {
+		n++
+		return n < 5
+	})
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if n != 5 {
+		t.Errorf("unexpected number of calls: got:%d want:5", n)
+	}
+}
+
+func TestAllTopologicalSortsValid(t *testing.T) {
+	g := simple.NewDirectedGraph()
+	for u, e := range batageljZaversnikGraph[:12] {
+		if g.Node(int64(u)) == nil {
+			g.AddNode(simple.Node(u))
+		}
+		for v := range e {
+			g.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+		}
+	}
+	var count int
+	seen := make(map[string]bool)
+	err := AllTopologicalSorts(g, func(order []graph.Node) bool {
+		count++
+		pos := make(map[int64]int)
+		for i, n := range order {
+			pos[n.ID()] = i
+		}
+		edges := g.Edges()
+		for edges.Next() {
+			e := edges.Edge()
+			if pos[e.From().ID()] >= pos[e.To().ID()] {
+				t.Fatalf("edge %d->%d out of order in %d", e.From().ID(), e.To().ID(), order)
+			}
+		}
+		key := fmt.Sprint(order)
+		if seen[key] {
+			t.Fatalf("ordering visited twice: %d", order)
+		}
+		seen[key] = true
+		return count < 10000
+	})
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if count == 0 {
+		t.Error("no orderings visited")
+	}
+}
@@ -42,6 +42,56 @@ func Sort(g graph.Directed) (sorted []graph.Node, err error) {
 	return sortedFrom(sccs, lexical)
 }
 
+// SortWithCycle performs a topological sort of the directed graph g as Sort does,
+// additionally returning a concrete cycle in g when a topological ordering is not
+// possible. The returned cycle is a shortest cycle through the lowest ID node of the
+// first cyclic component in the topological order of the components of g, and has the
+// same first and last node. If g is acyclic, cycle is nil.
+func SortWithCycle(g graph.Directed) (sorted, cycle []graph.Node, err error) {
+	sorted, err = Sort(g)
+	if err == nil {
+		return sorted, nil, nil
+	}
+	return sorted, shortestCycleIn(g, err.(Unorderable)[0]), err
+}
+
+// shortestCycleIn returns a shortest cycle in g through the first node of the
+// strongly connected component scc, using only nodes within scc. The first and
+// last nodes of the returned cycle are the same.
+func shortestCycleIn(g graph.Directed, scc []graph.Node) []graph.Node {
+	in := make(set.Ints[int64], len(scc))
+	for _, n := range scc {
+		in.Add(n.ID())
+	}
+	start := scc[0]
+	sid := start.ID()
+	prev := map[int64]graph.Node{sid: nil}
+	queue := []graph.Node{start}
+	for len(queue) != 0 {
+		u := queue[0]
+		queue = queue[1:]
+		to := graph.NodesOf(g.From(u.ID()))
+		order.ByID(to)
+		for _, v := range to {
+			vid := v.ID()
+			if vid == sid {
+				cycle := []graph.Node{start}
+				for n := u; n != nil; n = prev[n.ID()] {
+					cycle = append(cycle, n)
+				}
+				slices.Reverse(cycle)
+				return cycle
+			}
+			if _, seen := prev[vid]; seen || !in.Has(vid) {
+				continue
+			}
+			prev[vid] = u
+			queue = append(queue, v)
+		}
+	}
+	panic("topo: no cycle in strongly connected component")
+}
+
 // SortStabilized performs a topological sort of the directed graph g returning the 'from'
 // to 'to' sort order, or the order defined by the in place order sort function where there
 // is no unambiguous topological ordering. If a topological ordering is not possible, an
@@ -90,6 +140,24 @@ func TarjanSCC(g graph.Directed) [][]graph.Node {
 	return tarjanSCCstabilized(g, nil)
 }
 
+// StrongComponentLabels returns a labeling of the nodes of the directed graph g
+// by strongly connected component. Labels are dense in [0, k) for a graph with k
+// strongly connected components, and are assigned in a topological order of the
+// components, so every edge between components is from a lower label to a higher
+// label. The labeling is deterministic for a given graph.
+func StrongComponentLabels(g graph.Directed) map[int64]int {
+	sccs := tarjanSCCstabilized(g, lexical)
+	labels := make(map[int64]int)
+	for i, c := range sccs {
+		// Tarjan's algorithm returns components
+		// in reverse topological order.
+		for _, n := range c {
+			labels[n.ID()] = len(sccs) - 1 - i
+		}
+	}
+	return labels
+}
+
 func tarjanSCCstabilized(g graph.Directed, order func([]graph.Node)) [][]graph.Node {
 	nodes := graph.NodesOf(g.Nodes())
 	var succ func(id int64) []graph.Node
@@ -186,3 +254,90 @@ func (t *tarjan) strongconnect(v graph.Node) {
 		t.sccs = append(t.sccs, scc)
 	}
 }
+
+// TarjanSCCFunc calls fn with each strongly connected component of the graph g,
+// found using Tarjan's algorithm, in the order TarjanSCC would return them. This is
+// a reverse topological order of the components. If fn returns false, the search
+// is terminated. The component passed to fn is not retained by TarjanSCCFunc and
+// so it may be kept or mutated by fn.
+//
+// Unlike TarjanSCC, the depth-first search performed by TarjanSCCFunc uses an
+// explicit stack rather than recursion and the complete set of components is not
+// held, so TarjanSCCFunc is suitable for very large or very deep graphs.
+func TarjanSCCFunc(g graph.Directed, fn func(component []graph.Node) bool) {
+	nodes := graph.NodesOf(g.Nodes())
+	t := tarjan{
+		indexTable: make(map[int64]int, len(nodes)),
+		lowLink:    make(map[int64]int, len(nodes)),
+		onStack:    make(set.Ints[int64]),
+	}
+
+	// tarjanFrame is a suspended call of
+	// strongconnect on the node v, with
+	// to holding the unvisited successors
+	// of v.
+	type tarjanFrame struct {
+		v  graph.Node
+		to graph.Nodes
+	}
+	var frames []tarjanFrame
+	visit := func(v graph.Node) {
+		vID := v.ID()
+		t.index++
+		t.indexTable[vID] = t.index
+		t.lowLink[vID] = t.index
+		t.stack = append(t.stack, v)
+		t.onStack.Add(vID)
+		frames = append(frames, tarjanFrame{v: v, to: g.From(vID)})
+	}
+
+	for _, u := range nodes {
+		if t.indexTable[u.ID()] != 0 {
+			continue
+		}
+		visit(u)
+		for len(frames) != 0 {
+			f := &frames[len(frames)-1]
+			vID := f.v.ID()
+			if f.to.Next() {
+				w := f.to.Node()
+				wID := w.ID()
+				if t.indexTable[wID] == 0 {
+					// Successor w has not yet been visited; descend into it.
+					visit(w)
+				} else if t.onStack.Has(wID) {
+					// Successor w is in stack s and hence in the current SCC.
+					t.lowLink[vID] = min(t.lowLink[vID], t.indexTable[wID])
+				}
+				continue
+			}
+
+			// All successors of v have been considered.
+			frames = frames[:len(frames)-1]
+			if len(frames) != 0 {
+				pID := frames[len(frames)-1].v.ID()
+				t.lowLink[pID] = min(t.lowLink[pID], t.lowLink[vID])
+			}
+			if t.lowLink[vID] != t.indexTable[vID] {
+				continue
+			}
+
+			// v is a root node, so pop the stack and generate an SCC.
+			var (
+				scc []graph.Node
+				w   graph.Node
+			)
+			for {
+				w, t.stack = t.stack[len(t.stack)-1], t.stack[:len(t.stack)-1]
+				t.onStack.Remove(w.ID())
+				scc = append(scc, w)
+				if w.ID() == vID {
+					break
+				}
+			}
+			if !fn(scc) {
+				return
+			}
+		}
+	}
+}
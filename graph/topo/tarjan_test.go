@@ -306,3 +306,225 @@ func TestSortStabilized(t *testing.T) {
 		}
 	}
 }
+
+func TestTarjanSCCFunc(t *testing.T) {
+	for i, test := range tarjanTests {
+		g := simple.NewDirectedGraph()
+		for u, e := range test.g {
+			// Add nodes that are not defined by an edge.
+			if g.Node(int64(u)) == nil {
+				g.AddNode(simple.Node(u))
+			}
+			for v := range e {
+				g.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+			}
+		}
+		var gotIDs [][]int64
+		TarjanSCCFunc(g, func(scc []graph.Node) bool {
+			ids := make([]int64, len(scc))
+			for j, n := range scc {
+				ids[j] = n.ID()
+			}
+			slices.Sort(ids)
+			gotIDs = append(gotIDs, ids)
+			return true
+		})
+		for _, iv := range test.ambiguousOrder {
+			order.BySliceValues(test.want[iv.start:iv.end])
+			order.BySliceValues(gotIDs[iv.start:iv.end])
+		}
+		if !reflect.DeepEqual(gotIDs, test.want) {
+			t.Errorf("unexpected Tarjan scc result for %d:\n\tgot:%v\n\twant:%v", i, gotIDs, test.want)
+		}
+
+		var n int
+		TarjanSCCFunc(g, func([]graph.Node) bool {
+			n++
+			return false
+		})
+		if n != 1 {
+			t.Errorf("unexpected number of calls after early termination for %d: got:%d want:1", i, n)
+		}
+	}
+}
+
+func TestTarjanSCCFuncDeep(t *testing.T) {
+	// A long cycle with a long tail would require a
+	// very deep recursion in a recursive implementation.
+	const n = 100000
+	g := simple.NewDirectedGraph()
+	for i := 0; i < n-1; i++ {
+		g.SetEdge(simple.Edge{F: simple.Node(i), T: simple.Node(i + 1)})
+	}
+	g.SetEdge(simple.Edge{F: simple.Node(n - 1), T: simple.Node(n / 2)})
+
+	var sizes []int
+	TarjanSCCFunc(g, func(scc []graph.Node) bool {
+		sizes = append(sizes, len(scc))
+		return true
+	})
+	if len(sizes) != n/2+1 {
+		t.Fatalf("unexpected number of components: got:%d want:%d", len(sizes), n/2+1)
+	}
+	if sizes[0] != n/2 {
+		t.Errorf("unexpected size of first component: got:%d want:%d", sizes[0], n/2)
+	}
+}
+
+var sortWithCycleTests = []struct {
+	g    []intset
+	want []int64
+}{
+	{
+		g: []intset{
+			0: linksTo(1),
+			1: linksTo(2),
+			2: nil,
+		},
+		want: nil,
+	},
+	{
+		g: []intset{
+			0: linksTo(1),
+			1: linksTo(2, 7),
+			2: linksTo(3, 6),
+			3: linksTo(4),
+			4: linksTo(2, 5),
+			6: linksTo(3, 5),
+			7: linksTo(0, 6),
+		},
+		want: []int64{0, 1, 7, 0},
+	},
+	{
+		g: []intset{
+			0: linksTo(1, 2, 3),
+			1: linksTo(2),
+			2: linksTo(3),
+			3: linksTo(1),
+		},
+		want: []int64{1, 2, 3, 1},
+	},
+	{
+		g: []intset{
+			0: linksTo(1, 4),
+			1: linksTo(2),
+			2: linksTo(3),
+			3: linksTo(0),
+			4: linksTo(3),
+		},
+		want: []int64{0, 4, 3, 0},
+	},
+}
+
+func TestSortWithCycle(t *testing.T) {
+	for i, test := range sortWithCycleTests {
+		g := simple.NewDirectedGraph()
+		for u, e := range test.g {
+			// Add nodes that are not defined by an edge.
+			if g.Node(int64(u)) == nil {
+				g.AddNode(simple.Node(u))
+			}
+			for v := range e {
+				g.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+			}
+		}
+		sorted, cycle, err := SortWithCycle(g)
+		wantSorted, wantErr := Sort(g)
+		if !reflect.DeepEqual(sorted, wantSorted) || !reflect.DeepEqual(err, wantErr) {
+			t.Errorf("unexpected sort result for test %d: got:%v %v want:%v %v", i, sorted, err, wantSorted, wantErr)
+		}
+		var got []int64
+		for _, n := range cycle {
+			got = append(got, n.ID())
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("unexpected cycle for test %d: got:%d want:%d", i, got, test.want)
+		}
+		if cycle != nil && !IsPathIn(g, cycle) {
+			t.Errorf("cycle for test %d is not a path in the graph: %d", i, got)
+		}
+	}
+}
+
+func TestSortWithCycleValid(t *testing.T) {
+	for i, test := range tarjanTests {
+		g := simple.NewDirectedGraph()
+		for u, e := range test.g {
+			// Add nodes that are not defined by an edge.
+			if g.Node(int64(u)) == nil {
+				g.AddNode(simple.Node(u))
+			}
+			for v := range e {
+				g.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+			}
+		}
+		_, cycle, err := SortWithCycle(g)
+		if (err == nil) != (cycle == nil) {
+			t.Errorf("unexpected cycle for test %d: got:%v with error:%v", i, cycle, err)
+			continue
+		}
+		if cycle == nil {
+			continue
+		}
+		if len(cycle) < 3 || cycle[0].ID() != cycle[len(cycle)-1].ID() {
+			t.Errorf("cycle for test %d is not closed: %v", i, cycle)
+		}
+		if !IsPathIn(g, cycle) {
+			t.Errorf("cycle for test %d is not a path in the graph: %v", i, cycle)
+		}
+		seen := make(map[int64]bool)
+		for _, n := range cycle[1:] {
+			if seen[n.ID()] {
+				t.Errorf("cycle for test %d is not elementary: %v", i, cycle)
+			}
+			seen[n.ID()] = true
+		}
+	}
+}
+
+func TestStrongComponentLabels(t *testing.T) {
+	for i, test := range tarjanTests {
+		g := simple.NewDirectedGraph()
+		for u, e := range test.g {
+			// Add nodes that are not defined by an edge.
+			if g.Node(int64(u)) == nil {
+				g.AddNode(simple.Node(u))
+			}
+			for v := range e {
+				g.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+			}
+		}
+		labels := StrongComponentLabels(g)
+		if len(labels) != g.Nodes().Len() {
+			t.Errorf("unexpected number of labels for test %d: got:%d want:%d", i, len(labels), g.Nodes().Len())
+		}
+
+		sccs := TarjanSCC(g)
+		seen := make(map[int]bool)
+		for _, c := range sccs {
+			label := labels[c[0].ID()]
+			if seen[label] {
+				t.Errorf("label %d used for more than one component in test %d", label, i)
+			}
+			seen[label] = true
+			for _, n := range c {
+				if labels[n.ID()] != label {
+					t.Errorf("inconsistent label within component for test %d: %v", i, c)
+				}
+			}
+		}
+		for label := range seen {
+			if label < 0 || label >= len(sccs) {
+				t.Errorf("label %d out of range for test %d", label, i)
+			}
+		}
+		for _, e := range graph.EdgesOf(g.Edges()) {
+			if labels[e.From().ID()] > labels[e.To().ID()] {
+				t.Errorf("edge %d->%d from higher label to lower for test %d", e.From().ID(), e.To().ID(), i)
+			}
+		}
+		if again := StrongComponentLabels(g); !reflect.DeepEqual(again, labels) {
+			t.Errorf("labels not deterministic for test %d", i)
+		}
+	}
+}
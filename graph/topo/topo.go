@@ -42,7 +42,7 @@ func IsPathIn(g graph.Graph, path []graph.Node) bool {
 // to 'to'.
 //
 // PathExistsIn exists as a helper function. If many tests for path existence
-// are being performed, other approaches will be more efficient.
+// are being performed on a directed graph, NewReachability will be more efficient.
 func PathExistsIn(g graph.Graph, from, to graph.Node) bool {
 	var t traverse.BreadthFirst
 	return t.Walk(g, from, func(n graph.Node, _ int) bool { return n.ID() == to.ID() }) != nil
@@ -68,6 +68,30 @@ func ConnectedComponents(g graph.Undirected) [][]graph.Node {
 	return cc
 }
 
+// ComponentLabels returns a labeling of the nodes of the undirected graph g by
+// connected component. Labels are dense in [0, k) for a graph with k connected
+// components, and are assigned in order of the lowest node ID in each component.
+// graph.Undirect may be used to label the weakly connected components of a
+// directed graph.
+func ComponentLabels(g graph.Undirected) map[int64]int {
+	nodes := graph.NodesOf(g.Nodes())
+	order.ByID(nodes)
+	labels := make(map[int64]int, len(nodes))
+	var (
+		w     traverse.BreadthFirst
+		label int
+	)
+	w.Visit = func(n graph.Node) { labels[n.ID()] = label }
+	for _, n := range nodes {
+		if w.Visited(n) {
+			continue
+		}
+		w.Walk(g, n, nil)
+		label++
+	}
+	return labels
+}
+
 // Equal returns whether two graphs are topologically equal. To be
 // considered topologically equal, a and b must have identical sets
 // of nodes and be identically traversable.
@@ -174,6 +174,36 @@ func TestConnectedComponents(t *testing.T) {
 	}
 }
 
+func TestComponentLabels(t *testing.T) {
+	for i, test := range connectedComponentTests {
+		g := simple.NewUndirectedGraph()
+
+		for u, e := range test.g {
+			if g.Node(int64(u)) == nil {
+				g.AddNode(simple.Node(u))
+			}
+			for v := range e {
+				if g.Node(v) == nil {
+					g.AddNode(simple.Node(v))
+				}
+				g.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+			}
+		}
+		// The components of test.want are sorted by
+		// their lowest ID, so are indexed by label.
+		want := make(map[int64]int)
+		for label, c := range test.want {
+			for _, id := range c {
+				want[id] = label
+			}
+		}
+		got := ComponentLabels(g)
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("unexpected component labels for test %d:\ngot: %v\nwant:%v", i, got, want)
+		}
+	}
+}
+
 var equalTests = []struct {
 	name string
 	a, b graph.Graph
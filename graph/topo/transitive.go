@@ -0,0 +1,131 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package topo
+
+import (
+	"slices"
+
+	"gonum.org/v1/gonum/graph"
+)
+
+// TransitiveReduction builds the transitive reduction of the directed acyclic
+// graph g in dst. The transitive reduction is the graph with the fewest edges
+// that has the same reachability relation as g. All nodes of g are added to dst
+// and an edge of g from u to v is added to dst unless v is reachable from u by
+// another path in g. Self edges are not added to dst. The dst graph is not
+// cleared.
+//
+// The transitive reduction is only unique for acyclic graphs. If g is not
+// acyclic, dst is not altered and an Unorderable error is returned as it
+// would be by Sort.
+func TransitiveReduction(dst Builder, g graph.Directed) error {
+	sorted, err := Sort(g)
+	if err != nil {
+		return err
+	}
+	indexOf := make(map[int64]int, len(sorted))
+	for i, n := range sorted {
+		indexOf[n.ID()] = i
+	}
+
+	// reach[i] holds the descendants of sorted[i].
+	// Filling reach in reverse topological order
+	// ensures the descendants of each successor
+	// are complete before they are needed.
+	reach := make([]bitset, len(sorted))
+	edges := make([][2]int, 0, len(sorted))
+	var succ []int
+	for i := len(sorted) - 1; i >= 0; i-- {
+		uid := sorted[i].ID()
+		succ = succ[:0]
+		from := g.From(uid)
+		for from.Next() {
+			if j := indexOf[from.Node().ID()]; j != i {
+				succ = append(succ, j)
+			}
+		}
+		// A successor reachable through another
+		// successor must follow it topologically,
+		// so visiting successors in topological
+		// order finds indirect paths first.
+		slices.Sort(succ)
+		reach[i] = newBitset(len(sorted))
+		for _, j := range succ {
+			if reach[i].has(j) {
+				continue
+			}
+			edges = append(edges, [2]int{i, j})
+			reach[i].add(j)
+			reach[i].union(reach[j])
+		}
+	}
+
+	for _, n := range sorted {
+		dst.AddNode(n)
+	}
+	for _, e := range edges {
+		dst.SetEdge(g.Edge(sorted[e[0]].ID(), sorted[e[1]].ID()))
+	}
+	return nil
+}
+
+// Reachability holds the transitive closure of a directed graph for answering
+// reachability queries in constant time.
+type Reachability struct {
+	compOf map[int64]int
+	reach  []bitset
+}
+
+// NewReachability returns the reachability relation of the directed graph g.
+// The closure is computed over the strongly connected components of g in
+// reverse topological order, so it is valid for cyclic graphs. For a graph
+// with V nodes the returned Reachability holds up to V² bits, one for each
+// pair of strongly connected components in g.
+func NewReachability(g graph.Directed) *Reachability {
+	// TarjanSCC returns components in reverse
+	// topological order, so the closure of
+	// each successor component is complete
+	// before it is used.
+	sccs := TarjanSCC(g)
+	r := Reachability{
+		compOf: make(map[int64]int),
+		reach:  make([]bitset, len(sccs)),
+	}
+	for i, c := range sccs {
+		for _, n := range c {
+			r.compOf[n.ID()] = i
+		}
+	}
+	for i, c := range sccs {
+		r.reach[i] = newBitset(len(sccs))
+		r.reach[i].add(i)
+		for _, u := range c {
+			from := g.From(u.ID())
+			for from.Next() {
+				j := r.compOf[from.Node().ID()]
+				if r.reach[i].has(j) {
+					continue
+				}
+				r.reach[i].union(r.reach[j])
+			}
+		}
+	}
+	return &r
+}
+
+// CanReach returns whether there is a path in the graph from the node with ID
+// uid to the node with ID vid. Every node in the graph can reach itself. If
+// either node is not in the graph, CanReach returns false.
+func (r *Reachability) CanReach(uid, vid int64) bool {
+	u, ok := r.compOf[uid]
+	if !ok {
+		return false
+	}
+	v, ok := r.compOf[vid]
+	if !ok {
+		return false
+	}
+	return r.reach[u].has(v)
+}
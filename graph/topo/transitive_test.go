@@ -0,0 +1,176 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package topo
+
+import (
+	"cmp"
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+var transitiveReductionTests = []struct {
+	g    []intset
+	want [][2]int64
+	err  bool
+}{
+	{
+		g: []intset{
+			0: linksTo(1, 2, 3),
+			1: linksTo(2, 3),
+			2: linksTo(3),
+			3: nil,
+		},
+		want: [][2]int64{{0, 1}, {1, 2}, {2, 3}},
+	},
+	{
+		g: []intset{
+			0: linksTo(1, 2, 3),
+			1: linksTo(3),
+			2: linksTo(3),
+			3: nil,
+			4: nil,
+		},
+		want: [][2]int64{{0, 1}, {0, 2}, {1, 3}, {2, 3}},
+	},
+	{
+		g: []intset{
+			0: linksTo(1, 4),
+			1: linksTo(2),
+			2: linksTo(3),
+			3: nil,
+			4: linksTo(3),
+		},
+		want: [][2]int64{{0, 1}, {0, 4}, {1, 2}, {2, 3}, {4, 3}},
+	},
+	{
+		g: []intset{
+			0: linksTo(1),
+			1: linksTo(2),
+			2: linksTo(0),
+		},
+		err: true,
+	},
+}
+
+func TestTransitiveReduction(t *testing.T) {
+	for i, test := range transitiveReductionTests {
+		g := simple.NewDirectedGraph()
+		for u, e := range test.g {
+			// Add nodes that are not defined by an edge.
+			if g.Node(int64(u)) == nil {
+				g.AddNode(simple.Node(u))
+			}
+			for v := range e {
+				g.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+			}
+		}
+		dst := simple.NewDirectedGraph()
+		err := TransitiveReduction(dst, g)
+		if (err != nil) != test.err {
+			t.Errorf("unexpected error for test %d: %v", i, err)
+		}
+		if err != nil {
+			if dst.Nodes().Len() != 0 {
+				t.Errorf("unexpected modification of dst for test %d", i)
+			}
+			continue
+		}
+		if dst.Nodes().Len() != g.Nodes().Len() {
+			t.Errorf("unexpected number of nodes for test %d: got:%d want:%d", i, dst.Nodes().Len(), g.Nodes().Len())
+		}
+		got := edgeIDs(dst)
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("unexpected reduction for test %d: got:%v want:%v", i, got, test.want)
+		}
+	}
+}
+
+func TestTransitiveReductionRandom(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for trial := 0; trial < 20; trial++ {
+		g := simple.NewDirectedGraph()
+		const n = 20
+		for i := 0; i < n; i++ {
+			g.AddNode(simple.Node(i))
+		}
+		for u := 0; u < n; u++ {
+			for v := u + 1; v < n; v++ {
+				if rnd.Float64() < 0.3 {
+					g.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+				}
+			}
+		}
+		dst := simple.NewDirectedGraph()
+		err := TransitiveReduction(dst, g)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		for u := int64(0); u < n; u++ {
+			for v := int64(0); v < n; v++ {
+				if PathExistsIn(g, simple.Node(u), simple.Node(v)) != PathExistsIn(dst, simple.Node(u), simple.Node(v)) {
+					t.Errorf("reachability from %d to %d not preserved", u, v)
+				}
+			}
+		}
+		for _, e := range edgeIDs(dst) {
+			dst.RemoveEdge(e[0], e[1])
+			if PathExistsIn(dst, simple.Node(e[0]), simple.Node(e[1])) {
+				t.Errorf("redundant edge %d->%d retained", e[0], e[1])
+			}
+			dst.SetEdge(simple.Edge{F: simple.Node(e[0]), T: simple.Node(e[1])})
+		}
+	}
+}
+
+// edgeIDs returns the end point IDs of the edges of g sorted lexically.
+func edgeIDs(g *simple.DirectedGraph) [][2]int64 {
+	var ids [][2]int64
+	for _, e := range graph.EdgesOf(g.Edges()) {
+		ids = append(ids, [2]int64{e.From().ID(), e.To().ID()})
+	}
+	slices.SortFunc(ids, func(a, b [2]int64) int {
+		if c := cmp.Compare(a[0], b[0]); c != 0 {
+			return c
+		}
+		return cmp.Compare(a[1], b[1])
+	})
+	return ids
+}
+
+func TestReachability(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for trial := 0; trial < 20; trial++ {
+		g := simple.NewDirectedGraph()
+		const n = 30
+		for i := 0; i < n; i++ {
+			g.AddNode(simple.Node(i))
+		}
+		for u := 0; u < n; u++ {
+			for v := 0; v < n; v++ {
+				if u != v && rnd.Float64() < 0.05 {
+					g.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+				}
+			}
+		}
+		r := NewReachability(g)
+		for u := int64(0); u < n; u++ {
+			for v := int64(0); v < n; v++ {
+				got := r.CanReach(u, v)
+				want := PathExistsIn(g, simple.Node(u), simple.Node(v))
+				if got != want {
+					t.Errorf("unexpected reachability from %d to %d: got:%t want:%t", u, v, got, want)
+				}
+			}
+		}
+		if r.CanReach(0, n) || r.CanReach(n, 0) || r.CanReach(n, n) {
+			t.Error("unexpected reachability for node not in graph")
+		}
+	}
+}
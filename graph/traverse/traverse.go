@@ -45,22 +45,42 @@ type BreadthFirst struct {
 // Walk performs a breadth-first traversal of the graph g starting from the given node,
 // depending on the Traverse field and the until parameter if they are non-nil.
 // The traversal follows edges for which Traverse(edge) is true and returns the first node
-// for which until(node, depth) is true. During the traversal, if the Visit field is
-// non-nil, it is called with each node the first time it is visited.
+// for which until(node, depth) is true. The depth passed to until is the number of edges
+// traversed from the starting node to reach node. During the traversal, if the Visit field
+// is non-nil, it is called with each node the first time it is visited.
 func (b *BreadthFirst) Walk(g Graph, from graph.Node, until func(n graph.Node, d int) bool) graph.Node {
+	return b.WalkFrom(g, []graph.Node{from}, until)
+}
+
+// WalkFrom performs a multi-source breadth-first traversal of the graph g starting from
+// all the given nodes, depending on the Traverse field and the until parameter if they are
+// non-nil. The traversal follows edges for which Traverse(edge) is true and returns the
+// first node for which until(node, depth) is true. The depth passed to until is the number
+// of edges traversed from the nearest of the starting nodes to reach node, so the starting
+// nodes are at depth zero. During the traversal, if the Visit field is non-nil, it is called
+// with each node the first time it is visited.
+func (b *BreadthFirst) WalkFrom(g Graph, from []graph.Node, until func(n graph.Node, d int) bool) graph.Node {
 	if b.visited == nil {
 		b.visited = make(set.Ints[int64])
 	}
-	b.queue.Enqueue(from)
-	if b.Visit != nil && !b.visited.Has(from.ID()) {
-		b.Visit(from)
+	seeds := make(set.Ints[int64], len(from))
+	for _, n := range from {
+		nid := n.ID()
+		if seeds.Has(nid) {
+			continue
+		}
+		seeds.Add(nid)
+		b.queue.Enqueue(n)
+		if b.Visit != nil && !b.visited.Has(nid) {
+			b.Visit(n)
+		}
+		b.visited.Add(nid)
 	}
-	b.visited.Add(from.ID())
 
 	var (
 		depth     int
 		children  int
-		untilNext = 1
+		untilNext = seeds.Count()
 	)
 	for b.queue.Len() > 0 {
 		t := b.queue.Dequeue()
@@ -146,47 +166,87 @@ type DepthFirst struct {
 	// edge can be traversed during the walk.
 	Traverse func(graph.Edge) bool
 
-	stack   linear.NodeStack
+	// Finish is called on all nodes after the walk
+	// has visited every node that was first reached
+	// by traversing an edge from them, giving the
+	// post-order of the walk. Finish is not called
+	// on nodes remaining when the walk is stopped
+	// by the until function.
+	Finish func(graph.Node)
+
+	stack   []dfsFrame
 	visited set.Ints[int64]
 }
 
+// dfsFrame is a node being visited by a depth-first
+// walk and its successors that are yet to be walked,
+// held in reverse walk order.
+type dfsFrame struct {
+	node graph.Node
+	succ []graph.Node
+}
+
 // Walk performs a depth-first traversal of the graph g starting from the given node,
 // depending on the Traverse field and the until parameter if they are non-nil.
 // The traversal follows edges for which Traverse(edge) is true and returns the first node
 // for which until(node) is true. During the traversal, if the Visit field is non-nil, it
-// is called with each node the first time it is visited.
+// is called with each node the first time it is visited, and if the Finish field is
+// non-nil, it is called with each node when its traversal is complete.
 func (d *DepthFirst) Walk(g Graph, from graph.Node, until func(graph.Node) bool) graph.Node {
 	if d.visited == nil {
 		d.visited = make(set.Ints[int64])
 	}
-	d.stack.Push(from)
-	for d.stack.Len() != 0 {
-		u := d.stack.Pop()
-		uid := u.ID()
-		if d.visited.Has(uid) {
+	if !d.visited.Has(from.ID()) && d.visit(g, from, until) {
+		return from
+	}
+	// The walk is performed with an explicit
+	// stack to allow walks of arbitrary depth.
+	for len(d.stack) != 0 {
+		f := &d.stack[len(d.stack)-1]
+		if len(f.succ) == 0 {
+			d.stack = d.stack[:len(d.stack)-1]
+			if d.Finish != nil {
+				d.Finish(f.node)
+			}
 			continue
 		}
-		d.visited.Add(uid)
-		if d.Visit != nil {
-			d.Visit(u)
-		}
-		if until != nil && until(u) {
-			return u
+		v := f.succ[len(f.succ)-1]
+		f.succ = f.succ[:len(f.succ)-1]
+		if d.visited.Has(v.ID()) {
+			continue
 		}
-		to := g.From(uid)
-		for to.Next() {
-			v := to.Node()
-			vid := v.ID()
-			if d.Traverse != nil && !d.Traverse(g.Edge(uid, vid)) {
-				continue
-			}
-			d.stack.Push(v)
+		if d.visit(g, v, until) {
+			return v
 		}
 	}
 
 	return nil
 }
 
+// visit marks u as visited and pushes it and its traversable successors onto the
+// walk stack, returning whether until(u) is true.
+func (d *DepthFirst) visit(g Graph, u graph.Node, until func(graph.Node) bool) bool {
+	uid := u.ID()
+	d.visited.Add(uid)
+	if d.Visit != nil {
+		d.Visit(u)
+	}
+	if until != nil && until(u) {
+		return true
+	}
+	var succ []graph.Node
+	to := g.From(uid)
+	for to.Next() {
+		v := to.Node()
+		if d.Traverse != nil && !d.Traverse(g.Edge(uid, v.ID())) {
+			continue
+		}
+		succ = append(succ, v)
+	}
+	d.stack = append(d.stack, dfsFrame{node: u, succ: succ})
+	return false
+}
+
 // WalkAll calls Walk for each unvisited node of the graph g using edges independent
 // of their direction. The functions before and after are called prior to commencing
 // and after completing each walk if they are non-nil respectively. The function
@@ -13,6 +13,7 @@ import (
 
 	"gonum.org/v1/gonum/graph"
 	"gonum.org/v1/gonum/graph/graphs/gen"
+	"gonum.org/v1/gonum/graph/iterator"
 	"gonum.org/v1/gonum/graph/simple"
 	"gonum.org/v1/gonum/internal/order"
 )
@@ -180,6 +181,44 @@ func TestBreadthFirst(t *testing.T) {
 	}
 }
 
+func TestBreadthFirstWalkFrom(t *testing.T) {
+	g := simple.NewUndirectedGraph()
+	for i := 0; i < 6; i++ {
+		g.SetEdge(simple.Edge{F: simple.Node(i), T: simple.Node(i + 1)})
+	}
+	g.SetEdge(simple.Edge{F: simple.Node(3), T: simple.Node(7)})
+	for _, test := range []struct {
+		from []graph.Node
+		want map[int64]int
+	}{
+		{
+			from: []graph.Node{simple.Node(0)},
+			want: map[int64]int{0: 0, 1: 1, 2: 2, 3: 3, 4: 4, 5: 5, 6: 6, 7: 4},
+		},
+		{
+			from: []graph.Node{simple.Node(0), simple.Node(6)},
+			want: map[int64]int{0: 0, 6: 0, 1: 1, 5: 1, 2: 2, 4: 2, 3: 3, 7: 4},
+		},
+		{
+			from: []graph.Node{simple.Node(7), simple.Node(7), simple.Node(1)},
+			want: map[int64]int{7: 0, 1: 0, 0: 1, 2: 1, 3: 1, 4: 2, 5: 3, 6: 4},
+		},
+	} {
+		var w BreadthFirst
+		got := make(map[int64]int)
+		w.WalkFrom(g, test.from, func(n graph.Node, d int) bool {
+			if _, ok := got[n.ID()]; ok {
+				t.Errorf("node %d walked twice from %v", n.ID(), test.from)
+			}
+			got[n.ID()] = d
+			return false
+		})
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("unexpected depths from %v:\ngot: %v\nwant:%v", test.from, got, test.want)
+		}
+	}
+}
+
 var depthFirstTests = []struct {
 	g     []intset
 	from  graph.Node
@@ -294,6 +333,106 @@ func TestDepthFirst(t *testing.T) {
 	}
 }
 
+func TestDepthFirstFinish(t *testing.T) {
+	g := simple.NewDirectedGraph()
+	for _, e := range [][2]int64{{0, 1}, {0, 2}, {1, 3}, {2, 3}, {3, 4}} {
+		g.SetEdge(simple.Edge{F: simple.Node(e[0]), T: simple.Node(e[1])})
+	}
+	var pre, post []int64
+	w := DepthFirst{
+		Visit:  func(n graph.Node) { pre = append(pre, n.ID()) },
+		Finish: func(n graph.Node) { post = append(post, n.ID()) },
+	}
+	w.Walk(g, simple.Node(0), nil)
+	if len(pre) != 5 || len(post) != 5 {
+		t.Fatalf("unexpected number of visits: pre:%v post:%v", pre, post)
+	}
+	if pre[0] != 0 || post[len(post)-1] != 0 {
+		t.Errorf("unexpected root position: pre:%v post:%v", pre, post)
+	}
+	finished := make(map[int64]int)
+	for i, id := range post {
+		finished[id] = i
+	}
+	// Every edge of a DAG goes from a node that
+	// finishes later to one that finishes earlier.
+	for _, e := range graph.EdgesOf(g.Edges()) {
+		if finished[e.From().ID()] <= finished[e.To().ID()] {
+			t.Errorf("edge %d->%d finished out of order: %v", e.From().ID(), e.To().ID(), post)
+		}
+	}
+}
+
+func TestDepthFirstOrder(t *testing.T) {
+	for _, p := range []float64{0.05, 0.2, 0.5} {
+		g := orderedFrom{gnpUndirected(50, p)}
+		var got []int64
+		w := DepthFirst{Visit: func(n graph.Node) { got = append(got, n.ID()) }}
+		w.Walk(g, simple.Node(0), nil)
+		want := lazyDepthFirst(g, simple.Node(0))
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("unexpected visit order for p=%v:\ngot: %v\nwant:%v", p, got, want)
+		}
+	}
+}
+
+// orderedFrom is a graph returning the nodes reachable
+// from a node sorted by ID.
+type orderedFrom struct {
+	graph.Graph
+}
+
+func (g orderedFrom) From(id int64) graph.Nodes {
+	nodes := graph.NodesOf(g.Graph.From(id))
+	order.ByID(nodes)
+	return iterator.NewOrderedNodes(nodes)
+}
+
+// lazyDepthFirst returns the visit order of a depth-first walk of g starting
+// from the given node that pushes all successors of each visited node onto a
+// stack. It is the reference for the walk order of DepthFirst.
+func lazyDepthFirst(g graph.Graph, from graph.Node) []int64 {
+	var visit []int64
+	visited := make(map[int64]bool)
+	stack := []graph.Node{from}
+	for len(stack) != 0 {
+		u := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if visited[u.ID()] {
+			continue
+		}
+		visited[u.ID()] = true
+		visit = append(visit, u.ID())
+		to := g.From(u.ID())
+		for to.Next() {
+			stack = append(stack, to.Node())
+		}
+	}
+	return visit
+}
+
+func TestDepthFirstDeep(t *testing.T) {
+	const n = 100000
+	g := simple.NewDirectedGraph()
+	for i := 0; i < n-1; i++ {
+		g.SetEdge(simple.Edge{F: simple.Node(i), T: simple.Node(i + 1)})
+	}
+	var visited, finished int
+	w := DepthFirst{
+		Visit: func(graph.Node) { visited++ },
+		Finish: func(u graph.Node) {
+			if u.ID() != int64(n-1-finished) {
+				t.Fatalf("unexpected finish order: got:%d want:%d", u.ID(), n-1-finished)
+			}
+			finished++
+		},
+	}
+	w.Walk(g, simple.Node(0), nil)
+	if visited != n || finished != n {
+		t.Errorf("unexpected number of visits: visited:%d finished:%d want:%d", visited, finished, n)
+	}
+}
+
 var walkAllTests = []struct {
 	g    []intset
 	edge func(graph.Edge) bool